@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/triggermesh/eventstore/pkg/client"
@@ -30,8 +31,20 @@ type KVCmd struct {
 	Del  KVDelCmd  `cmd:"" help:"Delete Key"`
 	Incr KVIncrCmd `cmd:"" help:"Increase value"`
 	Decr KVDecrCmd `cmd:"" help:"Increase value"`
+
+	TTL     KVTTLCmd     `cmd:"" help:"Get remaining time to live"`
+	Expire  KVExpireCmd  `cmd:"" help:"Set a new time to live"`
+	Persist KVPersistCmd `cmd:"" help:"Remove time to live"`
+}
+
+type KVTTLCmd struct{}
+
+type KVExpireCmd struct {
+	TTL int32 `help:"Key's new time to live (seconds)" required:""`
 }
 
+type KVPersistCmd struct{}
+
 type KVSetCmd struct {
 	Value string        `help:"Value to be stored" required:""`
 	TTL   time.Duration `help:"Key's time to live (seconds)" default:"5s"`
@@ -42,11 +55,11 @@ type KVGetCmd struct{}
 type KVDelCmd struct{}
 
 type KVIncrCmd struct {
-	Incr int32 `help:"Value to be increased" default:"1"`
+	Incr int64 `help:"Value to be increased" default:"1"`
 }
 
 type KVDecrCmd struct {
-	Decr int32 `help:"Value to be decreased" default:"1"`
+	Decr int64 `help:"Value to be decreased" default:"1"`
 }
 
 func (kv *KVSetCmd) Run(g *Globals) error {
@@ -110,11 +123,12 @@ func (kv *KVIncrCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	if err := g.scopedClient(es).KV().Incr(ctx, g.Key, kv.Incr); err != nil {
+	n, err := g.scopedClient(es).KV().IncrBy(ctx, g.Key, kv.Incr)
+	if err != nil {
 		return err
 	}
 
-	printDone()
+	printKV(g.Key, strconv.FormatInt(n, 10))
 	return nil
 }
 
@@ -127,7 +141,60 @@ func (kv *KVDecrCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	if err := g.scopedClient(es).KV().Decr(ctx, g.Key, kv.Decr); err != nil {
+	n, err := g.scopedClient(es).KV().DecrBy(ctx, g.Key, kv.Decr)
+	if err != nil {
+		return err
+	}
+
+	printKV(g.Key, strconv.FormatInt(n, 10))
+	return nil
+}
+
+func (c *KVTTLCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ttl, err := g.scopedClient(es).KV().TTL(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printTTL(ttl)
+	return nil
+}
+
+func (c *KVExpireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).KV().Expire(ctx, g.Key, c.TTL); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (c *KVPersistCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).KV().Persist(ctx, g.Key); err != nil {
 		return err
 	}
 
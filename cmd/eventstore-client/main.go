@@ -32,7 +32,7 @@ type Globals struct {
 	Scope    string `help:"Storage scope" enum:"global,bridge,instance"`
 	Bridge   string `help:"Bridge name, when scope is bridge or instance"`
 	Instance string `help:"Instance ID, when scope is instance"`
-	Key      string `help:"Storage Key" required:""`
+	Key      string `help:"Storage Key, required by all commands but keys"`
 
 	Timeout time.Duration `help:"Timeout for completing the operation" default:"5s"`
 }
@@ -40,10 +40,22 @@ type Globals struct {
 type Cli struct {
 	Globals
 
-	Kv    KVCmd    `cmd:"" help:"KV store"`
-	Queue QueueCmd `cmd:"" help:"Queue store"`
-	Map   MapCmd   `cmd:"" help:"Map store"`
-	Sync  SyncCmd  `cmd:"" help:"Lock and unlock keys"`
+	Kv        KVCmd        `cmd:"" help:"KV store"`
+	Queue     QueueCmd     `cmd:"" help:"Queue store"`
+	Map       MapCmd       `cmd:"" help:"Map store"`
+	Set       SetCmd       `cmd:"" help:"Set store"`
+	SortedSet SortedSetCmd `cmd:"" help:"Sorted set store"`
+	Stream    StreamCmd    `cmd:"" help:"Stream store"`
+	Sync      SyncCmd      `cmd:"" help:"Lock and unlock keys"`
+	Keys      KeysCmd      `cmd:"" help:"List keys at the scope"`
+
+	ScopeCmd ScopeCmd `cmd:"" name:"scope" help:"Manage the data at the scope"`
+}
+
+// keylessCommands do not operate on a single key.
+var keylessCommands = map[string]bool{
+	"keys":       true,
+	"scope drop": true,
 }
 
 func main() {
@@ -57,6 +69,10 @@ func main() {
 			Summary: true,
 		}))
 
+	if cli.Key == "" && !keylessCommands[ctx.Command()] {
+		ctx.Fatalf("missing flags: --key=STRING")
+	}
+
 	err := ctx.Run(&cli.Globals)
 	ctx.FatalIfErrorf(err)
 }
@@ -120,6 +136,35 @@ func printMap(key string, values map[string][]byte) {
 	}
 }
 
+func printMembers(key string, members []string) {
+	log.Printf("%s:\n", key)
+	for _, m := range members {
+		log.Printf("\t%s\n", m)
+	}
+}
+
+func printScored(key string, members []client.ScoredMember) {
+	log.Printf("%s:\n", key)
+	for _, m := range members {
+		log.Printf("\t%s: %v\n", m.Member, m.Score)
+	}
+}
+
+func printEntries(key string, entries []client.StreamEntry) {
+	log.Printf("%s:\n", key)
+	for _, e := range entries {
+		log.Printf("\t%d: %s\n", e.Offset, string(e.Value))
+	}
+}
+
+func printTTL(ttl int32) {
+	if ttl == 0 {
+		log.Println("ttl: none")
+		return
+	}
+	log.Printf("ttl: %ds\n", ttl)
+}
+
 func printDone() {
 	log.Println("done")
 }
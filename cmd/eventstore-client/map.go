@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/triggermesh/eventstore/pkg/client"
 )
@@ -27,6 +28,10 @@ type MapCmd struct {
 	New MapNewCmd `cmd:"" help:"Create new map at key"`
 	Del MapDelCmd `cmd:"" help:"Delete map at key"`
 
+	TTL     MapTTLCmd     `cmd:"" help:"Get remaining time to live"`
+	Expire  MapExpireCmd  `cmd:"" help:"Set a new time to live"`
+	Persist MapPersistCmd `cmd:"" help:"Remove time to live"`
+
 	FieldSet  MapFieldSetCmd  `cmd:"" help:"Set value at field"`
 	FieldGet  MapFieldGetCmd  `cmd:"" help:"Get value from field"`
 	FieldDel  MapFieldDelCmd  `cmd:"" help:"Delete value from field"`
@@ -37,6 +42,14 @@ type MapCmd struct {
 	Len      MapLenCmd      `cmd:"" help:"Get map length"`
 }
 
+type MapTTLCmd struct{}
+
+type MapExpireCmd struct {
+	TTL int32 `help:"Key's new time to live (seconds)" required:""`
+}
+
+type MapPersistCmd struct{}
+
 type MapNewCmd struct {
 	TTL int32 `help:"Key's time to live (seconds)" default:"5"`
 }
@@ -46,6 +59,8 @@ type MapDelCmd struct{}
 type MapFieldSetCmd struct {
 	Field string `help:"Field at map" required:""`
 	Value string `help:"Value to be set at field" required:""`
+	TTL   int32  `help:"Field's time to live (seconds), zero to live as long as the map" default:"0"`
+	NX    bool   `help:"Only set the field if it does not exist"`
 }
 
 type MapFieldGetCmd struct {
@@ -58,12 +73,12 @@ type MapFieldDelCmd struct {
 
 type MapFieldIncrCmd struct {
 	Field string `help:"Field at map" required:""`
-	Incr  int32  `help:"Value to be increased" default:"1"`
+	Incr  int64  `help:"Value to be increased" default:"1"`
 }
 
 type MapFieldDecrCmd struct {
 	Field string `help:"Field at map" required:""`
-	Decr  int32  `help:"Value to be decreased" default:"1"`
+	Decr  int64  `help:"Value to be decreased" default:"1"`
 }
 
 type MapAllItemsCmd struct{}
@@ -114,7 +129,14 @@ func (s *MapFieldSetCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	err := g.scopedClient(es).Map().Fields(g.Key).Set(ctx, s.Field, []byte(s.Value))
+	fields := g.scopedClient(es).Map().Fields(g.Key)
+
+	var err error
+	if s.NX {
+		err = fields.SetIfNotExists(ctx, s.Field, []byte(s.Value), s.TTL)
+	} else {
+		err = fields.SetWithTTL(ctx, s.Field, []byte(s.Value), s.TTL)
+	}
 	if err != nil {
 		return err
 	}
@@ -168,12 +190,12 @@ func (s *MapFieldIncrCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	err := g.scopedClient(es).Map().Fields(g.Key).Incr(ctx, s.Field, s.Incr)
+	n, err := g.scopedClient(es).Map().Fields(g.Key).IncrBy(ctx, s.Field, s.Incr)
 	if err != nil {
 		return err
 	}
 
-	printDone()
+	printKV(s.Field, strconv.FormatInt(n, 10))
 	return nil
 }
 
@@ -186,12 +208,12 @@ func (s *MapFieldDecrCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	err := g.scopedClient(es).Map().Fields(g.Key).Decr(ctx, s.Field, s.Decr)
+	n, err := g.scopedClient(es).Map().Fields(g.Key).DecrBy(ctx, s.Field, s.Decr)
 	if err != nil {
 		return err
 	}
 
-	printDone()
+	printKV(s.Field, strconv.FormatInt(n, 10))
 	return nil
 }
 
@@ -230,3 +252,55 @@ func (s *MapLenCmd) Run(g *Globals) error {
 	printKV("len", res)
 	return nil
 }
+
+func (c *MapTTLCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ttl, err := g.scopedClient(es).Map().TTL(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printTTL(ttl)
+	return nil
+}
+
+func (c *MapExpireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Map().Expire(ctx, g.Key, c.TTL); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (c *MapPersistCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Map().Persist(ctx, g.Key); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
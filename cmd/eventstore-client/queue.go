@@ -27,32 +27,89 @@ type QueueCmd struct {
 	New QueueNewCmd `cmd:"" help:"Create new queue"`
 	Del QueueDelCmd `cmd:"" help:"Delete queue at key"`
 
-	Push  QueuePushCmd  `cmd:"" help:"Push item to queue"`
-	Index QueueIndexCmd `cmd:"" help:"Retrieve element at index"`
-	Pop   QueuePopCmd   `cmd:"" help:"Extract and remove element at head"`
-	Peek  QueuePeekCmd  `cmd:"" help:"Extract element at head wihtout removing"`
+	TTL     QueueTTLCmd     `cmd:"" help:"Get remaining time to live"`
+	Expire  QueueExpireCmd  `cmd:"" help:"Set a new time to live"`
+	Persist QueuePersistCmd `cmd:"" help:"Remove time to live"`
+
+	Push      QueuePushCmd      `cmd:"" help:"Push item to queue"`
+	PushFront QueuePushFrontCmd `cmd:"" help:"Push item at the head of the queue"`
+	Index     QueueIndexCmd     `cmd:"" help:"Retrieve element at index"`
+	Range     QueueRangeCmd     `cmd:"" help:"Retrieve elements between two indexes"`
+	Trim      QueueTrimCmd      `cmd:"" help:"Remove elements out of two indexes"`
+	Pop       QueuePopCmd       `cmd:"" help:"Extract and remove element at head"`
+	PopBack   QueuePopBackCmd   `cmd:"" help:"Extract and remove element at tail"`
+	Peek      QueuePeekCmd      `cmd:"" help:"Extract element at head wihtout removing"`
+
+	Reserve QueueReserveCmd `cmd:"" help:"Hide element at head until acknowledged"`
+	Ack     QueueAckCmd     `cmd:"" help:"Remove reserved element"`
+	Nack    QueueNackCmd    `cmd:"" help:"Release reserved element for redelivery"`
 
 	AllItems QueueAllItemsCmd `cmd:"" help:"Get all items at queue"`
 	Len      QueueLenCmd      `cmd:"" help:"Get queue length"`
 }
 
+type QueueTTLCmd struct{}
+
+type QueueExpireCmd struct {
+	TTL int32 `help:"Key's new time to live (seconds)" required:""`
+}
+
+type QueuePersistCmd struct{}
+
 type QueueNewCmd struct {
-	TTL int32 `help:"Key's time to live (seconds)" default:"5"`
+	TTL      int32  `help:"Key's time to live (seconds)" default:"5"`
+	MaxLen   int32  `help:"Maximum number of elements, unbounded when not informed"`
+	Overflow string `help:"Policy when pushing to a full queue" enum:"reject,drop-oldest" default:"reject"`
 }
 
 type QueueDelCmd struct {
 }
 
 type QueuePushCmd struct {
+	Value    string `help:"Value to be pushed" required:""`
+	Priority int32  `help:"Elements with higher priorities are popped first"`
+	Delay    int32  `help:"Seconds the element is hidden after being pushed"`
+}
+
+type QueuePushFrontCmd struct {
 	Value string `help:"Value to be pushed" required:""`
 }
 
+type QueueRangeCmd struct {
+	Start int32 `help:"Index of the first element, negative counting from the tail"`
+	Stop  int32 `help:"Index of the last element, negative counting from the tail" default:"-1"`
+}
+
+type QueueTrimCmd struct {
+	Start int32 `help:"Index of the first element kept, negative counting from the tail" required:""`
+	Stop  int32 `help:"Index of the last element kept, negative counting from the tail" required:""`
+}
+
+type QueuePopBackCmd struct{}
+
 type QueueIndexCmd struct {
 	Index int32 `help:"Index for the queue element to retrieve" required:""`
 }
 
-type QueuePopCmd struct{}
+type QueuePopCmd struct {
+	Wait int32 `help:"Seconds to wait for an element when the queue is empty"`
+}
+
 type QueuePeekCmd struct{}
+
+type QueueReserveCmd struct {
+	Visibility    int32  `help:"Seconds the element is hidden from other consumers" default:"30"`
+	MaxDeliveries int32  `help:"Deliveries before moving the element to the dead-letter queue"`
+	DeadLetter    string `help:"Dead-letter queue key, elements are dropped when not informed"`
+}
+
+type QueueAckCmd struct {
+	ID string `help:"Reservation identifier" required:""`
+}
+
+type QueueNackCmd struct {
+	ID string `help:"Reservation identifier" required:""`
+}
 type QueueAllItemsCmd struct{}
 type QueueLenCmd struct{}
 
@@ -65,7 +122,16 @@ func (s *QueueNewCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	err := g.scopedClient(es).Queue().New(ctx, g.Key, s.TTL)
+	var opts []client.QueueOption
+	if s.MaxLen != 0 {
+		overflow := client.OverflowReject
+		if s.Overflow == "drop-oldest" {
+			overflow = client.OverflowDropOldest
+		}
+		opts = append(opts, client.WithMaxLen(s.MaxLen, overflow))
+	}
+
+	err := g.scopedClient(es).Queue().New(ctx, g.Key, s.TTL, opts...)
 	if err != nil {
 		return err
 	}
@@ -101,7 +167,8 @@ func (s *QueuePushCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	err := g.scopedClient(es).Queue().Items(g.Key).Push(ctx, []byte(s.Value))
+	err := g.scopedClient(es).Queue().Items(g.Key).Push(ctx, []byte(s.Value),
+		client.WithPriority(s.Priority), client.WithDelay(s.Delay))
 	if err != nil {
 		return err
 	}
@@ -137,7 +204,15 @@ func (s *QueuePopCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	res, err := g.scopedClient(es).Queue().Items(g.Key).Pop(ctx)
+	items := g.scopedClient(es).Queue().Items(g.Key)
+
+	var res []byte
+	var err error
+	if s.Wait > 0 {
+		res, err = items.PopWait(ctx, s.Wait)
+	} else {
+		res, err = items.Pop(ctx)
+	}
 	if err != nil {
 		return err
 	}
@@ -199,3 +274,184 @@ func (s *QueueLenCmd) Run(g *Globals) error {
 	printKV("len", res)
 	return nil
 }
+
+func (c *QueueTTLCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ttl, err := g.scopedClient(es).Queue().TTL(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printTTL(ttl)
+	return nil
+}
+
+func (c *QueueExpireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Queue().Expire(ctx, g.Key, c.TTL); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (c *QueuePersistCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Queue().Persist(ctx, g.Key); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *QueueReserveCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	var opts []client.ReserveOption
+	if s.MaxDeliveries != 0 {
+		opts = append(opts, client.WithMaxDeliveries(s.MaxDeliveries, s.DeadLetter))
+	}
+
+	res, err := g.scopedClient(es).Queue().Items(g.Key).Reserve(ctx, s.Visibility, opts...)
+	if err != nil {
+		return err
+	}
+
+	printKV("id", res.ID)
+	printKV("deliveries", fmt.Sprint(res.Deliveries))
+	printKV(g.Key, res.Value)
+	return nil
+}
+
+func (s *QueueAckCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Queue().Items(g.Key).Ack(ctx, s.ID); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *QueueNackCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Queue().Items(g.Key).Nack(ctx, s.ID); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *QueuePushFrontCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Queue().Items(g.Key).PushFront(ctx, []byte(s.Value)); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *QueueRangeCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	res, err := g.scopedClient(es).Queue().Items(g.Key).Range(ctx, s.Start, s.Stop)
+	if err != nil {
+		return err
+	}
+
+	printList("items", res)
+	return nil
+}
+
+func (s *QueueTrimCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Queue().Items(g.Key).Trim(ctx, s.Start, s.Stop); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *QueuePopBackCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	res, err := g.scopedClient(es).Queue().Items(g.Key).PopBack(ctx)
+	if err != nil {
+		return err
+	}
+
+	printKV(g.Key, res)
+	return nil
+}
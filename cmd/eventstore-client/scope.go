@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/triggermesh/eventstore/pkg/client"
+)
+
+type ScopeCmd struct {
+	Drop ScopeDropCmd `cmd:"" help:"Remove all keys and locks at the bridge or instance"`
+}
+
+type ScopeDropCmd struct{}
+
+func (d *ScopeDropCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	n, err := g.scopedClient(es).DropScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("dropped %d keys\n", n)
+	return nil
+}
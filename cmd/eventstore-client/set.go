@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/triggermesh/eventstore/pkg/client"
+)
+
+type SetCmd struct {
+	New SetNewCmd `cmd:"" help:"Create new set at key"`
+	Del SetDelCmd `cmd:"" help:"Delete set at key"`
+
+	TTL     SetTTLCmd     `cmd:"" help:"Get remaining time to live"`
+	Expire  SetExpireCmd  `cmd:"" help:"Set a new time to live"`
+	Persist SetPersistCmd `cmd:"" help:"Remove time to live"`
+
+	Add      SetAddCmd      `cmd:"" help:"Add members to set"`
+	Remove   SetRemoveCmd   `cmd:"" help:"Remove members from set"`
+	IsMember SetIsMemberCmd `cmd:"" help:"Check whether a member is in set"`
+	Pop      SetPopCmd      `cmd:"" help:"Remove a random member from set"`
+
+	Members SetMembersCmd `cmd:"" help:"Get all members of set"`
+	Card    SetCardCmd    `cmd:"" help:"Get number of members of set"`
+}
+
+type SetTTLCmd struct{}
+
+type SetExpireCmd struct {
+	TTL int32 `help:"Key's new time to live (seconds)" required:""`
+}
+
+type SetPersistCmd struct{}
+
+type SetNewCmd struct {
+	TTL int32 `help:"Key's time to live (seconds)" default:"5"`
+}
+
+type SetDelCmd struct{}
+
+type SetAddCmd struct {
+	Member []string `help:"Member to be added, can be repeated" required:""`
+}
+
+type SetRemoveCmd struct {
+	Member []string `help:"Member to be removed, can be repeated" required:""`
+}
+
+type SetIsMemberCmd struct {
+	Member string `help:"Member to be checked" required:""`
+}
+
+type SetPopCmd struct{}
+type SetMembersCmd struct{}
+type SetCardCmd struct{}
+
+func (s *SetNewCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).Set().New(ctx, g.Key, s.TTL)
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *SetDelCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).Set().Del(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *SetAddCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	n, err := g.scopedClient(es).Set().Members(g.Key).Add(ctx, s.Member...)
+	if err != nil {
+		return err
+	}
+
+	printKV("added", strconv.Itoa(n))
+	return nil
+}
+
+func (s *SetRemoveCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	n, err := g.scopedClient(es).Set().Members(g.Key).Remove(ctx, s.Member...)
+	if err != nil {
+		return err
+	}
+
+	printKV("removed", strconv.Itoa(n))
+	return nil
+}
+
+func (s *SetIsMemberCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ok, err := g.scopedClient(es).Set().Members(g.Key).IsMember(ctx, s.Member)
+	if err != nil {
+		return err
+	}
+
+	printKV(s.Member, strconv.FormatBool(ok))
+	return nil
+}
+
+func (s *SetPopCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	m, err := g.scopedClient(es).Set().Members(g.Key).Pop(ctx)
+	if err != nil {
+		return err
+	}
+
+	printKV("member", m)
+	return nil
+}
+
+func (s *SetMembersCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	res, err := g.scopedClient(es).Set().Members(g.Key).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	printMembers("members", res)
+	return nil
+}
+
+func (s *SetCardCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	n, err := g.scopedClient(es).Set().Members(g.Key).Len(ctx)
+	if err != nil {
+		return err
+	}
+
+	printKV("card", strconv.Itoa(n))
+	return nil
+}
+
+func (c *SetTTLCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ttl, err := g.scopedClient(es).Set().TTL(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printTTL(ttl)
+	return nil
+}
+
+func (c *SetExpireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Set().Expire(ctx, g.Key, c.TTL); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (c *SetPersistCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Set().Persist(ctx, g.Key); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/triggermesh/eventstore/pkg/client"
+)
+
+type SortedSetCmd struct {
+	New SortedSetNewCmd `cmd:"" help:"Create new sorted set at key"`
+	Del SortedSetDelCmd `cmd:"" help:"Delete sorted set at key"`
+
+	TTL     SortedSetTTLCmd     `cmd:"" help:"Get remaining time to live"`
+	Expire  SortedSetExpireCmd  `cmd:"" help:"Set a new time to live"`
+	Persist SortedSetPersistCmd `cmd:"" help:"Remove time to live"`
+
+	Add                SortedSetAddCmd                `cmd:"" help:"Add member with score to sorted set"`
+	Incr               SortedSetIncrCmd               `cmd:"" help:"Increase score of member"`
+	RangeByScore       SortedSetRangeByScoreCmd       `cmd:"" help:"Get members between two scores"`
+	RangeByRank        SortedSetRangeByRankCmd        `cmd:"" help:"Get members between two ranks"`
+	RemoveRangeByScore SortedSetRemoveRangeByScoreCmd `cmd:"" help:"Remove members between two scores"`
+
+	Card SortedSetCardCmd `cmd:"" help:"Get number of members of sorted set"`
+}
+
+type SortedSetTTLCmd struct{}
+
+type SortedSetExpireCmd struct {
+	TTL int32 `help:"Key's new time to live (seconds)" required:""`
+}
+
+type SortedSetPersistCmd struct{}
+
+type SortedSetNewCmd struct {
+	TTL int32 `help:"Key's time to live (seconds)" default:"5"`
+}
+
+type SortedSetDelCmd struct{}
+
+type SortedSetAddCmd struct {
+	Member string  `help:"Member to be added" required:""`
+	Score  float64 `help:"Score of the member" required:""`
+}
+
+type SortedSetIncrCmd struct {
+	Member string  `help:"Member of sorted set" required:""`
+	Incr   float64 `help:"Value to be added to score" default:"1"`
+}
+
+type SortedSetRangeByScoreCmd struct {
+	Min   float64 `help:"Minimum score, inclusive" default:"-inf"`
+	Max   float64 `help:"Maximum score, inclusive" default:"+inf"`
+	Limit int32   `help:"Maximum number of members, zero meaning no limit" default:"0"`
+}
+
+type SortedSetRangeByRankCmd struct {
+	Start int32 `help:"Start rank, negative counting from the highest score" default:"0"`
+	Stop  int32 `help:"Stop rank, negative counting from the highest score" default:"-1"`
+}
+
+type SortedSetRemoveRangeByScoreCmd struct {
+	Min float64 `help:"Minimum score, inclusive" default:"-inf"`
+	Max float64 `help:"Maximum score, inclusive" required:""`
+}
+
+type SortedSetCardCmd struct{}
+
+func (s *SortedSetNewCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).SortedSet().New(ctx, g.Key, s.TTL)
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *SortedSetDelCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).SortedSet().Del(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *SortedSetAddCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	m := client.ScoredMember{Member: s.Member, Score: s.Score}
+	n, err := g.scopedClient(es).SortedSet().Members(g.Key).Add(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	printKV("added", strconv.Itoa(n))
+	return nil
+}
+
+func (s *SortedSetIncrCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	score, err := g.scopedClient(es).SortedSet().Members(g.Key).IncrBy(ctx, s.Member, s.Incr)
+	if err != nil {
+		return err
+	}
+
+	printKV(s.Member, strconv.FormatFloat(score, 'f', -1, 64))
+	return nil
+}
+
+func (s *SortedSetRangeByScoreCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	res, err := g.scopedClient(es).SortedSet().Members(g.Key).RangeByScore(ctx, s.Min, s.Max, s.Limit)
+	if err != nil {
+		return err
+	}
+
+	printScored("members", res)
+	return nil
+}
+
+func (s *SortedSetRangeByRankCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	res, err := g.scopedClient(es).SortedSet().Members(g.Key).RangeByRank(ctx, s.Start, s.Stop)
+	if err != nil {
+		return err
+	}
+
+	printScored("members", res)
+	return nil
+}
+
+func (s *SortedSetRemoveRangeByScoreCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	n, err := g.scopedClient(es).SortedSet().Members(g.Key).RemoveRangeByScore(ctx, s.Min, s.Max)
+	if err != nil {
+		return err
+	}
+
+	printKV("removed", strconv.Itoa(n))
+	return nil
+}
+
+func (s *SortedSetCardCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	n, err := g.scopedClient(es).SortedSet().Members(g.Key).Len(ctx)
+	if err != nil {
+		return err
+	}
+
+	printKV("card", strconv.Itoa(n))
+	return nil
+}
+
+func (c *SortedSetTTLCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ttl, err := g.scopedClient(es).SortedSet().TTL(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printTTL(ttl)
+	return nil
+}
+
+func (c *SortedSetExpireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).SortedSet().Expire(ctx, g.Key, c.TTL); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (c *SortedSetPersistCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).SortedSet().Persist(ctx, g.Key); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
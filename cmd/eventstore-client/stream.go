@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/triggermesh/eventstore/pkg/client"
+)
+
+type StreamCmd struct {
+	New StreamNewCmd `cmd:"" help:"Create new stream at key"`
+	Del StreamDelCmd `cmd:"" help:"Delete stream at key"`
+
+	TTL     StreamTTLCmd     `cmd:"" help:"Get remaining time to live"`
+	Expire  StreamExpireCmd  `cmd:"" help:"Set a new time to live"`
+	Persist StreamPersistCmd `cmd:"" help:"Remove time to live"`
+
+	Append StreamAppendCmd `cmd:"" help:"Append entry to stream"`
+	Read   StreamReadCmd   `cmd:"" help:"Read entries starting at an offset"`
+	Commit StreamCommitCmd `cmd:"" help:"Commit the offset of a consumer group"`
+	Offset StreamOffsetCmd `cmd:"" help:"Get the offset committed by a consumer group"`
+}
+
+type StreamTTLCmd struct{}
+
+type StreamExpireCmd struct {
+	TTL int32 `help:"Key's new time to live (seconds)" required:""`
+}
+
+type StreamPersistCmd struct{}
+
+type StreamNewCmd struct {
+	TTL    int32 `help:"Key's time to live (seconds)" default:"5"`
+	MaxLen int32 `help:"Maximum number of entries retained, unbounded when not informed"`
+	MaxAge int32 `help:"Seconds entries are retained, forever when not informed"`
+}
+
+type StreamDelCmd struct{}
+
+type StreamAppendCmd struct {
+	Value string `help:"Value to be appended" required:""`
+}
+
+type StreamReadCmd struct {
+	Offset int64 `help:"Offset of the first entry, the oldest retained when not informed"`
+	Limit  int32 `help:"Maximum number of entries, unlimited when not informed"`
+}
+
+type StreamCommitCmd struct {
+	Group  string `help:"Consumer group" required:""`
+	Offset int64  `help:"Offset of the next entry the group will read" required:""`
+}
+
+type StreamOffsetCmd struct {
+	Group string `help:"Consumer group" required:""`
+}
+
+func (s *StreamNewCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).Stream().New(ctx, g.Key, s.TTL,
+		client.WithRetentionLen(s.MaxLen), client.WithRetentionAge(s.MaxAge))
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *StreamDelCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).Stream().Del(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *StreamAppendCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	offset, err := g.scopedClient(es).Stream().Entries(g.Key).Append(ctx, []byte(s.Value))
+	if err != nil {
+		return err
+	}
+
+	printKV("offset", strconv.FormatInt(offset, 10))
+	return nil
+}
+
+func (s *StreamReadCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	entries, err := g.scopedClient(es).Stream().Entries(g.Key).Read(ctx, s.Offset, s.Limit)
+	if err != nil {
+		return err
+	}
+
+	printEntries("entries", entries)
+	return nil
+}
+
+func (s *StreamCommitCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	err := g.scopedClient(es).Stream().Entries(g.Key).Commit(ctx, s.Group, s.Offset)
+	if err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *StreamOffsetCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	offset, err := g.scopedClient(es).Stream().Entries(g.Key).Offset(ctx, s.Group)
+	if err != nil {
+		return err
+	}
+
+	printKV(s.Group, strconv.FormatInt(offset, 10))
+	return nil
+}
+
+func (c *StreamTTLCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	ttl, err := g.scopedClient(es).Stream().TTL(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printTTL(ttl)
+	return nil
+}
+
+func (c *StreamExpireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Stream().Expire(ctx, g.Key, c.TTL); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (c *StreamPersistCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Stream().Persist(ctx, g.Key); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
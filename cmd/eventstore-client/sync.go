@@ -19,23 +19,57 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/triggermesh/eventstore/pkg/client"
 )
 
 type SyncCmd struct {
-	Lock   LockCmd   `cmd:"" help:"Lock key for exclusive access"`
-	Unlock UnlockCmd `cmd:"" help:"Unlock key"`
+	Lock    LockCmd        `cmd:"" help:"Lock key for exclusive access"`
+	Unlock  UnlockCmd      `cmd:"" help:"Unlock key"`
+	Refresh RefreshLockCmd `cmd:"" help:"Set a new timeout for a held lock"`
+	Info    LockInfoCmd    `cmd:"" help:"Describe the lock at key"`
+	RLock   RLockCmd       `cmd:"" name:"rlock" help:"Lock key for shared access"`
+	RUnlock RUnlockCmd     `cmd:"" name:"runlock" help:"Release shared access to key"`
+	Acquire AcquireCmd     `cmd:"" help:"Acquire permits from the semaphore at key"`
+	Release ReleaseCmd     `cmd:"" help:"Release permits to the semaphore at key"`
 }
 
 type LockCmd struct {
-	UnlockTimeout int32 `help:"Timeout before automatically unlocking (seconds)" required:""`
+	UnlockTimeout int32  `help:"Timeout before automatically unlocking (seconds)" required:""`
+	Owner         string `help:"Identifies the holder of the lock"`
+	Wait          int32  `help:"Time to wait in line when the key is locked (seconds)"`
 }
 
 type UnlockCmd struct {
 	Unlock string `help:"Unlock string" required:""`
 }
 
+type RefreshLockCmd struct {
+	Unlock        string `help:"Unlock string" required:""`
+	UnlockTimeout int32  `help:"New timeout before automatically unlocking (seconds)" required:""`
+}
+
+type LockInfoCmd struct{}
+
+type RLockCmd struct {
+	UnlockTimeout int32 `help:"Timeout before automatically unlocking (seconds)" required:""`
+}
+
+type RUnlockCmd struct {
+	Unlock string `help:"Unlock string" required:""`
+}
+
+type AcquireCmd struct {
+	Permits        int32 `help:"Number of permits to acquire" default:"1"`
+	Limit          int32 `help:"Number of permits the semaphore grants at the same time" required:""`
+	ReleaseTimeout int32 `help:"Timeout before automatically releasing (seconds)" required:""`
+}
+
+type ReleaseCmd struct {
+	Release string `help:"Release string" required:""`
+}
+
 func (s *LockCmd) Run(g *Globals) error {
 	es := client.New(g.Server, g.Timeout)
 	ctx := context.Background()
@@ -45,12 +79,14 @@ func (s *LockCmd) Run(g *Globals) error {
 	}
 	defer func() { _ = es.Disconnect() }()
 
-	unlock, err := g.scopedClient(es).Sync().Lock(ctx, g.Key, s.UnlockTimeout)
+	lock, err := g.scopedClient(es).Sync().Lock(ctx, g.Key, s.UnlockTimeout,
+		client.WithLockOwner(s.Owner), client.WithLockWait(s.Wait))
 	if err != nil {
 		return err
 	}
 
-	printKV("unlock", unlock)
+	printKV("unlock", lock.UnlockCode())
+	printKV("fencing token", strconv.FormatUint(lock.FencingToken(), 10))
 	return nil
 }
 
@@ -70,3 +106,116 @@ func (s *UnlockCmd) Run(g *Globals) error {
 	printDone()
 	return nil
 }
+
+func (s *RefreshLockCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Sync().RefreshLock(ctx, g.Key, s.Unlock, s.UnlockTimeout); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *LockInfoCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	info, err := g.scopedClient(es).Sync().LockInfo(ctx, g.Key)
+	if err != nil {
+		return err
+	}
+
+	printKV("locked", strconv.FormatBool(info.Locked))
+	if info.Locked {
+		printKV("owner", info.Owner)
+		printTTL(info.TTL)
+		printKV("fencing token", strconv.FormatUint(info.FencingToken, 10))
+	}
+	if info.Readers != 0 {
+		printKV("readers", strconv.Itoa(info.Readers))
+	}
+	return nil
+}
+
+func (s *RLockCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	unlock, err := g.scopedClient(es).Sync().RLock(ctx, g.Key, s.UnlockTimeout)
+	if err != nil {
+		return err
+	}
+
+	printKV("unlock", unlock)
+	return nil
+}
+
+func (s *RUnlockCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Sync().RUnlock(ctx, g.Key, s.Unlock); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
+
+func (s *AcquireCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	release, err := g.scopedClient(es).Sync().Acquire(ctx, g.Key, s.Permits, s.Limit, s.ReleaseTimeout)
+	if err != nil {
+		return err
+	}
+
+	printKV("release", release)
+	return nil
+}
+
+func (s *ReleaseCmd) Run(g *Globals) error {
+	es := client.New(g.Server, g.Timeout)
+	ctx := context.Background()
+
+	if err := es.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.Server, err)
+	}
+	defer func() { _ = es.Disconnect() }()
+
+	if err := g.scopedClient(es).Sync().Release(ctx, g.Key, s.Release); err != nil {
+		return err
+	}
+
+	printDone()
+	return nil
+}
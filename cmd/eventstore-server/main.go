@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"google.golang.org/grpc"
+
+	"github.com/triggermesh/eventstore/pkg/server"
+	"github.com/triggermesh/eventstore/pkg/server/file"
+	"github.com/triggermesh/eventstore/pkg/server/memory"
+	"github.com/triggermesh/eventstore/pkg/server/redis"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type Cli struct {
+	Address       string        `help:"Address to listen for gRPC requests" default:":8080"`
+	Storage       string        `help:"Storage backend" enum:"memory,file,redis" default:"memory"`
+	SweepInterval time.Duration `help:"Interval for removing expired keys from memory" default:"1s"`
+
+	File  FileFlags  `embed:"" prefix:"file-" group:"File storage"`
+	Redis RedisFlags `embed:"" prefix:"redis-" group:"Redis storage"`
+}
+
+type FileFlags struct {
+	Path            string        `help:"Path to the storage log file" default:"eventstore.log"`
+	CompactInterval time.Duration `help:"Interval for checking whether the log file needs compaction" default:"1m"`
+	SyncWrites      bool          `help:"Flush every write to stable storage"`
+}
+
+type RedisFlags struct {
+	Address  string `help:"Address of the Redis server" default:"localhost:6379"`
+	Password string `help:"Password for the Redis server" env:"REDIS_PASSWORD"`
+	DB       int    `help:"Redis database number" default:"0"`
+	Prefix   string `help:"Prefix for all keys written to Redis" default:"eventstore"`
+}
+
+func main() {
+	cli := Cli{}
+	ctx := kong.Parse(&cli,
+		kong.Name("eventstore-server"),
+		kong.Description("EventStore server."),
+		kong.UsageOnError())
+
+	err := cli.Run()
+	ctx.FatalIfErrorf(err)
+}
+
+func (c *Cli) Run() error {
+	lis, err := net.Listen("tcp", c.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen at %s: %w", c.Address, err)
+	}
+
+	storage, err := c.storage()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = storage.Close() }()
+
+	gs := grpc.NewServer()
+	server.Register(gs, storage)
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sig
+		log.Println("shutting down")
+
+		// Watch streams never end on their own, stop
+		// them once pending requests had time to finish.
+		stopped := make(chan struct{})
+		go func() {
+			gs.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			gs.Stop()
+		}
+	}()
+
+	log.Printf("listening at %s\n", lis.Addr())
+	return gs.Serve(lis)
+}
+
+type closableStorage interface {
+	server.Storage
+	Close() error
+}
+
+func (c *Cli) storage() (closableStorage, error) {
+	switch c.Storage {
+	case "file":
+		opts := []file.Option{
+			file.WithCompactInterval(c.File.CompactInterval),
+			file.WithMemoryOptions(memory.WithSweepInterval(c.SweepInterval)),
+		}
+		if c.File.SyncWrites {
+			opts = append(opts, file.WithSyncWrites())
+		}
+
+		s, err := file.Open(c.File.Path, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", c.File.Path, err)
+		}
+		return s, nil
+
+	case "redis":
+		s := redis.New(c.Redis.Address,
+			redis.WithPassword(c.Redis.Password),
+			redis.WithDB(c.Redis.DB),
+			redis.WithPrefix(c.Redis.Prefix))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.Ping(ctx); err != nil {
+			_ = s.Close()
+			return nil, fmt.Errorf("failed to connect to %s: %w", c.Redis.Address, err)
+		}
+		return s, nil
+
+	default:
+		return memory.New(memory.WithSweepInterval(c.SweepInterval)), nil
+	}
+}
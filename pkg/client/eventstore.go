@@ -42,12 +42,58 @@ type Interface interface {
 	KV() KeyValue
 	Map() Map
 	Queue() Queue
+	Set() Set
+	SortedSet() SortedSet
+	Stream() Stream
 	Sync() Sync
+
+	// Watch streams the changes at the key. See WatchOption
+	// for customizing what is watched.
+	Watch(ctx context.Context, key string, opts ...WatchOption) (<-chan Event, error)
+
+	// Keys lists the keys that start with the prefix.
+	Keys(ctx context.Context, prefix string) ([]KeyInfo, error)
+
+	// DropScope removes every key and lock at the bridge or
+	// instance, returning the number of keys removed.
+	DropScope(ctx context.Context) (int, error)
+
+	// Batch collects operations that are executed
+	// in a single request.
+	Batch() Batch
+
+	// Txn collects operations that are applied atomically.
+	Txn() Txn
 }
 
+// Sync provides locks shared by all components. Locks are released
+// automatically after a non zero timeout.
 type Sync interface {
-	Lock(ctx context.Context, key string, timeout int32) (string, error)
+	// Lock fails when the key is locked, unless a wait is informed
+	// using WithLockWait. Waiting contenders are granted the lock in
+	// order, and leave the line when ctx is done.
+	Lock(ctx context.Context, key string, timeout int32, opts ...LockOption) (*Lock, error)
+	// Unlock and RefreshLock work on the lock held using the code
+	// informed by its handle, RefreshLock sets a new timeout.
 	Unlock(ctx context.Context, key string, unlock string) error
+	RefreshLock(ctx context.Context, key string, unlock string, timeout int32) error
+	// LockInfo describes the lock at the key.
+	LockInfo(ctx context.Context, key string) (*LockInfo, error)
+
+	// RLock locks key for shared access, which is granted as long as
+	// it is not locked exclusively, and returns the code needed to
+	// unlock it. Exclusive locks are not granted while there are
+	// shared holders.
+	RLock(ctx context.Context, key string, timeout int32) (string, error)
+	RUnlock(ctx context.Context, key string, unlock string) error
+
+	// Acquire takes permits from the semaphore at key as long as no
+	// more than limit permits are held, and returns the code needed
+	// to release them, failing with a ResourceExhausted error when
+	// there are not enough permits left. Permits are released
+	// automatically after a non zero timeout.
+	Acquire(ctx context.Context, key string, permits, limit, timeout int32) (string, error)
+	Release(ctx context.Context, key string, release string) error
 }
 
 // KeyValue is the key value interface for storage.
@@ -57,6 +103,37 @@ type KeyValue interface {
 	Del(ctx context.Context, key string) error
 	Incr(ctx context.Context, key string, value int32) error
 	Decr(ctx context.Context, key string, value int32) error
+
+	// Counter operations returning the resulting value.
+	IncrBy(ctx context.Context, key string, delta int64) (int64, error)
+	DecrBy(ctx context.Context, key string, delta int64) (int64, error)
+	IncrByFloat(ctx context.Context, key string, delta float64) (float64, error)
+
+	// TTL operations. A zero TTL means that the key never expires.
+	TTL(ctx context.Context, key string) (int32, error)
+	Expire(ctx context.Context, key string, ttlSec int32) error
+	Persist(ctx context.Context, key string) error
+
+	// Versioned operations. Every write assigns a new version to
+	// the key, conditional writes fail when the condition does not
+	// hold, which can be checked using IsConflict.
+	GetWithVersion(ctx context.Context, key string) ([]byte, uint64, error)
+	SetWithVersion(ctx context.Context, key string, value []byte, ttlSec int32) (uint64, error)
+	CompareAndSwap(ctx context.Context, key string, value []byte, ttlSec int32, version uint64) (uint64, error)
+	SetIfNotExists(ctx context.Context, key string, value []byte, ttlSec int32) (uint64, error)
+	SetIfExists(ctx context.Context, key string, value []byte, ttlSec int32) (uint64, error)
+
+	// Multiple key operations performed in a single request. MGet
+	// omits missing keys from the result, MDel returns the number of
+	// keys removed.
+	MGet(ctx context.Context, keys ...string) (map[string][]byte, error)
+	MSet(ctx context.Context, values map[string][]byte, ttlSec int32) error
+	MDel(ctx context.Context, keys ...string) (int, error)
+
+	// Lock locks the key temporarily, sharing the lock namespace
+	// with Sync. Locks are advisory, writes made by components that
+	// do not hold the lock are not rejected.
+	Lock(ctx context.Context, key string, timeout int32, opts ...LockOption) (*Lock, error)
 }
 
 // MapInterface is the map structure interface for storage.
@@ -64,6 +141,15 @@ type Map interface {
 	New(ctx context.Context, key string, ttlSec int32) error
 	Fields(key string) MapFields
 	Del(ctx context.Context, key string) error
+
+	TTL(ctx context.Context, key string) (int32, error)
+	Expire(ctx context.Context, key string, ttlSec int32) error
+	Persist(ctx context.Context, key string) error
+
+	// Lock locks the map temporarily for exclusive multi-field
+	// updates, see KeyValue.Lock. The handle scopes the field
+	// operations on the locked map.
+	Lock(ctx context.Context, key string, timeout int32, opts ...LockOption) (*MapLock, error)
 }
 
 type MapFields interface {
@@ -73,26 +159,131 @@ type MapFields interface {
 	Incr(ctx context.Context, key string, value int32) error
 	Decr(ctx context.Context, key string, value int32) error
 
+	// Counter operations returning the resulting value.
+	IncrBy(ctx context.Context, key string, delta int64) (int64, error)
+	DecrBy(ctx context.Context, key string, delta int64) (int64, error)
+	IncrByFloat(ctx context.Context, key string, delta float64) (float64, error)
+
 	All(ctx context.Context) (map[string][]byte, error)
 	Len(ctx context.Context) (int, error)
+
+	// Field TTL operations. Fields expire after their TTL, a zero TTL
+	// means that the field lives as long as the map. SetIfNotExists
+	// fails when the field exists, which can be checked using IsConflict.
+	SetWithTTL(ctx context.Context, key string, value []byte, ttlSec int32) error
+	SetIfNotExists(ctx context.Context, key string, value []byte, ttlSec int32) error
+
+	// Multiple field operations performed in a single request. MGet
+	// omits missing fields from the result, MSet sets all fields
+	// atomically.
+	MGet(ctx context.Context, keys ...string) (map[string][]byte, error)
+	MSet(ctx context.Context, values map[string][]byte, ttlSec int32) error
 }
 
 // Queue is a minimal FIFO interface.
 type Queue interface {
-	New(ctx context.Context, key string, ttlSec int32) error
+	New(ctx context.Context, key string, ttlSec int32, opts ...QueueOption) error
 	Items(key string) QueueItems
 	Del(ctx context.Context, key string) error
+
+	TTL(ctx context.Context, key string) (int32, error)
+	Expire(ctx context.Context, key string, ttlSec int32) error
+	Persist(ctx context.Context, key string) error
 }
 type QueueItems interface {
-	Push(ctx context.Context, value []byte) error
+	Push(ctx context.Context, value []byte, opts ...PushOption) error
+	PushFront(ctx context.Context, value []byte) error
 	Index(ctx context.Context, index int32) ([]byte, error)
+	Range(ctx context.Context, start, stop int32) ([][]byte, error)
+	Trim(ctx context.Context, start, stop int32) error
 	Pop(ctx context.Context) ([]byte, error)
+	PopBack(ctx context.Context) ([]byte, error)
+	PopWait(ctx context.Context, timeout int32) ([]byte, error)
 	Peek(ctx context.Context) ([]byte, error)
 
+	// Reserve, Ack and Nack consume items at least once.
+	// See Reservation.
+	Reserve(ctx context.Context, visibility int32, opts ...ReserveOption) (Reservation, error)
+	Ack(ctx context.Context, id string) error
+	Nack(ctx context.Context, id string) error
+
 	All(ctx context.Context) ([][]byte, error)
 	Len(ctx context.Context) (int, error)
 }
 
+// Set is an unordered collection of unique string members.
+type Set interface {
+	New(ctx context.Context, key string, ttlSec int32) error
+	Members(key string) SetMembers
+	Del(ctx context.Context, key string) error
+
+	TTL(ctx context.Context, key string) (int32, error)
+	Expire(ctx context.Context, key string, ttlSec int32) error
+	Persist(ctx context.Context, key string) error
+}
+
+// SetMembers operates on the members of a set. Add and Remove
+// return the number of members that were added or removed.
+type SetMembers interface {
+	Add(ctx context.Context, members ...string) (int, error)
+	Remove(ctx context.Context, members ...string) (int, error)
+	IsMember(ctx context.Context, member string) (bool, error)
+	Pop(ctx context.Context) (string, error)
+
+	All(ctx context.Context) ([]string, error)
+	Len(ctx context.Context) (int, error)
+}
+
+// SortedSet is a collection of unique string members ordered by
+// score, members with the same score being ordered lexicographically.
+type SortedSet interface {
+	New(ctx context.Context, key string, ttlSec int32) error
+	Members(key string) SortedSetMembers
+	Del(ctx context.Context, key string) error
+
+	TTL(ctx context.Context, key string) (int32, error)
+	Expire(ctx context.Context, key string, ttlSec int32) error
+	Persist(ctx context.Context, key string) error
+}
+
+// SortedSetMembers operates on the members of a sorted set. Score
+// ranges include both ends and can be unbounded using math.Inf, rank
+// ranges count from the lowest score and negative ranks count from
+// the highest one.
+type SortedSetMembers interface {
+	Add(ctx context.Context, members ...ScoredMember) (int, error)
+	IncrBy(ctx context.Context, member string, delta float64) (float64, error)
+	RangeByScore(ctx context.Context, min, max float64, limit int32) ([]ScoredMember, error)
+	RangeByRank(ctx context.Context, start, stop int32) ([]ScoredMember, error)
+	RemoveRangeByScore(ctx context.Context, min, max float64) (int, error)
+	Len(ctx context.Context) (int, error)
+}
+
+// Stream is an append-only sequence of entries that consumer groups
+// read independently. Entries are retained according to the options
+// the stream was created with.
+type Stream interface {
+	New(ctx context.Context, key string, ttlSec int32, opts ...StreamOption) error
+	Entries(key string) StreamEntries
+	Del(ctx context.Context, key string) error
+
+	TTL(ctx context.Context, key string) (int32, error)
+	Expire(ctx context.Context, key string, ttlSec int32) error
+	Persist(ctx context.Context, key string) error
+}
+
+// StreamEntries operates on the entries of a stream. Offsets start
+// at one and increase with every append, reading from an offset whose
+// entry is no longer retained starts at the oldest retained entry.
+// Consumer groups commit the offset of the next entry they will read,
+// zero being returned for groups that have not committed any.
+type StreamEntries interface {
+	Append(ctx context.Context, value []byte) (int64, error)
+	Read(ctx context.Context, offset int64, limit int32) ([]StreamEntry, error)
+	Commit(ctx context.Context, group string, offset int64) error
+	Offset(ctx context.Context, group string) (int64, error)
+}
+
 // client is the default implementation of the stateful
 // store client interface.
 type client struct {
@@ -105,10 +296,21 @@ type client struct {
 }
 
 type services struct {
-	kvc    eventstore.KVClient
-	mapc   eventstore.MapClient
-	queuec eventstore.QueueClient
-	syncc  eventstore.SyncClient
+	kvc     eventstore.KVClient
+	mapc    eventstore.MapClient
+	queuec  eventstore.QueueClient
+	setc    eventstore.SetClient
+	zsetc   eventstore.SortedSetClient
+	streamc eventstore.StreamClient
+	syncc   eventstore.SyncClient
+	watchc  eventstore.WatchClient
+	scopec  eventstore.ScopeClient
+	batchc  eventstore.BatchClient
+	txnc    eventstore.TxnClient
+
+	// timeout bounds the calls made on behalf of the
+	// user that cannot be bound by the user context.
+	timeout time.Duration
 }
 
 type internalClient struct {
@@ -130,6 +332,46 @@ func (s *internalClient) Queue() Queue {
 	return &internalQueue{s}
 }
 
+func (s *internalClient) Set() Set {
+	return &internalSet{s}
+}
+
+func (s *internalClient) SortedSet() SortedSet {
+	return &internalSortedSet{s}
+}
+
+func (s *internalClient) Stream() Stream {
+	return &internalStream{s}
+}
+
+// scope returns the scope the client operates at.
+func (s *internalClient) scope() *eventstore.ScopeType {
+	sc := &eventstore.ScopeType{
+		Bridge:   s.bridge,
+		Instance: s.instance,
+	}
+
+	switch {
+	case s.instance != "":
+		sc.Type = eventstore.ScopeChoice_Instance
+	case s.bridge != "":
+		sc.Type = eventstore.ScopeChoice_Bridge
+	default:
+		sc.Type = eventstore.ScopeChoice_Global
+	}
+
+	return sc
+}
+
+// location returns the location of the key
+// at the scope the client operates at.
+func (s *internalClient) location(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: s.scope(),
+		Key:   key,
+	}
+}
+
 func (s *internalClient) Sync() Sync {
 	// TODO add Queue
 	return &internalSync{s}
@@ -154,10 +396,18 @@ func (c *client) Connect(ctx context.Context) error {
 	}
 
 	c.services = &services{
-		kvc:    eventstore.NewKVClient(conn),
-		mapc:   eventstore.NewMapClient(conn),
-		queuec: eventstore.NewQueueClient(conn),
-		syncc:  eventstore.NewSyncClient(conn),
+		kvc:     eventstore.NewKVClient(conn),
+		mapc:    eventstore.NewMapClient(conn),
+		queuec:  eventstore.NewQueueClient(conn),
+		setc:    eventstore.NewSetClient(conn),
+		zsetc:   eventstore.NewSortedSetClient(conn),
+		streamc: eventstore.NewStreamClient(conn),
+		syncc:   eventstore.NewSyncClient(conn),
+		watchc:  eventstore.NewWatchClient(conn),
+		scopec:  eventstore.NewScopeClient(conn),
+		batchc:  eventstore.NewBatchClient(conn),
+		txnc:    eventstore.NewTxnClient(conn),
+		timeout: c.timeout,
 	}
 
 	return nil
@@ -175,7 +425,14 @@ func (c *client) Disconnect() error {
 	c.services.kvc = nil
 	c.services.mapc = nil
 	c.services.queuec = nil
+	c.services.setc = nil
+	c.services.zsetc = nil
+	c.services.streamc = nil
 	c.services.syncc = nil
+	c.services.watchc = nil
+	c.services.scopec = nil
+	c.services.batchc = nil
+	c.services.txnc = nil
 	c.conn = nil
 
 	return nil
@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// Batch collects KV, map and queue operations that are sent to the
+// EventStore in a single request when executed. Operations are
+// executed in the order they were added, the failure of one of them
+// does not prevent the rest from being executed.
+type Batch interface {
+	Set(key string, value []byte, ttlSec int32) Batch
+	Get(key string) Batch
+	Del(key string) Batch
+	IncrBy(key string, delta int64) Batch
+	DecrBy(key string, delta int64) Batch
+
+	MapNew(key string, ttlSec int32) Batch
+	MapDel(key string) Batch
+	MapFields(key string) Batch
+	MapLen(key string) Batch
+	MapFieldSet(key, field string, value []byte) Batch
+	MapFieldGet(key, field string) Batch
+	MapFieldDel(key, field string) Batch
+	MapFieldIncrBy(key, field string, delta int64) Batch
+	MapFieldDecrBy(key, field string, delta int64) Batch
+
+	QueueNew(key string, ttlSec int32) Batch
+	QueueDel(key string) Batch
+	QueueLen(key string) Batch
+	QueuePush(key string, value []byte, opts ...PushOption) Batch
+	QueuePop(key string) Batch
+	QueuePeek(key string) Batch
+
+	// Exec sends the operations to the EventStore and returns
+	// their results in the same order they were added. The
+	// returned error is only informed when the batch could not
+	// be executed at all.
+	Exec(ctx context.Context) ([]BatchResult, error)
+}
+
+// BatchResult is the outcome of a batch operation. Only the
+// fields that apply to the operation are populated.
+type BatchResult struct {
+	// Value returned by get, pop and peek operations.
+	Value []byte
+	// Fields returned by MapFields.
+	Fields map[string][]byte
+	// Number returned by counter and length operations.
+	Number int64
+	// Version returned by Set and Get.
+	Version uint64
+	// Err is the error for the operation, nil when it succeeded.
+	Err error
+}
+
+type internalBatch struct {
+	*internalClient
+	ops []*eventstore.BatchOperation
+}
+
+var _ Batch = (*internalBatch)(nil)
+
+// Batch returns a builder for operations that
+// are executed in a single request.
+func (s *internalClient) Batch() Batch {
+	return &internalBatch{internalClient: s}
+}
+
+func (b *internalBatch) add(op *eventstore.BatchOperation) Batch {
+	b.ops = append(b.ops, op)
+	return b
+}
+
+// Set key/value at store
+func (b *internalBatch) Set(key string, value []byte, ttlSec int32) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_KvSet{
+		KvSet: &eventstore.SetKVRequest{Location: b.location(key), Ttl: ttlSec, Value: value},
+	}})
+}
+
+// Get value from EventStore
+func (b *internalBatch) Get(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_KvGet{
+		KvGet: &eventstore.GetKVRequest{Location: b.location(key)},
+	}})
+}
+
+// Del Value from EventStore
+func (b *internalBatch) Del(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_KvDel{
+		KvDel: &eventstore.DelKVRequest{Location: b.location(key)},
+	}})
+}
+
+// IncrBy increments the value at the key.
+func (b *internalBatch) IncrBy(key string, delta int64) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_KvIncr{
+		KvIncr: &eventstore.IncrKVRequest{Location: b.location(key), Incr: delta},
+	}})
+}
+
+// DecrBy decrements the value at the key.
+func (b *internalBatch) DecrBy(key string, delta int64) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_KvDecr{
+		KvDecr: &eventstore.DecrKVRequest{Location: b.location(key), Decr: delta},
+	}})
+}
+
+// MapNew creates a map at the key.
+func (b *internalBatch) MapNew(key string, ttlSec int32) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapNew{
+		MapNew: &eventstore.NewMapRequest{Location: b.location(key), Ttl: ttlSec},
+	}})
+}
+
+// MapDel removes the map at the key.
+func (b *internalBatch) MapDel(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapDel{
+		MapDel: &eventstore.DelMapRequest{Location: b.location(key)},
+	}})
+}
+
+// MapFields retrieves all fields of the map at the key.
+func (b *internalBatch) MapFields(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapGetFields{
+		MapGetFields: &eventstore.GetAllMapFieldsRequest{Location: b.location(key)},
+	}})
+}
+
+// MapLen retrieves the number of fields of the map at the key.
+func (b *internalBatch) MapLen(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapLen{
+		MapLen: &eventstore.LenMapRequest{Location: b.location(key)},
+	}})
+}
+
+// MapFieldSet sets a field of the map at the key.
+func (b *internalBatch) MapFieldSet(key, field string, value []byte) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapFieldSet{
+		MapFieldSet: &eventstore.SetMapFieldRequest{Location: b.location(key), Field: field, Value: value},
+	}})
+}
+
+// MapFieldGet retrieves a field of the map at the key.
+func (b *internalBatch) MapFieldGet(key, field string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapFieldGet{
+		MapFieldGet: &eventstore.GetMapFieldRequest{Location: b.location(key), Field: field},
+	}})
+}
+
+// MapFieldDel removes a field of the map at the key.
+func (b *internalBatch) MapFieldDel(key, field string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapFieldDel{
+		MapFieldDel: &eventstore.DelMapFieldRequest{Location: b.location(key), Field: field},
+	}})
+}
+
+// MapFieldIncrBy increments a field of the map at the key.
+func (b *internalBatch) MapFieldIncrBy(key, field string, delta int64) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapFieldIncr{
+		MapFieldIncr: &eventstore.IncrMapFieldRequest{Location: b.location(key), Field: field, Incr: delta},
+	}})
+}
+
+// MapFieldDecrBy decrements a field of the map at the key.
+func (b *internalBatch) MapFieldDecrBy(key, field string, delta int64) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_MapFieldDecr{
+		MapFieldDecr: &eventstore.DecrMapFieldRequest{Location: b.location(key), Field: field, Decr: delta},
+	}})
+}
+
+// QueueNew creates a queue at the key.
+func (b *internalBatch) QueueNew(key string, ttlSec int32) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_QueueNew{
+		QueueNew: &eventstore.NewQueueRequest{Location: b.location(key), Ttl: ttlSec},
+	}})
+}
+
+// QueueDel removes the queue at the key.
+func (b *internalBatch) QueueDel(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_QueueDel{
+		QueueDel: &eventstore.DelQueueRequest{Location: b.location(key)},
+	}})
+}
+
+// QueueLen retrieves the number of items of the queue at the key.
+func (b *internalBatch) QueueLen(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_QueueLen{
+		QueueLen: &eventstore.LenQueueRequest{Location: b.location(key)},
+	}})
+}
+
+// QueuePush adds an item to the queue at the key.
+func (b *internalBatch) QueuePush(key string, value []byte, opts ...PushOption) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_QueuePush{
+		QueuePush: pushRequest(b.location(key), value, opts),
+	}})
+}
+
+// QueuePop removes and retrieves the first item of the queue at the key.
+func (b *internalBatch) QueuePop(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_QueuePop{
+		QueuePop: &eventstore.PopQueueRequest{Location: b.location(key)},
+	}})
+}
+
+// QueuePeek retrieves the first item of the queue at the key.
+func (b *internalBatch) QueuePeek(key string) Batch {
+	return b.add(&eventstore.BatchOperation{Op: &eventstore.BatchOperation_QueuePeek{
+		QueuePeek: &eventstore.PeekQueueRequest{Location: b.location(key)},
+	}})
+}
+
+// Exec sends the operations to the EventStore. Each operation is
+// validated by the server, invalid operations fail on their own
+// without affecting the rest of the batch.
+func (b *internalBatch) Exec(ctx context.Context) ([]BatchResult, error) {
+	bc := b.svc.batchc
+	if bc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	if len(b.ops) == 0 {
+		return nil, nil
+	}
+
+	r := &eventstore.BatchRequest{Ops: b.ops}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := bc.Batch(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, 0, len(res.Results))
+	for _, r := range res.Results {
+		results = append(results, batchResult(r))
+	}
+
+	return results, nil
+}
+
+func batchResult(r *eventstore.BatchResult) BatchResult {
+	switch res := r.Result.(type) {
+	case *eventstore.BatchResult_KvSet:
+		return BatchResult{Version: res.KvSet.GetVersion()}
+	case *eventstore.BatchResult_KvGet:
+		return BatchResult{Value: res.KvGet.GetValue(), Version: res.KvGet.GetVersion()}
+	case *eventstore.BatchResult_KvIncr:
+		return BatchResult{Number: res.KvIncr.GetValue()}
+	case *eventstore.BatchResult_KvDecr:
+		return BatchResult{Number: res.KvDecr.GetValue()}
+	case *eventstore.BatchResult_MapGetFields:
+		return BatchResult{Fields: res.MapGetFields.GetValues()}
+	case *eventstore.BatchResult_MapLen:
+		return BatchResult{Number: int64(res.MapLen.GetLen())}
+	case *eventstore.BatchResult_MapFieldGet:
+		return BatchResult{Value: res.MapFieldGet.GetValue()}
+	case *eventstore.BatchResult_MapFieldIncr:
+		return BatchResult{Number: res.MapFieldIncr.GetValue()}
+	case *eventstore.BatchResult_MapFieldDecr:
+		return BatchResult{Number: res.MapFieldDecr.GetValue()}
+	case *eventstore.BatchResult_QueueLen:
+		return BatchResult{Number: int64(res.QueueLen.GetLen())}
+	case *eventstore.BatchResult_QueuePop:
+		return BatchResult{Value: res.QueuePop.GetValue()}
+	case *eventstore.BatchResult_QueuePeek:
+		return BatchResult{Value: res.QueuePeek.GetValue()}
+	case *eventstore.BatchResult_Error:
+		return BatchResult{Err: status.Error(codes.Code(res.Error.GetCode()), res.Error.GetMessage())}
+	}
+	return BatchResult{}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMultiKey(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	kv := c.Bridge(tBridge).KV()
+
+	require.NoError(t, kv.MSet(ctx, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, 60))
+	values, err := kv.MGet(ctx, "a", "b", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, values)
+
+	n, err := kv.MDel(ctx, "a", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = kv.MGet(ctx)
+	assert.Error(t, err, "keys should be informed")
+}
+
+func TestBatch(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+
+	res, err := es.Batch().
+		Set(tKey, tValue, 0).
+		IncrBy("counter", 5).
+		MapNew("map", 0).
+		MapFieldSet("map", "field", tValue).
+		MapFields("map").
+		QueuePush("missing", tValue).
+		QueueNew("queue", 0).
+		QueuePush("queue", tValue).
+		QueueLen("queue").
+		Get(tKey).
+		Exec(ctx)
+	require.NoError(t, err)
+	require.Len(t, res, 10)
+
+	for i, r := range res {
+		if i == 5 {
+			assert.Equal(t, codes.NotFound, status.Code(r.Err))
+			continue
+		}
+		assert.NoError(t, r.Err, "operation %d failed", i)
+	}
+	assert.NotZero(t, res[0].Version)
+	assert.Equal(t, int64(5), res[1].Number)
+	assert.Equal(t, map[string][]byte{"field": tValue}, res[4].Fields)
+	assert.Equal(t, int64(1), res[8].Number)
+	assert.Equal(t, tValue, res[9].Value)
+	assert.Equal(t, res[0].Version, res[9].Version)
+
+	res, err = es.Batch().Exec(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
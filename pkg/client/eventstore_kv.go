@@ -19,6 +19,10 @@ package client
 import (
 	"context"
 	"errors"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	eventstore "github.com/triggermesh/eventstore/pkg/protob"
 )
@@ -31,8 +35,14 @@ var _ KeyValue = (*internalKV)(nil)
 
 // Set key/value at store
 func (i *internalKV) Set(ctx context.Context, key string, value []byte, ttlSec int32) error {
+	_, err := i.SetWithVersion(ctx, key, value, ttlSec)
+	return err
+}
+
+// SetWithVersion sets key/value at store and returns the new version.
+func (i *internalKV) SetWithVersion(ctx context.Context, key string, value []byte, ttlSec int32) (uint64, error) {
 	if i.svc.kvc == nil {
-		return errors.New("EventStore client is not connected")
+		return 0, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.SetKVRequest{
@@ -57,17 +67,27 @@ func (i *internalKV) Set(ctx context.Context, key string, value []byte, ttlSec i
 	}
 
 	if err := r.Validate(); err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err := i.svc.kvc.Set(ctx, r)
-	return err
+	res, err := i.svc.kvc.Set(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetVersion(), nil
 }
 
 // Get value from EventStore
 func (i *internalKV) Get(ctx context.Context, key string) ([]byte, error) {
+	v, _, err := i.GetWithVersion(ctx, key)
+	return v, err
+}
+
+// GetWithVersion returns the value and its version from EventStore
+func (i *internalKV) GetWithVersion(ctx context.Context, key string) ([]byte, uint64, error) {
 	if i.svc.kvc == nil {
-		return nil, errors.New("EventStore client is not connected")
+		return nil, 0, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.GetKVRequest{
@@ -88,15 +108,15 @@ func (i *internalKV) Get(ctx context.Context, key string) ([]byte, error) {
 	}
 
 	if err := r.Validate(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	res, err := i.svc.kvc.Get(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return res.GetValue(), nil
+	return res.GetValue(), res.GetVersion(), nil
 }
 
 // Del Value from EventStore
@@ -130,10 +150,122 @@ func (i *internalKV) Del(ctx context.Context, key string) error {
 	return err
 }
 
+// TTL returns the remaining time to live in seconds for the value,
+// zero meaning that it never expires.
+func (i *internalKV) TTL(ctx context.Context, key string) (int32, error) {
+	if i.svc.kvc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TTLKVRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}}}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.TTL(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetTtl(), nil
+}
+
+// Expire sets a new time to live in seconds for the value.
+func (i *internalKV) Expire(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.kvc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ExpireKVRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}},
+		Ttl: ttlSec,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.kvc.Expire(ctx, r)
+	return err
+}
+
+// Persist removes the time to live of the value.
+func (i *internalKV) Persist(ctx context.Context, key string) error {
+	if i.svc.kvc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PersistKVRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}}}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.kvc.Persist(ctx, r)
+	return err
+}
+
 // Incr integer for key.
 func (i *internalKV) Incr(ctx context.Context, key string, incr int32) error {
+	_, err := i.IncrBy(ctx, key, int64(incr))
+	return err
+}
+
+// Decr integer for key.
+func (i *internalKV) Decr(ctx context.Context, key string, decr int32) error {
+	_, err := i.DecrBy(ctx, key, int64(decr))
+	return err
+}
+
+// IncrBy integer for key, returning the resulting value.
+func (i *internalKV) IncrBy(ctx context.Context, key string, incr int64) (int64, error) {
 	if i.svc.kvc == nil {
-		return errors.New("EventStore client is not connected")
+		return 0, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.IncrKVRequest{
@@ -157,17 +289,21 @@ func (i *internalKV) Incr(ctx context.Context, key string, incr int32) error {
 	}
 
 	if err := r.Validate(); err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err := i.svc.kvc.Incr(ctx, r)
-	return err
+	res, err := i.svc.kvc.Incr(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetValue(), nil
 }
 
-// Decr integer for key.
-func (i *internalKV) Decr(ctx context.Context, key string, decr int32) error {
+// DecrBy integer for key, returning the resulting value.
+func (i *internalKV) DecrBy(ctx context.Context, key string, decr int64) (int64, error) {
 	if i.svc.kvc == nil {
-		return errors.New("EventStore client is not connected")
+		return 0, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.DecrKVRequest{
@@ -190,10 +326,271 @@ func (i *internalKV) Decr(ctx context.Context, key string, decr int32) error {
 		r.Location.Scope.Type = eventstore.ScopeChoice_Global
 	}
 
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.Decr(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetValue(), nil
+}
+
+// IncrByFloat floating point number for key, returning the
+// resulting value.
+func (i *internalKV) IncrByFloat(ctx context.Context, key string, incr float64) (float64, error) {
+	if i.svc.kvc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.IncrFloatKVRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			},
+			Key: key,
+		},
+		Incr: incr,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.IncrFloat(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetValue(), nil
+}
+
+// CompareAndSwap sets key/value at store if the current version
+// matches, returning the new version.
+func (i *internalKV) CompareAndSwap(ctx context.Context, key string, value []byte, ttlSec int32, version uint64) (uint64, error) {
+	if i.svc.kvc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.CompareAndSwapKVRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			},
+			Key: key,
+		},
+		Ttl:     ttlSec,
+		Value:   value,
+		Version: version,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.CompareAndSwap(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetVersion(), nil
+}
+
+// SetIfNotExists sets key/value at store if the key does not exist,
+// returning the new version.
+func (i *internalKV) SetIfNotExists(ctx context.Context, key string, value []byte, ttlSec int32) (uint64, error) {
+	if i.svc.kvc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.SetIfNotExistsKVRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			},
+			Key: key,
+		},
+		Ttl:   ttlSec,
+		Value: value,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.SetIfNotExists(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetVersion(), nil
+}
+
+// SetIfExists sets key/value at store if the key exists,
+// returning the new version.
+func (i *internalKV) SetIfExists(ctx context.Context, key string, value []byte, ttlSec int32) (uint64, error) {
+	if i.svc.kvc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.SetIfExistsKVRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			},
+			Key: key,
+		},
+		Ttl:   ttlSec,
+		Value: value,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.SetIfExists(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetVersion(), nil
+}
+
+// MGet returns the values stored at the keys, missing keys are
+// not included in the result.
+func (i *internalKV) MGet(ctx context.Context, keys ...string) (map[string][]byte, error) {
+	if i.svc.kvc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.MGetKVRequest{
+		Scope: i.scope(),
+		Keys:  keys,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.kvc.MGet(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte, len(res.Items))
+	for _, item := range res.Items {
+		if item.Found {
+			values[item.Key] = item.Value
+		}
+	}
+
+	return values, nil
+}
+
+// MSet stores all values using the same TTL.
+func (i *internalKV) MSet(ctx context.Context, values map[string][]byte, ttlSec int32) error {
+	if i.svc.kvc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.MSetKVRequest{
+		Scope:   i.scope(),
+		Entries: make([]*eventstore.KVEntry, 0, len(values)),
+	}
+
+	for k, v := range values {
+		r.Entries = append(r.Entries, &eventstore.KVEntry{
+			Key:   k,
+			Ttl:   ttlSec,
+			Value: v,
+		})
+	}
+	sort.Slice(r.Entries, func(a, b int) bool {
+		return r.Entries[a].Key < r.Entries[b].Key
+	})
+
 	if err := r.Validate(); err != nil {
 		return err
 	}
 
-	_, err := i.svc.kvc.Decr(ctx, r)
+	_, err := i.svc.kvc.MSet(ctx, r)
 	return err
 }
+
+// MDel removes the values at the keys and returns the number of
+// values removed, missing keys are skipped.
+func (i *internalKV) MDel(ctx context.Context, keys ...string) (int, error) {
+	if i.svc.kvc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.MDelKVRequest{
+		Scope: i.scope(),
+		Keys:  keys,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.kvc.MDel(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.Keys), nil
+}
+
+// Lock locks key temporarily through the KV service, returning
+// a handle to the lock.
+func (i *internalKV) Lock(ctx context.Context, key string, timeout int32, opts ...LockOption) (*Lock, error) {
+	return (&internalSync{i.internalClient}).lock(ctx, i.svc.kvc, key, timeout, opts)
+}
+
+// IsConflict returns whether the error was returned by a conditional
+// write whose condition did not hold.
+func IsConflict(err error) bool {
+	return status.Code(err) == codes.Aborted
+}
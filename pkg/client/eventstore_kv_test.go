@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalSet(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	kv := c.Instance(tBridge, tInstance).KV()
+
+	_, err := kv.SetIfExists(ctx, tKey, tValue, 0)
+	assert.True(t, IsConflict(err), "unexpected error %v", err)
+
+	created, err := kv.SetIfNotExists(ctx, tKey, tValue, 0)
+	require.NoError(t, err)
+	_, err = kv.SetIfNotExists(ctx, tKey, tValue, 0)
+	assert.True(t, IsConflict(err), "unexpected error %v", err)
+
+	v, version, err := kv.GetWithVersion(ctx, tKey)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+	assert.Equal(t, created, version)
+
+	// Concurrent writers fail to swap stale versions.
+	updated, err := kv.SetWithVersion(ctx, tKey, []byte("other"), 0)
+	require.NoError(t, err)
+	_, err = kv.CompareAndSwap(ctx, tKey, []byte("mine"), 0, version)
+	assert.True(t, IsConflict(err), "unexpected error %v", err)
+
+	swapped, err := kv.CompareAndSwap(ctx, tKey, []byte("mine"), 0, updated)
+	require.NoError(t, err)
+	assert.NotEqual(t, updated, swapped)
+
+	_, err = kv.CompareAndSwap(ctx, tKey, tValue, 0, 0)
+	assert.Error(t, err, "swaps should inform a version")
+}
+
+func TestTTL(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Bridge(tBridge)
+	require.NoError(t, es.KV().Set(ctx, tKey, tValue, 60))
+	require.NoError(t, es.Map().New(ctx, tKey+"-map", 30))
+
+	ttl, err := es.KV().TTL(ctx, tKey)
+	require.NoError(t, err)
+	assert.Equal(t, int32(60), ttl)
+
+	require.NoError(t, es.KV().Expire(ctx, tKey, 120))
+	ttl, err = es.KV().TTL(ctx, tKey)
+	require.NoError(t, err)
+	assert.Equal(t, int32(120), ttl)
+
+	require.NoError(t, es.KV().Persist(ctx, tKey))
+	ttl, err = es.KV().TTL(ctx, tKey)
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+
+	require.NoError(t, es.Map().Persist(ctx, tKey+"-map"))
+	ttl, err = es.Map().TTL(ctx, tKey+"-map")
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+
+	assert.Error(t, es.KV().Expire(ctx, tKey, 0), "expiring should inform a TTL")
+	assert.Error(t, es.Queue().Persist(ctx, "missing"))
+}
+
+func TestCounters(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	kv := c.Bridge(tBridge).KV()
+
+	n, err := kv.IncrBy(ctx, tKey, math.MaxInt32+1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt32+1), n)
+	n, err = kv.DecrBy(ctx, tKey, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt32), n)
+
+	f, err := kv.IncrByFloat(ctx, tKey+"-float", 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, f)
+	_, err = kv.IncrByFloat(ctx, tKey+"-float", math.Inf(1))
+	assert.Error(t, err, "increments should be finite")
+
+	m := c.Bridge(tBridge).Map()
+	require.NoError(t, m.New(ctx, tKey+"-map", 0))
+	n, err = m.Fields(tKey+"-map").DecrBy(ctx, "counter", 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-3), n)
+	f, err = m.Fields(tKey+"-map").IncrByFloat(ctx, "counter", 1.25)
+	require.NoError(t, err)
+	assert.Equal(t, -1.75, f)
+}
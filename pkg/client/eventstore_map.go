@@ -19,6 +19,7 @@ package client
 import (
 	"context"
 	"errors"
+	"sort"
 
 	eventstore "github.com/triggermesh/eventstore/pkg/protob"
 )
@@ -109,22 +110,56 @@ func (i *internalMap) Del(ctx context.Context, key string) error {
 	return err
 }
 
-// Set map field.
-func (i *internalMapFields) Set(ctx context.Context, key string, value []byte) error {
+// TTL returns the remaining time to live in seconds for the map,
+// zero meaning that it never expires.
+func (i *internalMap) TTL(ctx context.Context, key string) (int32, error) {
+	if i.svc.mapc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TTLMapRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}}}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.mapc.TTL(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetTtl(), nil
+}
+
+// Expire sets a new time to live in seconds for the map.
+func (i *internalMap) Expire(ctx context.Context, key string, ttlSec int32) error {
 	if i.svc.mapc == nil {
 		return errors.New("EventStore client is not connected")
 	}
 
-	r := &eventstore.SetMapFieldRequest{
+	r := &eventstore.ExpireMapRequest{
 		Location: &eventstore.LocationType{
+			Key: key,
 			Scope: &eventstore.ScopeType{
 				Bridge:   i.bridge,
 				Instance: i.instance,
-			},
-			Key: i.key,
-		},
-		Field: key,
-		Value: value,
+			}},
+		Ttl: ttlSec,
 	}
 
 	switch {
@@ -140,10 +175,173 @@ func (i *internalMapFields) Set(ctx context.Context, key string, value []byte) e
 		return err
 	}
 
+	_, err := i.svc.mapc.Expire(ctx, r)
+	return err
+}
+
+// Persist removes the time to live of the map.
+func (i *internalMap) Persist(ctx context.Context, key string) error {
+	if i.svc.mapc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PersistMapRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}}}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.mapc.Persist(ctx, r)
+	return err
+}
+
+// MapLock is a handle to a lock held on a map, which scopes
+// the field operations made while holding it.
+type MapLock struct {
+	*Lock
+
+	fields MapFields
+}
+
+// Lock locks the map temporarily through the Map service, returning
+// a handle to the lock.
+func (i *internalMap) Lock(ctx context.Context, key string, timeout int32, opts ...LockOption) (*MapLock, error) {
+	l, err := (&internalSync{i.internalClient}).lock(ctx, i.svc.mapc, key, timeout, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MapLock{Lock: l, fields: i.Fields(key)}, nil
+}
+
+// Fields operates on the fields of the locked map.
+func (l *MapLock) Fields() MapFields {
+	return l.fields
+}
+
+// Set map field.
+func (i *internalMapFields) Set(ctx context.Context, key string, value []byte) error {
+	return i.SetWithTTL(ctx, key, value, 0)
+}
+
+// SetWithTTL sets the map field, which expires after the TTL.
+func (i *internalMapFields) SetWithTTL(ctx context.Context, key string, value []byte, ttlSec int32) error {
+	if i.svc.mapc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.SetMapFieldRequest{
+		Location: i.location(i.key),
+		Field:    key,
+		Value:    value,
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
 	_, err := i.svc.mapc.FieldSet(ctx, r)
 	return err
 }
 
+// SetIfNotExists sets the map field if it does not exist.
+func (i *internalMapFields) SetIfNotExists(ctx context.Context, key string, value []byte, ttlSec int32) error {
+	if i.svc.mapc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.SetIfNotExistsMapFieldRequest{
+		Location: i.location(i.key),
+		Field:    key,
+		Value:    value,
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.mapc.FieldSetIfNotExists(ctx, r)
+	return err
+}
+
+// MGet returns the values stored at the map fields, missing
+// fields are not included in the result.
+func (i *internalMapFields) MGet(ctx context.Context, keys ...string) (map[string][]byte, error) {
+	if i.svc.mapc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.MGetMapFieldsRequest{
+		Location: i.location(i.key),
+		Fields:   keys,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.mapc.FieldMGet(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte, len(res.Items))
+	for _, item := range res.Items {
+		if item.Found {
+			values[item.Field] = item.Value
+		}
+	}
+
+	return values, nil
+}
+
+// MSet sets all map fields using the same TTL.
+func (i *internalMapFields) MSet(ctx context.Context, values map[string][]byte, ttlSec int32) error {
+	if i.svc.mapc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.MSetMapFieldsRequest{
+		Location: i.location(i.key),
+		Entries:  make([]*eventstore.MapFieldEntry, 0, len(values)),
+	}
+
+	for k, v := range values {
+		r.Entries = append(r.Entries, &eventstore.MapFieldEntry{
+			Field: k,
+			Ttl:   ttlSec,
+			Value: v,
+		})
+	}
+	sort.Slice(r.Entries, func(a, b int) bool {
+		return r.Entries[a].Field < r.Entries[b].Field
+	})
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.mapc.FieldMSet(ctx, r)
+	return err
+}
+
 // Get map field.
 func (i *internalMapFields) Get(ctx context.Context, key string) ([]byte, error) {
 	if i.svc.mapc == nil {
@@ -218,8 +416,20 @@ func (i *internalMapFields) Del(ctx context.Context, key string) error {
 
 // Incr integer for field.
 func (i *internalMapFields) Incr(ctx context.Context, key string, value int32) error {
+	_, err := i.IncrBy(ctx, key, int64(value))
+	return err
+}
+
+// Decr integer for field.
+func (i *internalMapFields) Decr(ctx context.Context, key string, value int32) error {
+	_, err := i.DecrBy(ctx, key, int64(value))
+	return err
+}
+
+// IncrBy integer for field, returning the resulting value.
+func (i *internalMapFields) IncrBy(ctx context.Context, key string, value int64) (int64, error) {
 	if i.svc.mapc == nil {
-		return errors.New("EventStore client is not connected")
+		return 0, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.IncrMapFieldRequest{
@@ -244,17 +454,21 @@ func (i *internalMapFields) Incr(ctx context.Context, key string, value int32) e
 	}
 
 	if err := r.Validate(); err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err := i.svc.mapc.FieldIncr(ctx, r)
-	return err
+	res, err := i.svc.mapc.FieldIncr(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetValue(), nil
 }
 
-// Decr integer for field.
-func (i *internalMapFields) Decr(ctx context.Context, key string, value int32) error {
+// DecrBy integer for field, returning the resulting value.
+func (i *internalMapFields) DecrBy(ctx context.Context, key string, value int64) (int64, error) {
 	if i.svc.mapc == nil {
-		return errors.New("EventStore client is not connected")
+		return 0, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.DecrMapFieldRequest{
@@ -279,11 +493,55 @@ func (i *internalMapFields) Decr(ctx context.Context, key string, value int32) e
 	}
 
 	if err := r.Validate(); err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err := i.svc.mapc.FieldDecr(ctx, r)
-	return err
+	res, err := i.svc.mapc.FieldDecr(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetValue(), nil
+}
+
+// IncrByFloat floating point number for field, returning the
+// resulting value.
+func (i *internalMapFields) IncrByFloat(ctx context.Context, key string, value float64) (float64, error) {
+	if i.svc.mapc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.IncrFloatMapFieldRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			},
+			Key: i.key,
+		},
+		Field: key,
+		Incr:  value,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.mapc.FieldIncrFloat(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetValue(), nil
 }
 
 // All elements in a map.
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMapFields(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Bridge(tBridge)
+	fields := es.Map().Fields("join")
+
+	err := fields.MSet(ctx, map[string][]byte{"a": []byte("1")}, 0)
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+
+	require.NoError(t, es.Map().New(ctx, "join", 0))
+	require.NoError(t, fields.MSet(ctx, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, 60))
+
+	require.NoError(t, fields.SetIfNotExists(ctx, "c", []byte("3"), 60))
+	err = fields.SetIfNotExists(ctx, "a", []byte("other"), 60)
+	assert.True(t, IsConflict(err), "unexpected error %v", err)
+
+	require.NoError(t, fields.SetWithTTL(ctx, "d", []byte("4"), 30))
+
+	values, err := fields.MGet(ctx, "a", "c", "d", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1"), "c": []byte("3"), "d": []byte("4")}, values)
+
+	_, err = fields.MGet(ctx)
+	assert.Error(t, err)
+}
+
+func TestMapLock(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Bridge(tBridge)
+	require.NoError(t, es.Map().New(ctx, "order", 0))
+
+	lock, err := es.Map().Lock(ctx, "order", 10)
+	require.NoError(t, err)
+
+	// The lock namespace is shared with the KV and Sync services.
+	_, err = es.KV().Lock(ctx, "order", 10)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "unexpected error %v", err)
+
+	err = lock.WithLock(ctx, func(ctx context.Context) error {
+		return lock.Fields().MSet(ctx, map[string][]byte{"status": []byte("paid"), "total": []byte("10")}, 0)
+	})
+	require.NoError(t, err)
+
+	values, err := es.Map().Fields("order").All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"status": []byte("paid"), "total": []byte("10")}, values)
+
+	// The lock is released even if the function fails.
+	kvLock, err := es.KV().Lock(ctx, "order", 10)
+	require.NoError(t, err)
+	err = kvLock.WithLock(ctx, func(ctx context.Context) error {
+		return status.Error(codes.Internal, "failed")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err), "unexpected error %v", err)
+
+	info, err := es.Sync().LockInfo(ctx, "order")
+	require.NoError(t, err)
+	assert.False(t, info.Locked)
+
+	// Failing to release the lock is reported.
+	lock, err = es.Map().Lock(ctx, "order", 10)
+	require.NoError(t, err)
+	err = lock.WithLock(ctx, func(ctx context.Context) error {
+		return es.Sync().Unlock(ctx, "order", lock.UnlockCode())
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+}
@@ -23,6 +23,61 @@ import (
 	eventstore "github.com/triggermesh/eventstore/pkg/protob"
 )
 
+// Overflow is the policy applied when pushing to a full queue.
+type Overflow int
+
+// Queue overflow policies.
+const (
+	// OverflowReject fails pushes to full queues.
+	OverflowReject Overflow = iota
+	// OverflowDropOldest removes the element at the opposite
+	// end of the queue to make room for the pushed one.
+	OverflowDropOldest
+)
+
+// QueueOption customizes a new queue.
+type QueueOption func(*eventstore.NewQueueRequest)
+
+// WithMaxLen limits the number of elements at the queue,
+// applying the overflow policy when pushing to a full queue.
+func WithMaxLen(max int32, overflow Overflow) QueueOption {
+	return func(r *eventstore.NewQueueRequest) {
+		r.MaxLen = max
+		r.Overflow = eventstore.QueueOverflow_OverflowReject
+		if overflow == OverflowDropOldest {
+			r.Overflow = eventstore.QueueOverflow_OverflowDropOldest
+		}
+	}
+}
+
+// PushOption customizes how an item is pushed to a queue.
+type PushOption func(*eventstore.PushQueueRequest)
+
+// WithPriority pushes the item with a priority, items with higher
+// priorities are popped first. Items are pushed with priority zero
+// by default, negative priorities being popped last.
+func WithPriority(priority int32) PushOption {
+	return func(r *eventstore.PushQueueRequest) {
+		r.Priority = priority
+	}
+}
+
+// WithDelay hides the pushed item for the number of seconds.
+func WithDelay(delaySec int32) PushOption {
+	return func(r *eventstore.PushQueueRequest) {
+		r.Delay = delaySec
+	}
+}
+
+// pushRequest returns the request for pushing the value with the options.
+func pushRequest(loc *eventstore.LocationType, value []byte, opts []PushOption) *eventstore.PushQueueRequest {
+	r := &eventstore.PushQueueRequest{Location: loc, Value: value}
+	for _, f := range opts {
+		f(r)
+	}
+	return r
+}
+
 type internalQueue struct {
 	*internalClient
 }
@@ -37,7 +92,7 @@ var _ Queue = (*internalQueue)(nil)
 var _ QueueItems = (*internalQueueItems)(nil)
 
 // Set key/value at store
-func (i *internalQueue) New(ctx context.Context, key string, ttlSec int32) error {
+func (i *internalQueue) New(ctx context.Context, key string, ttlSec int32, opts ...QueueOption) error {
 	if i.svc.queuec == nil {
 		return errors.New("EventStore client is not connected")
 	}
@@ -52,6 +107,9 @@ func (i *internalQueue) New(ctx context.Context, key string, ttlSec int32) error
 		},
 		Ttl: ttlSec,
 	}
+	for _, f := range opts {
+		f(r)
+	}
 
 	switch {
 	case i.instance != "":
@@ -109,8 +167,108 @@ func (i *internalQueue) Del(ctx context.Context, key string) error {
 	return err
 }
 
-// Push item to the queue.
-func (i *internalQueueItems) Push(ctx context.Context, value []byte) error {
+// TTL returns the remaining time to live in seconds for the queue,
+// zero meaning that it never expires.
+func (i *internalQueue) TTL(ctx context.Context, key string) (int32, error) {
+	if i.svc.queuec == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TTLQueueRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}}}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.queuec.TTL(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetTtl(), nil
+}
+
+// Expire sets a new time to live in seconds for the queue.
+func (i *internalQueue) Expire(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.queuec == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ExpireQueueRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}},
+		Ttl: ttlSec,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.queuec.Expire(ctx, r)
+	return err
+}
+
+// Persist removes the time to live of the queue.
+func (i *internalQueue) Persist(ctx context.Context, key string) error {
+	if i.svc.queuec == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PersistQueueRequest{
+		Location: &eventstore.LocationType{
+			Key: key,
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			}}}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.queuec.Persist(ctx, r)
+	return err
+}
+
+// Push item to the queue, behind the rest of the items with the same priority.
+func (i *internalQueueItems) Push(ctx context.Context, value []byte, opts ...PushOption) error {
 	if i.svc.queuec == nil {
 		return errors.New("EventStore client is not connected")
 	}
@@ -135,6 +293,10 @@ func (i *internalQueueItems) Push(ctx context.Context, value []byte) error {
 		r.Location.Scope.Type = eventstore.ScopeChoice_Global
 	}
 
+	for _, f := range opts {
+		f(r)
+	}
+
 	if err := r.Validate(); err != nil {
 		return err
 	}
@@ -180,6 +342,47 @@ func (i *internalQueueItems) Pop(ctx context.Context) ([]byte, error) {
 	return res.GetValue(), nil
 }
 
+// PopWait item, removing it from the queue. When the queue is empty
+// it waits for an item to be pushed for timeout seconds, zero meaning
+// until the context is done. Consumers waiting at the same queue
+// receive items in the order they started waiting.
+func (i *internalQueueItems) PopWait(ctx context.Context, timeout int32) ([]byte, error) {
+	if i.svc.queuec == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.BlockingPopQueueRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   i.bridge,
+				Instance: i.instance,
+			},
+			Key: i.key,
+		},
+		Timeout: timeout,
+	}
+
+	switch {
+	case i.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case i.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.queuec.BlockingPop(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetValue(), nil
+}
+
 // Peek item, keep it in the queue
 func (i *internalQueueItems) Peek(ctx context.Context) ([]byte, error) {
 	if i.svc.queuec == nil {
@@ -320,3 +523,86 @@ func (i *internalQueueItems) Len(ctx context.Context) (int, error) {
 	res, err := i.svc.queuec.Len(ctx, r)
 	return int(res.GetLen()), err
 }
+
+// PushFront item, inserting it at the head of the queue
+func (i *internalQueueItems) PushFront(ctx context.Context, value []byte) error {
+	if i.svc.queuec == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PushFrontQueueRequest{
+		Location: i.location(i.key),
+		Value:    value,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.queuec.PushFront(ctx, r)
+	return err
+}
+
+// PopBack item, removing it from the tail of the queue
+func (i *internalQueueItems) PopBack(ctx context.Context) ([]byte, error) {
+	if i.svc.queuec == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PopBackQueueRequest{
+		Location: i.location(i.key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.queuec.PopBack(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetValue(), nil
+}
+
+// Range items between the start and stop indexes, both inclusive.
+// Negative indexes count from the tail of the queue.
+func (i *internalQueueItems) Range(ctx context.Context, start, stop int32) ([][]byte, error) {
+	if i.svc.queuec == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RangeQueueRequest{
+		Location: i.location(i.key),
+		Start:    start,
+		Stop:     stop,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.queuec.Range(ctx, r)
+	return res.GetValues(), err
+}
+
+// Trim items out of the start and stop indexes, both inclusive.
+// Negative indexes count from the tail of the queue.
+func (i *internalQueueItems) Trim(ctx context.Context, start, stop int32) error {
+	if i.svc.queuec == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TrimQueueRequest{
+		Location: i.location(i.key),
+		Start:    start,
+		Stop:     stop,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.queuec.Trim(ctx, r)
+	return err
+}
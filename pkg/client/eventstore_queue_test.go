@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPopWait(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.Queue().New(ctx, "events", 0))
+	items := es.Queue().Items("events")
+
+	_, err := items.PopWait(ctx, 1)
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		assert.NoError(t, items.Push(ctx, tValue))
+	}()
+
+	v, err := items.PopWait(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = items.PopWait(ctx, 0)
+	assert.Error(t, err)
+}
+
+func TestReserve(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.Queue().New(ctx, "events", 0))
+	require.NoError(t, es.Queue().New(ctx, "failed", 0))
+	items := es.Queue().Items("events")
+	require.NoError(t, items.Push(ctx, tValue))
+
+	r, err := items.Reserve(ctx, 30, WithMaxDeliveries(2, "failed"))
+	require.NoError(t, err)
+	assert.Equal(t, tValue, r.Value)
+	assert.Equal(t, 1, r.Deliveries)
+
+	require.NoError(t, items.Nack(ctx, r.ID))
+	r, err = items.Reserve(ctx, 30, WithMaxDeliveries(2, "failed"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, r.Deliveries)
+
+	require.NoError(t, items.Nack(ctx, r.ID))
+	_, err = items.Reserve(ctx, 30, WithMaxDeliveries(2, "failed"))
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+
+	v, err := es.Queue().Items("failed").Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+
+	require.NoError(t, items.Push(ctx, tValue))
+	r, err = items.Reserve(ctx, 30)
+	require.NoError(t, err)
+	require.NoError(t, items.Ack(ctx, r.ID))
+	assert.Equal(t, codes.NotFound, status.Code(items.Ack(ctx, r.ID)))
+}
+
+func TestBoundedQueue(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.Queue().New(ctx, "recent", 0, WithMaxLen(3, OverflowDropOldest)))
+	items := es.Queue().Items("recent")
+
+	for _, v := range []string{"b", "c", "d", "e"} {
+		require.NoError(t, items.Push(ctx, []byte(v)))
+	}
+	require.NoError(t, items.PushFront(ctx, []byte("a")))
+
+	values, err := items.Range(ctx, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("c"), []byte("d")}, values)
+
+	v, err := items.PopBack(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("d"), v)
+
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := es.Watch(wctx, "recent")
+	require.NoError(t, err)
+
+	require.NoError(t, items.Trim(ctx, -1, -1))
+	values, err = items.Range(ctx, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("c")}, values)
+	assert.Equal(t, Event{Type: EventTrim, Key: "recent", Field: "-1:-1"}, receive(t, events))
+
+	require.NoError(t, es.Queue().New(ctx, "bounded", 0, WithMaxLen(1, OverflowReject)))
+	require.NoError(t, es.Queue().Items("bounded").Push(ctx, tValue))
+	err = es.Queue().Items("bounded").PushFront(ctx, tValue)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "unexpected error %v", err)
+}
+
+func TestPushOptions(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.Queue().New(ctx, "events", 0))
+	items := es.Queue().Items("events")
+
+	require.NoError(t, items.Push(ctx, []byte("normal")))
+	require.NoError(t, items.Push(ctx, []byte("urgent"), WithPriority(10)))
+	require.NoError(t, items.Push(ctx, []byte("retry"), WithPriority(10), WithDelay(60)))
+
+	_, err := es.Batch().QueuePush("events", []byte("high"), WithPriority(5)).Exec(ctx)
+	require.NoError(t, err)
+
+	v, err := items.Peek(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("urgent"), v)
+
+	all, err := items.All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("urgent"), []byte("high"), []byte("normal")}, all)
+
+	err = items.Push(ctx, tValue, WithDelay(-1))
+	assert.Error(t, err)
+}
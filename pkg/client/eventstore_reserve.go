@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// Reservation is a queue item delivered to a consumer, which
+// needs to acknowledge it using its ID once processed.
+type Reservation struct {
+	ID    string
+	Value []byte
+	// Deliveries is the number of times the item has been
+	// delivered, including this one.
+	Deliveries int
+}
+
+// ReserveOption customizes a reserve request.
+type ReserveOption func(*eventstore.ReserveQueueRequest)
+
+// WithMaxDeliveries moves items that have been delivered max times
+// to the dead-letter queue at the same scope, instead of delivering
+// them again. An empty dead-letter queue drops the items.
+func WithMaxDeliveries(max int32, deadLetter string) ReserveOption {
+	return func(r *eventstore.ReserveQueueRequest) {
+		r.MaxDeliveries = max
+		r.DeadLetter = deadLetter
+	}
+}
+
+// Reserve item, hiding it from other consumers for visibility seconds.
+// Items that are not acknowledged using Ack before the visibility
+// timeout elapses, or released using Nack, are delivered again.
+func (i *internalQueueItems) Reserve(ctx context.Context, visibility int32, opts ...ReserveOption) (Reservation, error) {
+	if i.svc.queuec == nil {
+		return Reservation{}, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ReserveQueueRequest{
+		Location:   i.location(i.key),
+		Visibility: visibility,
+	}
+	for _, f := range opts {
+		f(r)
+	}
+
+	if err := r.Validate(); err != nil {
+		return Reservation{}, err
+	}
+
+	res, err := i.svc.queuec.Reserve(ctx, r)
+	if err != nil {
+		return Reservation{}, err
+	}
+
+	return Reservation{
+		ID:         res.GetId(),
+		Value:      res.GetValue(),
+		Deliveries: int(res.GetDeliveries()),
+	}, nil
+}
+
+// Ack removes a reserved item from the queue.
+func (i *internalQueueItems) Ack(ctx context.Context, id string) error {
+	if i.svc.queuec == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.AckQueueRequest{
+		Location: i.location(i.key),
+		Id:       id,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.queuec.Ack(ctx, r)
+	return err
+}
+
+// Nack releases a reserved item so that it is delivered again.
+func (i *internalQueueItems) Nack(ctx context.Context, id string) error {
+	if i.svc.queuec == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.NackQueueRequest{
+		Location: i.location(i.key),
+		Id:       id,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.queuec.Nack(ctx, r)
+	return err
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// KeyType is the kind of value held by a key.
+type KeyType string
+
+// Kinds of values held by keys.
+const (
+	KeyTypeKV        KeyType = "kv"
+	KeyTypeMap       KeyType = "map"
+	KeyTypeQueue     KeyType = "queue"
+	KeyTypeSet       KeyType = "set"
+	KeyTypeSortedSet KeyType = "sorted-set"
+	KeyTypeStream    KeyType = "stream"
+)
+
+// KeyInfo describes a key stored at a scope. TTL is the remaining
+// time to live, zero meaning that the key never expires.
+type KeyInfo struct {
+	Key  string
+	Type KeyType
+	TTL  time.Duration
+}
+
+// Keys returns the keys at the scope that start with the prefix,
+// which can be empty to list the whole scope. The keys are retrieved
+// in pages, keys written or removed while listing might be missing.
+func (s *internalClient) Keys(ctx context.Context, prefix string) ([]KeyInfo, error) {
+	sc := s.svc.scopec
+	if sc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ScanScopeRequest{
+		Scope: &eventstore.ScopeType{
+			Bridge:   s.bridge,
+			Instance: s.instance,
+		},
+		Prefix: prefix,
+	}
+
+	switch {
+	case s.instance != "":
+		r.Scope.Type = eventstore.ScopeChoice_Instance
+	case s.bridge != "":
+		r.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	var keys []KeyInfo
+	for {
+		res, err := sc.Scan(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range res.Keys {
+			keys = append(keys, KeyInfo{
+				Key:  k.Key,
+				Type: keyType(k.Type),
+				TTL:  time.Duration(k.Ttl) * time.Second,
+			})
+		}
+
+		if res.Cursor == "" {
+			return keys, nil
+		}
+		r.Cursor = res.Cursor
+	}
+}
+
+// DropScope removes all data at the bridge, including its instances,
+// or at the instance. The global scope cannot be dropped.
+func (s *internalClient) DropScope(ctx context.Context) (int, error) {
+	sc := s.svc.scopec
+	if sc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.DropScopeRequest{
+		Scope: &eventstore.ScopeType{
+			Bridge:   s.bridge,
+			Instance: s.instance,
+		},
+	}
+
+	switch {
+	case s.instance != "":
+		r.Scope.Type = eventstore.ScopeChoice_Instance
+	case s.bridge != "":
+		r.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := sc.Drop(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.Keys), nil
+}
+
+func keyType(t eventstore.KeyType) KeyType {
+	switch t {
+	case eventstore.KeyType_TypeMap:
+		return KeyTypeMap
+	case eventstore.KeyType_TypeQueue:
+		return KeyTypeQueue
+	case eventstore.KeyType_TypeSet:
+		return KeyTypeSet
+	case eventstore.KeyType_TypeSortedSet:
+		return KeyTypeSortedSet
+	case eventstore.KeyType_TypeStream:
+		return KeyTypeStream
+	}
+	return KeyTypeKV
+}
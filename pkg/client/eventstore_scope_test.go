@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeys(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Bridge(tBridge)
+	require.NoError(t, es.KV().Set(ctx, tKey, tValue, 60))
+	require.NoError(t, es.Map().New(ctx, tKey+"-map", 0))
+	require.NoError(t, es.Queue().New(ctx, "other", 0))
+	require.NoError(t, c.Global().KV().Set(ctx, tKey+"-global", tValue, 0))
+
+	keys, err := es.Keys(ctx, tKey)
+	require.NoError(t, err)
+	assert.Equal(t, []KeyInfo{
+		{Key: tKey, Type: KeyTypeKV, TTL: time.Minute},
+		{Key: tKey + "-map", Type: KeyTypeMap},
+	}, keys)
+
+	keys, err = es.Keys(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, keys, 3)
+
+	n, err := c.Instance(tBridge, tInstance).DropScope(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	n, err = es.DropScope(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	keys, err = es.Keys(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = c.Global().DropScope(ctx)
+	assert.Error(t, err, "the global scope should not be dropped")
+}
@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+type internalSet struct {
+	*internalClient
+}
+
+type internalSetMembers struct {
+	*internalClient
+
+	key string
+}
+
+var _ Set = (*internalSet)(nil)
+var _ SetMembers = (*internalSetMembers)(nil)
+
+// New creates an empty set.
+func (i *internalSet) New(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.setc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.NewSetRequest{
+		Location: i.location(key),
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.setc.New(ctx, r)
+	return err
+}
+
+// Members returns the members of the set at the key.
+func (i *internalSet) Members(key string) SetMembers {
+	return &internalSetMembers{
+		internalClient: i.internalClient,
+		key:            key,
+	}
+}
+
+// Del removes the set.
+func (i *internalSet) Del(ctx context.Context, key string) error {
+	if i.svc.setc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.DelSetRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.setc.Del(ctx, r)
+	return err
+}
+
+// TTL returns the remaining time to live in seconds for the set,
+// zero meaning that it never expires.
+func (i *internalSet) TTL(ctx context.Context, key string) (int32, error) {
+	if i.svc.setc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TTLSetRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.setc.TTL(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetTtl(), nil
+}
+
+// Expire sets a new time to live in seconds for the set.
+func (i *internalSet) Expire(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.setc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ExpireSetRequest{
+		Location: i.location(key),
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.setc.Expire(ctx, r)
+	return err
+}
+
+// Persist removes the time to live of the set.
+func (i *internalSet) Persist(ctx context.Context, key string) error {
+	if i.svc.setc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PersistSetRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.setc.Persist(ctx, r)
+	return err
+}
+
+// Add members to the set, returning the number
+// of them that were not in the set.
+func (i *internalSetMembers) Add(ctx context.Context, members ...string) (int, error) {
+	if i.svc.setc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.AddSetRequest{
+		Location: i.location(i.key),
+		Members:  members,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.setc.Add(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.GetAdded()), nil
+}
+
+// Remove members from the set, returning the number
+// of them that were in the set.
+func (i *internalSetMembers) Remove(ctx context.Context, members ...string) (int, error) {
+	if i.svc.setc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RemoveSetRequest{
+		Location: i.location(i.key),
+		Members:  members,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.setc.Remove(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.GetRemoved()), nil
+}
+
+// IsMember checks whether the member is in the set.
+func (i *internalSetMembers) IsMember(ctx context.Context, member string) (bool, error) {
+	if i.svc.setc == nil {
+		return false, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.IsMemberSetRequest{
+		Location: i.location(i.key),
+		Member:   member,
+	}
+
+	if err := r.Validate(); err != nil {
+		return false, err
+	}
+
+	res, err := i.svc.setc.IsMember(ctx, r)
+	if err != nil {
+		return false, err
+	}
+
+	return res.GetIsMember(), nil
+}
+
+// Pop removes and returns a random member of the set.
+func (i *internalSetMembers) Pop(ctx context.Context) (string, error) {
+	if i.svc.setc == nil {
+		return "", errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PopSetRequest{
+		Location: i.location(i.key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+
+	res, err := i.svc.setc.Pop(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	return res.GetMember(), nil
+}
+
+// All returns the members of the set, in no particular order.
+func (i *internalSetMembers) All(ctx context.Context) ([]string, error) {
+	if i.svc.setc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.MembersSetRequest{
+		Location: i.location(i.key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.setc.Members(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetMembers(), nil
+}
+
+// Len returns the number of members of the set.
+func (i *internalSetMembers) Len(ctx context.Context) (int, error) {
+	if i.svc.setc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.CardSetRequest{
+		Location: i.location(i.key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.setc.Card(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.GetCard()), nil
+}
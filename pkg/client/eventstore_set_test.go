@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSet(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.Set().New(ctx, "seen", 60))
+	members := es.Set().Members("seen")
+
+	n, err := members.Add(ctx, "event-1", "event-2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = members.Add(ctx, "event-2")
+	require.NoError(t, err)
+	assert.Equal(t, 0, n, "duplicated members should not be added")
+
+	ok, err := members.IsMember(ctx, "event-1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	n, err = members.Remove(ctx, "event-1", "event-3")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	all, err := members.All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"event-2"}, all)
+
+	m, err := members.Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "event-2", m)
+
+	l, err := members.Len(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, l)
+
+	_, err = members.Pop(ctx)
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+
+	_, err = members.Add(ctx, "")
+	assert.Error(t, err)
+
+	keys, err := es.Keys(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, KeyTypeSet, keys[0].Type)
+
+	ttl, err := es.Set().TTL(ctx, "seen")
+	require.NoError(t, err)
+	assert.Equal(t, int32(60), ttl)
+
+	require.NoError(t, es.Set().Del(ctx, "seen"))
+	_, err = members.Len(ctx)
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+}
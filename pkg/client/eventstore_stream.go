@@ -0,0 +1,275 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// StreamEntry is an entry appended to a stream.
+type StreamEntry struct {
+	Offset int64
+	Value  []byte
+	// Time when the entry was appended.
+	Time time.Time
+}
+
+// StreamOption customizes a new stream.
+type StreamOption func(*eventstore.NewStreamRequest)
+
+// WithRetentionLen keeps up to max entries at the stream,
+// removing the oldest ones as new entries are appended.
+func WithRetentionLen(max int32) StreamOption {
+	return func(r *eventstore.NewStreamRequest) {
+		r.MaxLen = max
+	}
+}
+
+// WithRetentionAge keeps entries at the stream for the
+// number of seconds after they are appended.
+func WithRetentionAge(ageSec int32) StreamOption {
+	return func(r *eventstore.NewStreamRequest) {
+		r.MaxAge = ageSec
+	}
+}
+
+type internalStream struct {
+	*internalClient
+}
+
+type internalStreamEntries struct {
+	*internalClient
+
+	key string
+}
+
+var _ Stream = (*internalStream)(nil)
+var _ StreamEntries = (*internalStreamEntries)(nil)
+
+// New creates an empty stream.
+func (i *internalStream) New(ctx context.Context, key string, ttlSec int32, opts ...StreamOption) error {
+	if i.svc.streamc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.NewStreamRequest{
+		Location: i.location(key),
+		Ttl:      ttlSec,
+	}
+	for _, f := range opts {
+		f(r)
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.streamc.New(ctx, r)
+	return err
+}
+
+// Entries returns the entries of the stream at the key.
+func (i *internalStream) Entries(key string) StreamEntries {
+	return &internalStreamEntries{
+		internalClient: i.internalClient,
+		key:            key,
+	}
+}
+
+// Del removes the stream along with the offsets
+// committed by its consumer groups.
+func (i *internalStream) Del(ctx context.Context, key string) error {
+	if i.svc.streamc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.DelStreamRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.streamc.Del(ctx, r)
+	return err
+}
+
+// TTL returns the remaining time to live in seconds for the
+// stream, zero meaning that it never expires.
+func (i *internalStream) TTL(ctx context.Context, key string) (int32, error) {
+	if i.svc.streamc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TTLStreamRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.streamc.TTL(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetTtl(), nil
+}
+
+// Expire sets a new time to live in seconds for the stream.
+func (i *internalStream) Expire(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.streamc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ExpireStreamRequest{
+		Location: i.location(key),
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.streamc.Expire(ctx, r)
+	return err
+}
+
+// Persist removes the time to live of the stream.
+func (i *internalStream) Persist(ctx context.Context, key string) error {
+	if i.svc.streamc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PersistStreamRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.streamc.Persist(ctx, r)
+	return err
+}
+
+// Append the value to the stream and return its offset.
+func (i *internalStreamEntries) Append(ctx context.Context, value []byte) (int64, error) {
+	if i.svc.streamc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.AppendStreamRequest{
+		Location: i.location(i.key),
+		Value:    value,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.streamc.Append(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetOffset(), nil
+}
+
+// Read up to limit entries starting at the offset,
+// zero limit meaning no limit.
+func (i *internalStreamEntries) Read(ctx context.Context, offset int64, limit int32) ([]StreamEntry, error) {
+	if i.svc.streamc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ReadStreamRequest{
+		Location: i.location(i.key),
+		Offset:   offset,
+		Limit:    limit,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.streamc.Read(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]StreamEntry, 0, len(res.GetEntries()))
+	for _, e := range res.GetEntries() {
+		entries = append(entries, StreamEntry{
+			Offset: e.GetOffset(),
+			Value:  e.GetValue(),
+			Time:   time.Unix(0, e.GetTime()*int64(time.Millisecond)),
+		})
+	}
+
+	return entries, nil
+}
+
+// Commit the offset of the next entry the consumer group will read.
+func (i *internalStreamEntries) Commit(ctx context.Context, group string, offset int64) error {
+	if i.svc.streamc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.CommitStreamRequest{
+		Location: i.location(i.key),
+		Group:    group,
+		Offset:   offset,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.streamc.Commit(ctx, r)
+	return err
+}
+
+// Offset returns the offset committed by the consumer
+// group, zero if it has not committed any.
+func (i *internalStreamEntries) Offset(ctx context.Context, group string) (int64, error) {
+	if i.svc.streamc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.OffsetStreamRequest{
+		Location: i.location(i.key),
+		Group:    group,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.streamc.Offset(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetOffset(), nil
+}
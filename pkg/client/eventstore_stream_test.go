@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Bridge(tBridge)
+	require.NoError(t, es.Stream().New(ctx, "events", 0, WithRetentionLen(3), WithRetentionAge(3600)))
+	events := es.Stream().Entries("events")
+
+	for _, v := range []string{"e1", "e2", "e3", "e4"} {
+		_, err := events.Append(ctx, []byte(v))
+		require.NoError(t, err)
+	}
+
+	// consumer groups read from their committed offset
+	// independently of each other
+	read := func(group string, limit int32) []string {
+		offset, err := events.Offset(ctx, group)
+		require.NoError(t, err)
+
+		entries, err := events.Read(ctx, offset, limit)
+		require.NoError(t, err)
+
+		var values []string
+		for _, e := range entries {
+			values = append(values, string(e.Value))
+			assert.False(t, e.Time.IsZero())
+			offset = e.Offset + 1
+		}
+		require.NoError(t, events.Commit(ctx, group, offset))
+
+		return values
+	}
+
+	assert.Equal(t, []string{"e2", "e3"}, read("indexer", 2), "the oldest entry should not be retained")
+	assert.Equal(t, []string{"e2", "e3", "e4"}, read("archiver", 0))
+	assert.Equal(t, []string{"e4"}, read("indexer", 0))
+	assert.Empty(t, read("indexer", 0))
+
+	offset, err := events.Append(ctx, []byte("e5"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), offset)
+	assert.Equal(t, []string{"e5"}, read("archiver", 0))
+
+	assert.Error(t, events.Commit(ctx, "archiver", 10), "offsets past the end of the stream should fail")
+
+	keys, err := es.Keys(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, KeyTypeStream, keys[0].Type)
+}
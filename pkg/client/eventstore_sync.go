@@ -1,5 +1,5 @@
 /*
-Copyright (c) 2020 TriggerMesh Inc.
+Copyright (c) 2021 TriggerMesh Inc.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 
+	"google.golang.org/grpc"
+
 	eventstore "github.com/triggermesh/eventstore/pkg/protob"
 )
 
@@ -29,74 +31,309 @@ type internalSync struct {
 
 var _ Sync = (*internalSync)(nil)
 
-// Locks key temporarily.
-func (i *internalSync) Lock(ctx context.Context, key string, timeout int32) (string, error) {
-	if i.svc.syncc == nil {
-		return "", errors.New("EventStore client is not connected")
+// locker is implemented by the KV, Map and Sync services,
+// which share a single lock namespace per scope.
+type locker interface {
+	Lock(ctx context.Context, in *eventstore.LockRequest, opts ...grpc.CallOption) (*eventstore.LockResponse, error)
+	Unlock(ctx context.Context, in *eventstore.UnlockRequest, opts ...grpc.CallOption) (*eventstore.UnlockResponse, error)
+}
+
+// LockOption customizes how locks are acquired.
+type LockOption func(*eventstore.LockRequest)
+
+// WithLockOwner identifies the holder of the lock, which is
+// informed to anyone that inspects it.
+func WithLockOwner(owner string) LockOption {
+	return func(r *eventstore.LockRequest) {
+		r.Owner = owner
+	}
+}
+
+// WithLockWait waits in line for up to the number of seconds
+// informed when the key is locked, instead of failing right away.
+func WithLockWait(wait int32) LockOption {
+	return func(r *eventstore.LockRequest) {
+		r.Wait = wait
+	}
+}
+
+// Lock is a handle to a held lock.
+type Lock struct {
+	sync *internalSync
+	// svc is the service the lock was acquired through.
+	svc locker
+
+	key          string
+	unlock       string
+	fencingToken uint64
+}
+
+// LockInfo describes the lock at a key.
+type LockInfo struct {
+	Locked bool
+	Owner  string
+	// TTL is the remaining time in seconds,
+	// zero meaning that it is held until unlocked.
+	TTL          int32
+	FencingToken uint64
+	// Readers is the number of shared holders.
+	Readers int
+}
+
+// Lock locks key temporarily, returning a handle to the lock.
+func (i *internalSync) Lock(ctx context.Context, key string, timeout int32, opts ...LockOption) (*Lock, error) {
+	return i.lock(ctx, i.svc.syncc, key, timeout, opts)
+}
+
+// Unlock key.
+func (i *internalSync) Unlock(ctx context.Context, key string, unlock string) error {
+	return i.unlock(ctx, i.svc.syncc, key, unlock)
+}
+
+// lock locks key through the service.
+func (i *internalSync) lock(ctx context.Context, svc locker, key string, timeout int32, opts []LockOption) (*Lock, error) {
+	if svc == nil {
+		return nil, errors.New("EventStore client is not connected")
 	}
 
 	r := &eventstore.LockRequest{
-		Location: &eventstore.LocationType{
-			Scope: &eventstore.ScopeType{
-				Bridge:   i.bridge,
-				Instance: i.instance,
-			},
-			Key: key,
-		},
-		Timeout: timeout,
+		Location: i.location(key),
+		Timeout:  timeout,
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
 
-	switch {
-	case i.instance != "":
-		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
-	case i.bridge != "":
-		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
-	default:
-		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := svc.Lock(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Lock{
+		sync:         i,
+		svc:          svc,
+		key:          key,
+		unlock:       res.GetUnlock(),
+		fencingToken: res.GetFencingToken(),
+	}, nil
+}
+
+// unlock unlocks key through the service.
+func (i *internalSync) unlock(ctx context.Context, svc locker, key string, unlock string) error {
+	if svc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.UnlockRequest{
+		Location: i.location(key),
+		Unlock:   unlock,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := svc.Unlock(ctx, r)
+	return err
+}
+
+// RefreshLock sets a new timeout for the lock held at key.
+func (i *internalSync) RefreshLock(ctx context.Context, key string, unlock string, timeout int32) error {
+	if i.svc.syncc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RefreshLockRequest{
+		Location: i.location(key),
+		Unlock:   unlock,
+		Timeout:  timeout,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.syncc.RefreshLock(ctx, r)
+	return err
+}
+
+// LockInfo describes the lock at the key, which is
+// informed as not locked when nobody holds it.
+func (i *internalSync) LockInfo(ctx context.Context, key string) (*LockInfo, error) {
+	res, err := i.lockInfo(ctx, key, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LockInfo{
+		Locked:       res.GetLocked(),
+		Owner:        res.GetOwner(),
+		TTL:          res.GetTtl(),
+		FencingToken: res.GetFencingToken(),
+		Readers:      int(res.GetReaders()),
+	}, nil
+}
+
+func (i *internalSync) lockInfo(ctx context.Context, key, unlock string) (*eventstore.LockInfoResponse, error) {
+	if i.svc.syncc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.LockInfoRequest{
+		Location: i.location(key),
+		Unlock:   unlock,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return i.svc.syncc.LockInfo(ctx, r)
+}
+
+// RLock locks key temporarily for shared access.
+func (i *internalSync) RLock(ctx context.Context, key string, timeout int32) (string, error) {
+	if i.svc.syncc == nil {
+		return "", errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RLockRequest{
+		Location: i.location(key),
+		Timeout:  timeout,
 	}
 
 	if err := r.Validate(); err != nil {
 		return "", err
 	}
 
-	res, err := i.svc.syncc.Lock(ctx, r)
+	res, err := i.svc.syncc.RLock(ctx, r)
 	if err != nil {
 		return "", err
 	}
 
-	return res.GetUnlock(), err
+	return res.GetUnlock(), nil
 }
 
-// Unlock key.
-func (i *internalSync) Unlock(ctx context.Context, key string, unlock string) error {
+// RUnlock releases shared access to key.
+func (i *internalSync) RUnlock(ctx context.Context, key string, unlock string) error {
 	if i.svc.syncc == nil {
 		return errors.New("EventStore client is not connected")
 	}
 
-	r := &eventstore.UnlockRequest{
-		Location: &eventstore.LocationType{
-			Scope: &eventstore.ScopeType{
-				Bridge:   i.bridge,
-				Instance: i.instance,
-			},
-			Key: key,
-		},
-		Unlock: unlock,
+	r := &eventstore.RUnlockRequest{
+		Location: i.location(key),
+		Unlock:   unlock,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.syncc.RUnlock(ctx, r)
+	return err
+}
+
+// Acquire takes permits from the semaphore at key.
+func (i *internalSync) Acquire(ctx context.Context, key string, permits, limit, timeout int32) (string, error) {
+	if i.svc.syncc == nil {
+		return "", errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.AcquireRequest{
+		Location: i.location(key),
+		Permits:  permits,
+		Limit:    limit,
+		Timeout:  timeout,
+	}
+
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+
+	res, err := i.svc.syncc.Acquire(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	return res.GetRelease(), nil
+}
+
+// Release returns the permits taken from the semaphore at key.
+func (i *internalSync) Release(ctx context.Context, key string, release string) error {
+	if i.svc.syncc == nil {
+		return errors.New("EventStore client is not connected")
 	}
 
-	switch {
-	case i.instance != "":
-		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
-	case i.bridge != "":
-		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
-	default:
-		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	r := &eventstore.ReleaseRequest{
+		Location: i.location(key),
+		Release:  release,
 	}
 
 	if err := r.Validate(); err != nil {
 		return err
 	}
 
-	_, err := i.svc.syncc.Unlock(ctx, r)
+	_, err := i.svc.syncc.Release(ctx, r)
 	return err
 }
+
+// Key returns the locked key.
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// UnlockCode returns the code needed to unlock the key.
+func (l *Lock) UnlockCode() string {
+	return l.unlock
+}
+
+// FencingToken returns a number that is greater than the token of
+// any lock acquired before. Downstream systems can reject writes
+// informing tokens lower than the last one they have seen, which
+// protects them from holders whose lock timed out.
+func (l *Lock) FencingToken() uint64 {
+	return l.fencingToken
+}
+
+// Refresh sets a new timeout for the lock, failing
+// if the lock is no longer held.
+func (l *Lock) Refresh(ctx context.Context, timeout int32) error {
+	return l.sync.RefreshLock(ctx, l.key, l.unlock, timeout)
+}
+
+// Release unlocks the key.
+func (l *Lock) Release(ctx context.Context) error {
+	return l.sync.unlock(ctx, l.svc, l.key, l.unlock)
+}
+
+// WithLock runs f while holding the lock, releasing it once f returns
+// or panics. The lock is released even if ctx is done, waiting up to
+// the timeout the client was created with, and failing to release it
+// is reported when f succeeds, since the lock might have timed out
+// while f was running.
+func (l *Lock) WithLock(ctx context.Context, f func(ctx context.Context) error) (err error) {
+	defer func() {
+		rctx, cancel := context.WithTimeout(context.Background(), l.sync.svc.timeout)
+		defer cancel()
+		if rerr := l.Release(rctx); err == nil {
+			err = rerr
+		}
+	}()
+
+	return f(ctx)
+}
+
+// Held returns whether the lock is still held, which is not
+// the case once it times out or is released.
+func (l *Lock) Held(ctx context.Context) (bool, error) {
+	res, err := l.sync.lockInfo(ctx, l.key, l.unlock)
+	if err != nil {
+		return false, err
+	}
+
+	return res.GetHeld(), nil
+}
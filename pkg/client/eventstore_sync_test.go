@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLockHandle(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	sync := c.Bridge(tBridge).Sync()
+
+	lock, err := sync.Lock(ctx, "poller", 10, WithLockOwner("replica-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "poller", lock.Key())
+
+	_, err = sync.Lock(ctx, "poller", 10)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "unexpected error %v", err)
+
+	held, err := lock.Held(ctx)
+	require.NoError(t, err)
+	assert.True(t, held)
+
+	require.NoError(t, lock.Refresh(ctx, 60))
+
+	info, err := sync.LockInfo(ctx, "poller")
+	require.NoError(t, err)
+	assert.Equal(t, &LockInfo{Locked: true, Owner: "replica-1", TTL: 60, FencingToken: lock.FencingToken()}, info)
+
+	require.NoError(t, lock.Release(ctx))
+
+	held, err = lock.Held(ctx)
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	err = lock.Refresh(ctx, 60)
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error %v", err)
+
+	next, err := sync.Lock(ctx, "poller", 10)
+	require.NoError(t, err)
+	assert.Greater(t, next.FencingToken(), lock.FencingToken())
+}
+
+func TestLockWait(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	sync := c.Bridge(tBridge).Sync()
+
+	lock, err := sync.Lock(ctx, "poller", 10)
+	require.NoError(t, err)
+
+	// Contenders that give up leave the line.
+	cctx, cancel := context.WithCancel(ctx)
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err = sync.Lock(cctx, "poller", 10, WithLockWait(5))
+	assert.Equal(t, codes.Canceled, status.Code(err), "unexpected error %v", err)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		assert.NoError(t, lock.Release(ctx))
+	}()
+
+	next, err := sync.Lock(ctx, "poller", 10, WithLockWait(5))
+	require.NoError(t, err)
+	assert.Greater(t, next.FencingToken(), lock.FencingToken())
+}
+
+func TestSemaphoreAndReadLocks(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	sync := c.Bridge(tBridge).Sync()
+
+	first, err := sync.Acquire(ctx, "api", 2, 3, 30)
+	require.NoError(t, err)
+	_, err = sync.Acquire(ctx, "api", 2, 3, 30)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "unexpected error %v", err)
+	require.NoError(t, sync.Release(ctx, "api", first))
+	_, err = sync.Acquire(ctx, "api", 3, 3, 30)
+	require.NoError(t, err)
+
+	unlock, err := sync.RLock(ctx, "config", 30)
+	require.NoError(t, err)
+	_, err = sync.RLock(ctx, "config", 30)
+	require.NoError(t, err)
+
+	info, err := sync.LockInfo(ctx, "config")
+	require.NoError(t, err)
+	assert.Equal(t, &LockInfo{Readers: 2}, info)
+
+	_, err = sync.Lock(ctx, "config", 10)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "unexpected error %v", err)
+	require.NoError(t, sync.RUnlock(ctx, "config", unlock))
+}
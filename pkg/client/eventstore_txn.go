@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// Txn collects guards and operations on values, maps and queues that
+// are committed atomically: operations are only applied when all guards
+// hold, and either all of them are applied or none is. Commit fails with
+// an error for which IsConflict returns true when a guard does not hold.
+type Txn interface {
+	// Guards checked before applying the operations.
+	IfExists(key string) Txn
+	IfNotExists(key string) Txn
+	IfVersion(key string, version uint64) Txn
+	IfFieldEquals(key, field string, value []byte) Txn
+
+	Set(key string, value []byte, ttlSec int32) Txn
+	Del(key string) Txn
+	IncrBy(key string, delta int64) Txn
+	DecrBy(key string, delta int64) Txn
+
+	MapNew(key string, ttlSec int32) Txn
+	MapDel(key string) Txn
+	MapFieldSet(key, field string, value []byte) Txn
+	MapFieldDel(key, field string) Txn
+	MapFieldIncrBy(key, field string, delta int64) Txn
+	MapFieldDecrBy(key, field string, delta int64) Txn
+
+	QueueNew(key string, ttlSec int32) Txn
+	QueueDel(key string) Txn
+	QueuePush(key string, value []byte, opts ...PushOption) Txn
+	QueuePop(key string) Txn
+
+	// Commit sends the transaction to the EventStore and returns
+	// the results of the operations in the same order they were
+	// added.
+	Commit(ctx context.Context) ([]TxnResult, error)
+}
+
+// TxnResult is the outcome of a transaction operation. Only
+// the fields that apply to the operation are populated.
+type TxnResult struct {
+	// Value returned by QueuePop.
+	Value []byte
+	// Number returned by counter operations.
+	Number int64
+	// Version returned by Set.
+	Version uint64
+}
+
+type internalTxn struct {
+	*internalClient
+	guards []*eventstore.TxnGuard
+	ops    []*eventstore.TxnOperation
+}
+
+var _ Txn = (*internalTxn)(nil)
+
+// Txn returns a builder for operations
+// that are applied atomically.
+func (s *internalClient) Txn() Txn {
+	return &internalTxn{internalClient: s}
+}
+
+func (t *internalTxn) guard(g *eventstore.TxnGuard) Txn {
+	t.guards = append(t.guards, g)
+	return t
+}
+
+func (t *internalTxn) add(op *eventstore.TxnOperation) Txn {
+	t.ops = append(t.ops, op)
+	return t
+}
+
+// IfExists requires the key to exist.
+func (t *internalTxn) IfExists(key string) Txn {
+	return t.guard(&eventstore.TxnGuard{
+		Location:  t.location(key),
+		Condition: &eventstore.TxnGuard_Exists{Exists: true},
+	})
+}
+
+// IfNotExists requires the key not to exist.
+func (t *internalTxn) IfNotExists(key string) Txn {
+	return t.guard(&eventstore.TxnGuard{
+		Location:  t.location(key),
+		Condition: &eventstore.TxnGuard_Exists{Exists: false},
+	})
+}
+
+// IfVersion requires the value at the key to be at the version.
+func (t *internalTxn) IfVersion(key string, version uint64) Txn {
+	return t.guard(&eventstore.TxnGuard{
+		Location:  t.location(key),
+		Condition: &eventstore.TxnGuard_Version{Version: version},
+	})
+}
+
+// IfFieldEquals requires the field of the map at the key to hold the value.
+func (t *internalTxn) IfFieldEquals(key, field string, value []byte) Txn {
+	return t.guard(&eventstore.TxnGuard{
+		Location: t.location(key),
+		Condition: &eventstore.TxnGuard_Field{
+			Field: &eventstore.TxnFieldGuard{Field: field, Value: value},
+		},
+	})
+}
+
+// Set key/value at store
+func (t *internalTxn) Set(key string, value []byte, ttlSec int32) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_KvSet{
+		KvSet: &eventstore.SetKVRequest{Location: t.location(key), Ttl: ttlSec, Value: value},
+	}})
+}
+
+// Del Value from EventStore
+func (t *internalTxn) Del(key string) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_KvDel{
+		KvDel: &eventstore.DelKVRequest{Location: t.location(key)},
+	}})
+}
+
+// IncrBy increments the value at the key.
+func (t *internalTxn) IncrBy(key string, delta int64) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_KvIncr{
+		KvIncr: &eventstore.IncrKVRequest{Location: t.location(key), Incr: delta},
+	}})
+}
+
+// DecrBy decrements the value at the key.
+func (t *internalTxn) DecrBy(key string, delta int64) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_KvDecr{
+		KvDecr: &eventstore.DecrKVRequest{Location: t.location(key), Decr: delta},
+	}})
+}
+
+// MapNew creates a map at the key.
+func (t *internalTxn) MapNew(key string, ttlSec int32) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_MapNew{
+		MapNew: &eventstore.NewMapRequest{Location: t.location(key), Ttl: ttlSec},
+	}})
+}
+
+// MapDel removes the map at the key.
+func (t *internalTxn) MapDel(key string) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_MapDel{
+		MapDel: &eventstore.DelMapRequest{Location: t.location(key)},
+	}})
+}
+
+// MapFieldSet sets a field of the map at the key.
+func (t *internalTxn) MapFieldSet(key, field string, value []byte) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_MapFieldSet{
+		MapFieldSet: &eventstore.SetMapFieldRequest{Location: t.location(key), Field: field, Value: value},
+	}})
+}
+
+// MapFieldDel removes a field of the map at the key.
+func (t *internalTxn) MapFieldDel(key, field string) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_MapFieldDel{
+		MapFieldDel: &eventstore.DelMapFieldRequest{Location: t.location(key), Field: field},
+	}})
+}
+
+// MapFieldIncrBy increments a field of the map at the key.
+func (t *internalTxn) MapFieldIncrBy(key, field string, delta int64) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_MapFieldIncr{
+		MapFieldIncr: &eventstore.IncrMapFieldRequest{Location: t.location(key), Field: field, Incr: delta},
+	}})
+}
+
+// MapFieldDecrBy decrements a field of the map at the key.
+func (t *internalTxn) MapFieldDecrBy(key, field string, delta int64) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_MapFieldDecr{
+		MapFieldDecr: &eventstore.DecrMapFieldRequest{Location: t.location(key), Field: field, Decr: delta},
+	}})
+}
+
+// QueueNew creates a queue at the key.
+func (t *internalTxn) QueueNew(key string, ttlSec int32) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_QueueNew{
+		QueueNew: &eventstore.NewQueueRequest{Location: t.location(key), Ttl: ttlSec},
+	}})
+}
+
+// QueueDel removes the queue at the key.
+func (t *internalTxn) QueueDel(key string) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_QueueDel{
+		QueueDel: &eventstore.DelQueueRequest{Location: t.location(key)},
+	}})
+}
+
+// QueuePush adds an item to the queue at the key.
+func (t *internalTxn) QueuePush(key string, value []byte, opts ...PushOption) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_QueuePush{
+		QueuePush: pushRequest(t.location(key), value, opts),
+	}})
+}
+
+// QueuePop removes and retrieves the first item of the queue at the key.
+func (t *internalTxn) QueuePop(key string) Txn {
+	return t.add(&eventstore.TxnOperation{Op: &eventstore.TxnOperation_QueuePop{
+		QueuePop: &eventstore.PopQueueRequest{Location: t.location(key)},
+	}})
+}
+
+// Commit sends the transaction to the EventStore.
+func (t *internalTxn) Commit(ctx context.Context) ([]TxnResult, error) {
+	tc := t.svc.txnc
+	if tc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TxnRequest{
+		Guards: t.guards,
+		Ops:    t.ops,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := tc.Txn(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TxnResult, 0, len(res.Results))
+	for _, r := range res.Results {
+		results = append(results, txnResult(r))
+	}
+
+	return results, nil
+}
+
+func txnResult(r *eventstore.TxnResult) TxnResult {
+	switch res := r.Result.(type) {
+	case *eventstore.TxnResult_KvSet:
+		return TxnResult{Version: res.KvSet.GetVersion()}
+	case *eventstore.TxnResult_KvIncr:
+		return TxnResult{Number: res.KvIncr.GetValue()}
+	case *eventstore.TxnResult_KvDecr:
+		return TxnResult{Number: res.KvDecr.GetValue()}
+	case *eventstore.TxnResult_MapFieldIncr:
+		return TxnResult{Number: res.MapFieldIncr.GetValue()}
+	case *eventstore.TxnResult_MapFieldDecr:
+		return TxnResult{Number: res.MapFieldDecr.GetValue()}
+	case *eventstore.TxnResult_QueuePop:
+		return TxnResult{Value: res.QueuePop.GetValue()}
+	}
+	return TxnResult{}
+}
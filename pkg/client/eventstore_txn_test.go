@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxn(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.Queue().New(ctx, "events", 0))
+	require.NoError(t, es.Queue().Items("events").Push(ctx, tValue))
+	require.NoError(t, es.Map().New(ctx, "counts", 0))
+
+	res, err := es.Txn().
+		IfExists("events").
+		QueuePop("events").
+		MapFieldIncrBy("counts", "events", 1).
+		Set(tKey, tValue, 0).
+		Commit(ctx)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, tValue, res[0].Value)
+	assert.Equal(t, int64(1), res[1].Number)
+	assert.NotZero(t, res[2].Version)
+
+	_, err = es.Txn().
+		IfVersion(tKey, res[2].Version+1).
+		Del(tKey).
+		Commit(ctx)
+	assert.True(t, IsConflict(err), "unexpected error %v", err)
+
+	// The queue is empty, so the counter is not incremented.
+	_, err = es.Txn().
+		IfFieldEquals("counts", "events", []byte("1")).
+		QueuePop("events").
+		MapFieldIncrBy("counts", "events", 1).
+		Commit(ctx)
+	assert.Error(t, err)
+
+	v, err := es.Map().Fields("counts").Get(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), v)
+
+	_, err = es.Txn().Commit(ctx)
+	assert.Error(t, err, "empty transactions should fail")
+}
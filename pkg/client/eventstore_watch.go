@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+const (
+	watchMinBackoff = 100 * time.Millisecond
+	watchMaxBackoff = 5 * time.Second
+	// revisionHeader informs the revision at the time the watch is
+	// placed, which is resumed from until events are received.
+	revisionHeader = "eventstore-revision"
+)
+
+// EventType is the kind of change notified to watchers.
+type EventType string
+
+// Types of changes notified to watchers.
+const (
+	EventSet         EventType = "set"
+	EventDelete      EventType = "delete"
+	EventExpire      EventType = "expire"
+	EventNew         EventType = "new"
+	EventFieldSet    EventType = "field-set"
+	EventFieldDelete EventType = "field-delete"
+	EventPush        EventType = "push"
+	EventPop         EventType = "pop"
+	EventTrim        EventType = "trim"
+)
+
+// Event is a change at a watched key. Field is informed for map
+// field changes and holds the kept range for queue trims, and Value
+// for changes that write or remove a value, increments informing
+// the resulting number.
+type Event struct {
+	Type  EventType
+	Key   string
+	Field string
+	Value []byte
+}
+
+// WatchOption customizes a watch request.
+type WatchOption func(*eventstore.WatchRequest)
+
+// WatchPrefix watches every key starting with the key informed
+// to Watch, which can be empty to watch the whole scope.
+func WatchPrefix() WatchOption {
+	return func(r *eventstore.WatchRequest) {
+		r.Prefix = true
+	}
+}
+
+// Watch streams changes at the key through the returned channel.
+// Lost connections are resumed transparently, receiving the changes
+// that happened meanwhile. The channel is closed when the context is
+// done, the server rejects the watch, or the server no longer
+// remembers the changes that happened while disconnected.
+func (s *internalClient) Watch(ctx context.Context, key string, opts ...WatchOption) (<-chan Event, error) {
+	wc := s.svc.watchc
+	if wc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.WatchRequest{
+		Location: &eventstore.LocationType{
+			Scope: &eventstore.ScopeType{
+				Bridge:   s.bridge,
+				Instance: s.instance,
+			},
+			Key: key,
+		},
+	}
+
+	switch {
+	case s.instance != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Instance
+	case s.bridge != "":
+		r.Location.Scope.Type = eventstore.ScopeChoice_Bridge
+	default:
+		r.Location.Scope.Type = eventstore.ScopeChoice_Global
+	}
+
+	for _, f := range opts {
+		f(r)
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	stream, err := wc.Watch(ctx, r, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	// The server sends headers once the watch is in place,
+	// failing here when the request is rejected.
+	md, err := stream.Header()
+	if err != nil {
+		return nil, err
+	}
+	if v := md.Get(revisionHeader); len(v) != 0 {
+		if r.Revision, err = strconv.ParseUint(v[0], 10, 64); err != nil {
+			return nil, fmt.Errorf("parsing watch revision: %w", err)
+		}
+	}
+
+	ch := make(chan Event)
+	go watch(ctx, wc, r, stream, ch)
+
+	return ch, nil
+}
+
+// watch forwards events from the stream to the channel, resuming
+// from the last received revision when the stream is interrupted.
+func watch(ctx context.Context, wc eventstore.WatchClient, r *eventstore.WatchRequest,
+	stream eventstore.Watch_WatchClient, ch chan<- Event) {
+	defer close(ch)
+
+	backoff := watchMinBackoff
+	for {
+		if stream == nil {
+			t := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
+
+			if backoff *= 2; backoff > watchMaxBackoff {
+				backoff = watchMaxBackoff
+			}
+
+			var err error
+			if stream, err = wc.Watch(ctx, r, grpc.WaitForReady(true)); err != nil {
+				if !resumable(err) {
+					return
+				}
+				stream = nil
+				continue
+			}
+		}
+
+		ev, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil || !resumable(err) {
+				return
+			}
+			stream = nil
+			continue
+		}
+
+		backoff = watchMinBackoff
+		r.Revision = ev.Revision
+
+		select {
+		case ch <- eventOf(ev):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// resumable returns whether the watch can be resumed after the error.
+func resumable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+func eventOf(ev *eventstore.WatchEvent) Event {
+	e := Event{
+		Key:   ev.GetLocation().GetKey(),
+		Field: ev.Field,
+		Value: ev.Value,
+	}
+
+	switch ev.Type {
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_SET:
+		e.Type = EventSet
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE:
+		e.Type = EventDelete
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_EXPIRE:
+		e.Type = EventExpire
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW:
+		e.Type = EventNew
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET:
+		e.Type = EventFieldSet
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_DELETE:
+		e.Type = EventFieldDelete
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH:
+		e.Type = EventPush
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_POP:
+		e.Type = EventPop
+	case eventstore.WatchEventType_WATCH_EVENT_TYPE_TRIM:
+		e.Type = EventTrim
+	}
+
+	return e
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/triggermesh/eventstore/pkg/server"
+	"github.com/triggermesh/eventstore/pkg/server/memory"
+)
+
+// serve starts an EventStore server listening at the address.
+func serve(t *testing.T, addr string, s server.Storage) (*grpc.Server, string) {
+	lis, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+
+	gs := grpc.NewServer()
+	server.Register(gs, s)
+	go func() { _ = gs.Serve(lis) }()
+
+	return gs, lis.Addr().String()
+}
+
+// newTestClient returns a client connected to a
+// server backed by a new memory store.
+func newTestClient(t *testing.T) EventStore {
+	s := memory.New()
+	t.Cleanup(func() { _ = s.Close() })
+
+	gs, addr := serve(t, "127.0.0.1:0", s)
+	t.Cleanup(gs.Stop)
+
+	c := New(addr, 5*time.Second)
+	require.NoError(t, c.Connect(context.Background()))
+	t.Cleanup(func() { _ = c.Disconnect() })
+
+	return c
+}
+
+func receive(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+
+	select {
+	case ev, ok := <-ch:
+		require.True(t, ok, "watch channel closed")
+		return ev
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for event")
+	}
+	return Event{}
+}
+
+func TestWatch(t *testing.T) {
+	s := memory.New()
+	defer s.Close()
+
+	gs, addr := serve(t, "127.0.0.1:0", s)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := New(addr, 5*time.Second)
+	require.NoError(t, c.Connect(ctx))
+	defer func() { _ = c.Disconnect() }()
+
+	es := c.Bridge(tBridge)
+	ch, err := es.Watch(ctx, "test-", WatchPrefix())
+	require.NoError(t, err)
+
+	_, err = es.Watch(ctx, "")
+	assert.Error(t, err, "watching an empty key without prefix should fail")
+
+	require.NoError(t, es.KV().Set(ctx, tKey, tValue, 0))
+	assert.Equal(t, Event{Type: EventSet, Key: tKey, Value: tValue}, receive(t, ch))
+
+	require.NoError(t, c.Global().KV().Set(ctx, tKey, tValue, 0))
+	require.NoError(t, es.KV().Incr(ctx, tKey+"-counter", 2))
+	assert.Equal(t, Event{Type: EventSet, Key: tKey + "-counter", Value: []byte("2")}, receive(t, ch),
+		"changes at other scopes should not be notified")
+
+	// Restart the server, the watch should resume once it is back.
+	gs.Stop()
+	gs, _ = serve(t, addr, s)
+	defer gs.Stop()
+
+	require.Eventually(t, func() bool {
+		return es.KV().Set(ctx, tKey, nil, 0) == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(t, Event{Type: EventSet, Key: tKey}, receive(t, ch))
+
+	cancel()
+	for range ch {
+	}
+}
+
+func TestWatchResumeWithoutEvents(t *testing.T) {
+	s := memory.New()
+	defer s.Close()
+
+	gs, addr := serve(t, "127.0.0.1:0", s)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := New(addr, 5*time.Second)
+	require.NoError(t, c.Connect(ctx))
+	defer func() { _ = c.Disconnect() }()
+
+	es := c.Bridge(tBridge)
+	ch, err := es.Watch(ctx, tKey)
+	require.NoError(t, err)
+
+	// Changes made before the watch is resumed are
+	// replayed even if no events were received yet.
+	gs.Stop()
+	gs, _ = serve(t, addr, s)
+	defer gs.Stop()
+
+	require.Eventually(t, func() bool {
+		return es.KV().Set(ctx, tKey, tValue, 0) == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, Event{Type: EventSet, Key: tKey, Value: tValue}, receive(t, ch))
+
+	cancel()
+	for range ch {
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// ScoredMember is a member of a sorted set.
+type ScoredMember struct {
+	Member string
+	Score  float64
+}
+
+type internalSortedSet struct {
+	*internalClient
+}
+
+type internalSortedSetMembers struct {
+	*internalClient
+
+	key string
+}
+
+var _ SortedSet = (*internalSortedSet)(nil)
+var _ SortedSetMembers = (*internalSortedSetMembers)(nil)
+
+// New creates an empty sorted set.
+func (i *internalSortedSet) New(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.zsetc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.NewSortedSetRequest{
+		Location: i.location(key),
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.zsetc.New(ctx, r)
+	return err
+}
+
+// Members returns the members of the sorted set at the key.
+func (i *internalSortedSet) Members(key string) SortedSetMembers {
+	return &internalSortedSetMembers{
+		internalClient: i.internalClient,
+		key:            key,
+	}
+}
+
+// Del removes the sorted set.
+func (i *internalSortedSet) Del(ctx context.Context, key string) error {
+	if i.svc.zsetc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.DelSortedSetRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.zsetc.Del(ctx, r)
+	return err
+}
+
+// TTL returns the remaining time to live in seconds for the
+// sorted set, zero meaning that it never expires.
+func (i *internalSortedSet) TTL(ctx context.Context, key string) (int32, error) {
+	if i.svc.zsetc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.TTLSortedSetRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.zsetc.TTL(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetTtl(), nil
+}
+
+// Expire sets a new time to live in seconds for the sorted set.
+func (i *internalSortedSet) Expire(ctx context.Context, key string, ttlSec int32) error {
+	if i.svc.zsetc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.ExpireSortedSetRequest{
+		Location: i.location(key),
+		Ttl:      ttlSec,
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.zsetc.Expire(ctx, r)
+	return err
+}
+
+// Persist removes the time to live of the sorted set.
+func (i *internalSortedSet) Persist(ctx context.Context, key string) error {
+	if i.svc.zsetc == nil {
+		return errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.PersistSortedSetRequest{
+		Location: i.location(key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := i.svc.zsetc.Persist(ctx, r)
+	return err
+}
+
+// Add members to the sorted set, updating the score of existing
+// ones, and returns the number of members that were not in the set.
+func (i *internalSortedSetMembers) Add(ctx context.Context, members ...ScoredMember) (int, error) {
+	if i.svc.zsetc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.AddSortedSetRequest{
+		Location: i.location(i.key),
+		Members:  make([]*eventstore.ScoredMember, 0, len(members)),
+	}
+	for _, m := range members {
+		r.Members = append(r.Members, &eventstore.ScoredMember{Member: m.Member, Score: m.Score})
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.zsetc.Add(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.GetAdded()), nil
+}
+
+// IncrBy adds delta to the score of the member and returns the
+// result. Missing members are added with a zero score.
+func (i *internalSortedSetMembers) IncrBy(ctx context.Context, member string, delta float64) (float64, error) {
+	if i.svc.zsetc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.IncrSortedSetRequest{
+		Location: i.location(i.key),
+		Member:   member,
+		Incr:     delta,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.zsetc.Incr(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetScore(), nil
+}
+
+// RangeByScore returns up to limit members whose score is between
+// min and max, zero limit meaning no limit.
+func (i *internalSortedSetMembers) RangeByScore(ctx context.Context, min, max float64, limit int32) ([]ScoredMember, error) {
+	if i.svc.zsetc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RangeByScoreSortedSetRequest{
+		Location: i.location(i.key),
+		Min:      min,
+		Max:      max,
+		Limit:    limit,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.zsetc.RangeByScore(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return scoredMembers(res.GetMembers()), nil
+}
+
+// RangeByRank returns the members between the start and stop ranks.
+func (i *internalSortedSetMembers) RangeByRank(ctx context.Context, start, stop int32) ([]ScoredMember, error) {
+	if i.svc.zsetc == nil {
+		return nil, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RangeByRankSortedSetRequest{
+		Location: i.location(i.key),
+		Start:    start,
+		Stop:     stop,
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := i.svc.zsetc.RangeByRank(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return scoredMembers(res.GetMembers()), nil
+}
+
+// RemoveRangeByScore removes the members whose score is between
+// min and max, and returns the number of members removed.
+func (i *internalSortedSetMembers) RemoveRangeByScore(ctx context.Context, min, max float64) (int, error) {
+	if i.svc.zsetc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.RemoveRangeByScoreSortedSetRequest{
+		Location: i.location(i.key),
+		Min:      min,
+		Max:      max,
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.zsetc.RemoveRangeByScore(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.GetRemoved()), nil
+}
+
+// Len returns the number of members of the sorted set.
+func (i *internalSortedSetMembers) Len(ctx context.Context) (int, error) {
+	if i.svc.zsetc == nil {
+		return 0, errors.New("EventStore client is not connected")
+	}
+
+	r := &eventstore.CardSortedSetRequest{
+		Location: i.location(i.key),
+	}
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, err := i.svc.zsetc.Card(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.GetCard()), nil
+}
+
+func scoredMembers(members []*eventstore.ScoredMember) []ScoredMember {
+	res := make([]ScoredMember, 0, len(members))
+	for _, m := range members {
+		res = append(res, ScoredMember{Member: m.GetMember(), Score: m.GetScore()})
+	}
+	return res
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedSet(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	es := c.Instance(tBridge, tInstance)
+	require.NoError(t, es.SortedSet().New(ctx, "window", 0))
+	window := es.SortedSet().Members("window")
+
+	// events indexed by the second they were received at
+	n, err := window.Add(ctx,
+		ScoredMember{Member: "event-1", Score: 100},
+		ScoredMember{Member: "event-2", Score: 160},
+		ScoredMember{Member: "event-3", Score: 400},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	members, err := window.RangeByScore(ctx, 150, math.Inf(1), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []ScoredMember{{Member: "event-2", Score: 160}, {Member: "event-3", Score: 400}}, members)
+
+	removed, err := window.RemoveRangeByScore(ctx, math.Inf(-1), 400-300)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	score, err := window.IncrBy(ctx, "event-2", 500)
+	require.NoError(t, err)
+	assert.Equal(t, float64(660), score)
+
+	members, err = window.RangeByRank(ctx, -1, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []ScoredMember{{Member: "event-2", Score: 660}}, members)
+
+	l, err := window.Len(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+
+	_, err = window.Add(ctx, ScoredMember{Member: "event-4", Score: math.NaN()})
+	assert.Error(t, err)
+
+	keys, err := es.Keys(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, KeyTypeSortedSet, keys[0].Type)
+}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package election elects a leader among the replicas of a component
+// using locks of the EventStore Sync service.
+//
+// Candidates campaign by waiting in line for the lock at a key. The
+// candidate holding it is the leader, and keeps renewing the lock
+// until it steps down, which it also does as soon as renewing the
+// lock fails or does not complete before the lock times out.
+package election
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/triggermesh/eventstore/pkg/client"
+)
+
+const (
+	defaultTTL   int32 = 15
+	defaultRetry       = time.Second
+	// releaseTimeout bounds the time spent releasing
+	// the lock when stepping down.
+	releaseTimeout = 5 * time.Second
+)
+
+// Elector campaigns for the leadership of a key.
+type Elector struct {
+	sync  client.Sync
+	key   string
+	owner string
+	ttl   int32
+	renew time.Duration
+	retry time.Duration
+
+	onElected func(ctx context.Context)
+	onLost    func()
+
+	changes chan bool
+
+	mu     sync.Mutex
+	leader bool
+}
+
+// Option customizes the elector.
+type Option func(*Elector)
+
+// WithOwner identifies the candidate as the holder
+// of the lock, which is informed by Sync.LockInfo.
+func WithOwner(owner string) Option {
+	return func(e *Elector) {
+		e.owner = owner
+	}
+}
+
+// WithTTL sets the timeout of the lock in seconds, which is the
+// longest time a crashed leader keeps the leadership. Defaults
+// to 15 seconds.
+func WithTTL(ttl int32) Option {
+	return func(e *Elector) {
+		e.ttl = ttl
+	}
+}
+
+// WithRenewInterval sets how often the leader renews the lock.
+// Defaults to a third of the TTL.
+func WithRenewInterval(d time.Duration) Option {
+	return func(e *Elector) {
+		e.renew = d
+	}
+}
+
+// WithRetryInterval sets the wait before campaigning again after
+// failing to reach the EventStore. Defaults to one second.
+func WithRetryInterval(d time.Duration) Option {
+	return func(e *Elector) {
+		e.retry = d
+	}
+}
+
+// OnElected registers a function called when the candidate becomes
+// the leader, with a context that is done once it is no longer the
+// leader. The function must not block.
+func OnElected(f func(ctx context.Context)) Option {
+	return func(e *Elector) {
+		e.onElected = f
+	}
+}
+
+// OnLost registers a function called when the candidate is no longer
+// the leader, either because it stepped down or because it failed to
+// renew the lock. The function must not block.
+func OnLost(f func()) Option {
+	return func(e *Elector) {
+		e.onLost = f
+	}
+}
+
+// New creates an elector for the leadership of the key
+// using the locks of the Sync service.
+func New(s client.Sync, key string, opts ...Option) *Elector {
+	e := &Elector{
+		sync:    s,
+		key:     key,
+		ttl:     defaultTTL,
+		retry:   defaultRetry,
+		changes: make(chan bool, 1),
+	}
+
+	for _, f := range opts {
+		f(e)
+	}
+
+	if e.ttl <= 0 {
+		e.ttl = defaultTTL
+	}
+	if e.renew <= 0 {
+		e.renew = time.Duration(e.ttl) * time.Second / 3
+	}
+
+	return e
+}
+
+// IsLeader returns whether the candidate is the leader.
+func (e *Elector) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.leader
+}
+
+// Changes returns a channel that receives true when the candidate
+// becomes the leader, once the function registered with OnElected
+// returns, and false when it is no longer the leader, before the
+// function registered with OnLost is called.
+// Receivers that fall behind only get the latest change. The channel
+// is closed when Run returns.
+func (e *Elector) Changes() <-chan bool {
+	return e.changes
+}
+
+// Run campaigns for the leadership until ctx is done, stepping down
+// by releasing the lock when the candidate is the leader. Leaders
+// that fail to renew the lock also step down and campaign again. Run
+// must only be called once.
+func (e *Elector) Run(ctx context.Context) {
+	defer close(e.changes)
+
+	for ctx.Err() == nil {
+		lock, err := e.sync.Lock(ctx, e.key, e.ttl,
+			client.WithLockOwner(e.owner), client.WithLockWait(e.ttl))
+		switch {
+		case err == nil:
+			e.lead(ctx, lock)
+		case status.Code(err) == codes.FailedPrecondition:
+			// Still locked after waiting in line.
+		default:
+			e.wait(ctx, e.retry)
+		}
+	}
+}
+
+// lead keeps renewing the lock until ctx is done or the lock cannot
+// be renewed, releasing it when stepping down.
+func (e *Elector) lead(ctx context.Context, lock *client.Lock) {
+	lctx, cancel := context.WithCancel(ctx)
+	if e.onElected != nil {
+		e.onElected(lctx)
+	}
+	e.setLeader(true)
+
+	defer func() {
+		cancel()
+		e.setLeader(false)
+		if e.onLost != nil {
+			e.onLost()
+		}
+
+		rctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		_ = lock.Release(rctx)
+	}()
+
+	ttl := time.Duration(e.ttl) * time.Second
+	expireAt := time.Now().Add(ttl)
+
+	t := time.NewTicker(e.renew)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		// The leadership is given up when the lock cannot be
+		// renewed before it could time out.
+		renewedAt := time.Now()
+		rctx, rcancel := context.WithDeadline(ctx, expireAt)
+		err := lock.Refresh(rctx, e.ttl)
+		rcancel()
+		if err != nil {
+			return
+		}
+		expireAt = renewedAt.Add(ttl)
+	}
+}
+
+// setLeader records the leadership and notifies the change.
+func (e *Elector) setLeader(leader bool) {
+	e.mu.Lock()
+	e.leader = leader
+	e.mu.Unlock()
+
+	// Replace a change that was not received yet.
+	select {
+	case <-e.changes:
+	default:
+	}
+	e.changes <- leader
+}
+
+// wait returns after d or once ctx is done.
+func (e *Elector) wait(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
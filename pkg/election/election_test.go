@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package election
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/triggermesh/eventstore/pkg/client"
+	"github.com/triggermesh/eventstore/pkg/server"
+	"github.com/triggermesh/eventstore/pkg/server/memory"
+)
+
+const tBridge = "test-bridge"
+
+func newTestClient(t *testing.T) client.Interface {
+	s := memory.New()
+	t.Cleanup(func() { _ = s.Close() })
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	gs := grpc.NewServer()
+	server.Register(gs, s)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	ctx := context.Background()
+	c := client.New(lis.Addr().String(), 5*time.Second)
+	require.NoError(t, c.Connect(ctx))
+	t.Cleanup(func() { _ = c.Disconnect() })
+
+	return c.Bridge(tBridge)
+}
+
+func receive(t *testing.T, ch <-chan bool) bool {
+	t.Helper()
+
+	select {
+	case leader, ok := <-ch:
+		require.True(t, ok, "changes channel closed")
+		return leader
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for leadership change")
+	}
+	return false
+}
+
+func TestElection(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	var elected context.Context
+	lost := make(chan string, 2)
+	first := New(c.Sync(), "poller", WithOwner("replica-1"), WithTTL(2),
+		OnElected(func(ctx context.Context) { elected = ctx }),
+		OnLost(func() { lost <- "replica-1" }))
+	second := New(c.Sync(), "poller", WithOwner("replica-2"), WithTTL(2),
+		OnLost(func() { lost <- "replica-2" }))
+
+	fctx, stopFirst := context.WithCancel(ctx)
+	firstDone := make(chan struct{})
+	go func() {
+		first.Run(fctx)
+		close(firstDone)
+	}()
+	require.True(t, receive(t, first.Changes()))
+	assert.True(t, first.IsLeader())
+	assert.NotNil(t, elected, "OnElected should be called before notifying the change")
+
+	sctx, stopSecond := context.WithCancel(ctx)
+	defer stopSecond()
+	go second.Run(sctx)
+
+	// The leader keeps renewing the lock past its TTL.
+	time.Sleep(3 * time.Second)
+	assert.False(t, second.IsLeader())
+	info, err := c.Sync().LockInfo(ctx, "poller")
+	require.NoError(t, err)
+	assert.Equal(t, "replica-1", info.Owner)
+
+	// Stepping down hands the leadership over right away.
+	stopFirst()
+	<-firstDone
+	assert.False(t, first.IsLeader())
+	assert.Error(t, elected.Err(), "elected context should be done")
+	assert.Equal(t, "replica-1", <-lost)
+	assert.False(t, receive(t, first.Changes()))
+	_, ok := <-first.Changes()
+	assert.False(t, ok, "changes should be closed once Run returns")
+
+	require.True(t, receive(t, second.Changes()))
+	assert.True(t, second.IsLeader())
+
+	// Losing the lock loses the leadership.
+	_, err = c.DropScope(ctx)
+	require.NoError(t, err)
+	select {
+	case owner := <-lost:
+		assert.Equal(t, "replica-2", owner)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for the leadership to be lost")
+	}
+
+	// Candidates campaign again after losing the leadership.
+	assert.Eventually(t, second.IsLeader, 10*time.Second, 100*time.Millisecond)
+}
@@ -84,6 +84,183 @@ func (ScopeChoice) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{0}
 }
 
+// QueueOverflow is the policy applied when pushing to a full queue.
+type QueueOverflow int32
+
+const (
+	// OverflowReject fails the push.
+	QueueOverflow_OverflowReject QueueOverflow = 0
+	// OverflowDropOldest removes the element at the
+	// opposite end of the queue.
+	QueueOverflow_OverflowDropOldest QueueOverflow = 1
+)
+
+// Enum value maps for QueueOverflow.
+var (
+	QueueOverflow_name = map[int32]string{
+		0: "OverflowReject",
+		1: "OverflowDropOldest",
+	}
+	QueueOverflow_value = map[string]int32{
+		"OverflowReject":     0,
+		"OverflowDropOldest": 1,
+	}
+)
+
+func (x QueueOverflow) Enum() *QueueOverflow {
+	p := new(QueueOverflow)
+	*p = x
+	return p
+}
+
+func (x QueueOverflow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueOverflow) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_protob_eventstore_proto_enumTypes[1].Descriptor()
+}
+
+func (QueueOverflow) Type() protoreflect.EnumType {
+	return &file_pkg_protob_eventstore_proto_enumTypes[1]
+}
+
+func (x QueueOverflow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueOverflow.Descriptor instead.
+func (QueueOverflow) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{1}
+}
+
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_TYPE_SET          WatchEventType = 0
+	WatchEventType_WATCH_EVENT_TYPE_DELETE       WatchEventType = 1
+	WatchEventType_WATCH_EVENT_TYPE_EXPIRE       WatchEventType = 2
+	WatchEventType_WATCH_EVENT_TYPE_NEW          WatchEventType = 3
+	WatchEventType_WATCH_EVENT_TYPE_FIELD_SET    WatchEventType = 4
+	WatchEventType_WATCH_EVENT_TYPE_FIELD_DELETE WatchEventType = 5
+	WatchEventType_WATCH_EVENT_TYPE_PUSH         WatchEventType = 6
+	WatchEventType_WATCH_EVENT_TYPE_POP          WatchEventType = 7
+	// WATCH_EVENT_TYPE_TRIM informs the kept range of queue items
+	// as the field, formatted as "start:stop".
+	WatchEventType_WATCH_EVENT_TYPE_TRIM WatchEventType = 8
+)
+
+// Enum value maps for WatchEventType.
+var (
+	WatchEventType_name = map[int32]string{
+		0: "WATCH_EVENT_TYPE_SET",
+		1: "WATCH_EVENT_TYPE_DELETE",
+		2: "WATCH_EVENT_TYPE_EXPIRE",
+		3: "WATCH_EVENT_TYPE_NEW",
+		4: "WATCH_EVENT_TYPE_FIELD_SET",
+		5: "WATCH_EVENT_TYPE_FIELD_DELETE",
+		6: "WATCH_EVENT_TYPE_PUSH",
+		7: "WATCH_EVENT_TYPE_POP",
+		8: "WATCH_EVENT_TYPE_TRIM",
+	}
+	WatchEventType_value = map[string]int32{
+		"WATCH_EVENT_TYPE_SET":          0,
+		"WATCH_EVENT_TYPE_DELETE":       1,
+		"WATCH_EVENT_TYPE_EXPIRE":       2,
+		"WATCH_EVENT_TYPE_NEW":          3,
+		"WATCH_EVENT_TYPE_FIELD_SET":    4,
+		"WATCH_EVENT_TYPE_FIELD_DELETE": 5,
+		"WATCH_EVENT_TYPE_PUSH":         6,
+		"WATCH_EVENT_TYPE_POP":          7,
+		"WATCH_EVENT_TYPE_TRIM":         8,
+	}
+)
+
+func (x WatchEventType) Enum() *WatchEventType {
+	p := new(WatchEventType)
+	*p = x
+	return p
+}
+
+func (x WatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_protob_eventstore_proto_enumTypes[2].Descriptor()
+}
+
+func (WatchEventType) Type() protoreflect.EnumType {
+	return &file_pkg_protob_eventstore_proto_enumTypes[2]
+}
+
+func (x WatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventType.Descriptor instead.
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{2}
+}
+
+type KeyType int32
+
+const (
+	KeyType_TypeKV        KeyType = 0
+	KeyType_TypeMap       KeyType = 1
+	KeyType_TypeQueue     KeyType = 2
+	KeyType_TypeSet       KeyType = 3
+	KeyType_TypeSortedSet KeyType = 4
+	KeyType_TypeStream    KeyType = 5
+)
+
+// Enum value maps for KeyType.
+var (
+	KeyType_name = map[int32]string{
+		0: "TypeKV",
+		1: "TypeMap",
+		2: "TypeQueue",
+		3: "TypeSet",
+		4: "TypeSortedSet",
+		5: "TypeStream",
+	}
+	KeyType_value = map[string]int32{
+		"TypeKV":        0,
+		"TypeMap":       1,
+		"TypeQueue":     2,
+		"TypeSet":       3,
+		"TypeSortedSet": 4,
+		"TypeStream":    5,
+	}
+)
+
+func (x KeyType) Enum() *KeyType {
+	p := new(KeyType)
+	*p = x
+	return p
+}
+
+func (x KeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_protob_eventstore_proto_enumTypes[3].Descriptor()
+}
+
+func (KeyType) Type() protoreflect.EnumType {
+	return &file_pkg_protob_eventstore_proto_enumTypes[3]
+}
+
+func (x KeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyType.Descriptor instead.
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{3}
+}
+
 type ScopeType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -269,6 +446,8 @@ type SetKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SetKVResponse) Reset() {
@@ -303,13 +482,20 @@ func (*SetKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *SetKVResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type IncrKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Incr     int32         `protobuf:"varint,2,opt,name=incr,proto3" json:"incr,omitempty"`
+	Incr     int64         `protobuf:"varint,2,opt,name=incr,proto3" json:"incr,omitempty"`
 }
 
 func (x *IncrKVRequest) Reset() {
@@ -351,7 +537,7 @@ func (x *IncrKVRequest) GetLocation() *LocationType {
 	return nil
 }
 
-func (x *IncrKVRequest) GetIncr() int32 {
+func (x *IncrKVRequest) GetIncr() int64 {
 	if x != nil {
 		return x.Incr
 	}
@@ -362,6 +548,9 @@ type IncrKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// value after the increment.
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *IncrKVResponse) Reset() {
@@ -396,13 +585,20 @@ func (*IncrKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *IncrKVResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
 type DecrKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Decr     int32         `protobuf:"varint,2,opt,name=decr,proto3" json:"decr,omitempty"`
+	Decr     int64         `protobuf:"varint,2,opt,name=decr,proto3" json:"decr,omitempty"`
 }
 
 func (x *DecrKVRequest) Reset() {
@@ -444,7 +640,7 @@ func (x *DecrKVRequest) GetLocation() *LocationType {
 	return nil
 }
 
-func (x *DecrKVRequest) GetDecr() int32 {
+func (x *DecrKVRequest) GetDecr() int64 {
 	if x != nil {
 		return x.Decr
 	}
@@ -455,6 +651,9 @@ type DecrKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// value after the decrement.
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *DecrKVResponse) Reset() {
@@ -489,16 +688,24 @@ func (*DecrKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{7}
 }
 
-type GetKVRequest struct {
+func (x *DecrKVResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type IncrFloatKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Incr     float64       `protobuf:"fixed64,2,opt,name=incr,proto3" json:"incr,omitempty"`
 }
 
-func (x *GetKVRequest) Reset() {
-	*x = GetKVRequest{}
+func (x *IncrFloatKVRequest) Reset() {
+	*x = IncrFloatKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -506,13 +713,13 @@ func (x *GetKVRequest) Reset() {
 	}
 }
 
-func (x *GetKVRequest) String() string {
+func (x *IncrFloatKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKVRequest) ProtoMessage() {}
+func (*IncrFloatKVRequest) ProtoMessage() {}
 
-func (x *GetKVRequest) ProtoReflect() protoreflect.Message {
+func (x *IncrFloatKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,28 +731,36 @@ func (x *GetKVRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKVRequest.ProtoReflect.Descriptor instead.
-func (*GetKVRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrFloatKVRequest.ProtoReflect.Descriptor instead.
+func (*IncrFloatKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetKVRequest) GetLocation() *LocationType {
+func (x *IncrFloatKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type GetKVResponse struct {
+func (x *IncrFloatKVRequest) GetIncr() float64 {
+	if x != nil {
+		return x.Incr
+	}
+	return 0
+}
+
+type IncrFloatKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// value after the increment.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GetKVResponse) Reset() {
-	*x = GetKVResponse{}
+func (x *IncrFloatKVResponse) Reset() {
+	*x = IncrFloatKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,13 +768,13 @@ func (x *GetKVResponse) Reset() {
 	}
 }
 
-func (x *GetKVResponse) String() string {
+func (x *IncrFloatKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKVResponse) ProtoMessage() {}
+func (*IncrFloatKVResponse) ProtoMessage() {}
 
-func (x *GetKVResponse) ProtoReflect() protoreflect.Message {
+func (x *IncrFloatKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -571,19 +786,19 @@ func (x *GetKVResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKVResponse.ProtoReflect.Descriptor instead.
-func (*GetKVResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrFloatKVResponse.ProtoReflect.Descriptor instead.
+func (*IncrFloatKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetKVResponse) GetValue() []byte {
+func (x *IncrFloatKVResponse) GetValue() float64 {
 	if x != nil {
 		return x.Value
 	}
-	return nil
+	return 0
 }
 
-type DelKVRequest struct {
+type GetKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -591,8 +806,8 @@ type DelKVRequest struct {
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *DelKVRequest) Reset() {
-	*x = DelKVRequest{}
+func (x *GetKVRequest) Reset() {
+	*x = GetKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,13 +815,13 @@ func (x *DelKVRequest) Reset() {
 	}
 }
 
-func (x *DelKVRequest) String() string {
+func (x *GetKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelKVRequest) ProtoMessage() {}
+func (*GetKVRequest) ProtoMessage() {}
 
-func (x *DelKVRequest) ProtoReflect() protoreflect.Message {
+func (x *GetKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -618,26 +833,29 @@ func (x *DelKVRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelKVRequest.ProtoReflect.Descriptor instead.
-func (*DelKVRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetKVRequest.ProtoReflect.Descriptor instead.
+func (*GetKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DelKVRequest) GetLocation() *LocationType {
+func (x *GetKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type DelKVResponse struct {
+type GetKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Value   []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *DelKVResponse) Reset() {
-	*x = DelKVResponse{}
+func (x *GetKVResponse) Reset() {
+	*x = GetKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +863,13 @@ func (x *DelKVResponse) Reset() {
 	}
 }
 
-func (x *DelKVResponse) String() string {
+func (x *GetKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelKVResponse) ProtoMessage() {}
+func (*GetKVResponse) ProtoMessage() {}
 
-func (x *DelKVResponse) ProtoReflect() protoreflect.Message {
+func (x *GetKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,22 +881,35 @@ func (x *DelKVResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelKVResponse.ProtoReflect.Descriptor instead.
-func (*DelKVResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetKVResponse.ProtoReflect.Descriptor instead.
+func (*GetKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{11}
 }
 
-type LockRequest struct {
+func (x *GetKVResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetKVResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DelKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Timeout  int32         `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *LockRequest) Reset() {
-	*x = LockRequest{}
+func (x *DelKVRequest) Reset() {
+	*x = DelKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,13 +917,13 @@ func (x *LockRequest) Reset() {
 	}
 }
 
-func (x *LockRequest) String() string {
+func (x *DelKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockRequest) ProtoMessage() {}
+func (*DelKVRequest) ProtoMessage() {}
 
-func (x *LockRequest) ProtoReflect() protoreflect.Message {
+func (x *DelKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,35 +935,26 @@ func (x *LockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockRequest.ProtoReflect.Descriptor instead.
-func (*LockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelKVRequest.ProtoReflect.Descriptor instead.
+func (*DelKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LockRequest) GetLocation() *LocationType {
+func (x *DelKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *LockRequest) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-type LockResponse struct {
+type DelKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Unlock string `protobuf:"bytes,1,opt,name=unlock,proto3" json:"unlock,omitempty"`
 }
 
-func (x *LockResponse) Reset() {
-	*x = LockResponse{}
+func (x *DelKVResponse) Reset() {
+	*x = DelKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,13 +962,13 @@ func (x *LockResponse) Reset() {
 	}
 }
 
-func (x *LockResponse) String() string {
+func (x *DelKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockResponse) ProtoMessage() {}
+func (*DelKVResponse) ProtoMessage() {}
 
-func (x *LockResponse) ProtoReflect() protoreflect.Message {
+func (x *DelKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,29 +980,25 @@ func (x *LockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockResponse.ProtoReflect.Descriptor instead.
-func (*LockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelKVResponse.ProtoReflect.Descriptor instead.
+func (*DelKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LockResponse) GetUnlock() string {
-	if x != nil {
-		return x.Unlock
-	}
-	return ""
-}
-
-type UnlockRequest struct {
+type CompareAndSwapKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Unlock   string        `protobuf:"bytes,2,opt,name=unlock,proto3" json:"unlock,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value    []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// version that the stored value must have for the swap to happen.
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *UnlockRequest) Reset() {
-	*x = UnlockRequest{}
+func (x *CompareAndSwapKVRequest) Reset() {
+	*x = CompareAndSwapKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,13 +1006,13 @@ func (x *UnlockRequest) Reset() {
 	}
 }
 
-func (x *UnlockRequest) String() string {
+func (x *CompareAndSwapKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockRequest) ProtoMessage() {}
+func (*CompareAndSwapKVRequest) ProtoMessage() {}
 
-func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+func (x *CompareAndSwapKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,33 +1024,49 @@ func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
-func (*UnlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareAndSwapKVRequest.ProtoReflect.Descriptor instead.
+func (*CompareAndSwapKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UnlockRequest) GetLocation() *LocationType {
+func (x *CompareAndSwapKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *UnlockRequest) GetUnlock() string {
+func (x *CompareAndSwapKVRequest) GetTtl() int32 {
 	if x != nil {
-		return x.Unlock
+		return x.Ttl
 	}
-	return ""
+	return 0
 }
 
-type UnlockResponse struct {
+func (x *CompareAndSwapKVRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CompareAndSwapKVRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CompareAndSwapKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *UnlockResponse) Reset() {
-	*x = UnlockResponse{}
+func (x *CompareAndSwapKVResponse) Reset() {
+	*x = CompareAndSwapKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -840,13 +1074,13 @@ func (x *UnlockResponse) Reset() {
 	}
 }
 
-func (x *UnlockResponse) String() string {
+func (x *CompareAndSwapKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockResponse) ProtoMessage() {}
+func (*CompareAndSwapKVResponse) ProtoMessage() {}
 
-func (x *UnlockResponse) ProtoReflect() protoreflect.Message {
+func (x *CompareAndSwapKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -858,22 +1092,30 @@ func (x *UnlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockResponse.ProtoReflect.Descriptor instead.
-func (*UnlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareAndSwapKVResponse.ProtoReflect.Descriptor instead.
+func (*CompareAndSwapKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{15}
 }
 
-type NewMapRequest struct {
+func (x *CompareAndSwapKVResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SetIfNotExistsKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value    []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *NewMapRequest) Reset() {
-	*x = NewMapRequest{}
+func (x *SetIfNotExistsKVRequest) Reset() {
+	*x = SetIfNotExistsKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -881,13 +1123,13 @@ func (x *NewMapRequest) Reset() {
 	}
 }
 
-func (x *NewMapRequest) String() string {
+func (x *SetIfNotExistsKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewMapRequest) ProtoMessage() {}
+func (*SetIfNotExistsKVRequest) ProtoMessage() {}
 
-func (x *NewMapRequest) ProtoReflect() protoreflect.Message {
+func (x *SetIfNotExistsKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,33 +1141,42 @@ func (x *NewMapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewMapRequest.ProtoReflect.Descriptor instead.
-func (*NewMapRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetIfNotExistsKVRequest.ProtoReflect.Descriptor instead.
+func (*SetIfNotExistsKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *NewMapRequest) GetLocation() *LocationType {
+func (x *SetIfNotExistsKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *NewMapRequest) GetTtl() int32 {
+func (x *SetIfNotExistsKVRequest) GetTtl() int32 {
 	if x != nil {
 		return x.Ttl
 	}
 	return 0
 }
 
-type NewMapResponse struct {
+func (x *SetIfNotExistsKVRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetIfNotExistsKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *NewMapResponse) Reset() {
-	*x = NewMapResponse{}
+func (x *SetIfNotExistsKVResponse) Reset() {
+	*x = SetIfNotExistsKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -933,13 +1184,13 @@ func (x *NewMapResponse) Reset() {
 	}
 }
 
-func (x *NewMapResponse) String() string {
+func (x *SetIfNotExistsKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewMapResponse) ProtoMessage() {}
+func (*SetIfNotExistsKVResponse) ProtoMessage() {}
 
-func (x *NewMapResponse) ProtoReflect() protoreflect.Message {
+func (x *SetIfNotExistsKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -951,21 +1202,30 @@ func (x *NewMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewMapResponse.ProtoReflect.Descriptor instead.
-func (*NewMapResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetIfNotExistsKVResponse.ProtoReflect.Descriptor instead.
+func (*SetIfNotExistsKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{17}
 }
 
-type DelMapRequest struct {
+func (x *SetIfNotExistsKVResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SetIfExistsKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value    []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *DelMapRequest) Reset() {
-	*x = DelMapRequest{}
+func (x *SetIfExistsKVRequest) Reset() {
+	*x = SetIfExistsKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -973,13 +1233,13 @@ func (x *DelMapRequest) Reset() {
 	}
 }
 
-func (x *DelMapRequest) String() string {
+func (x *SetIfExistsKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelMapRequest) ProtoMessage() {}
+func (*SetIfExistsKVRequest) ProtoMessage() {}
 
-func (x *DelMapRequest) ProtoReflect() protoreflect.Message {
+func (x *SetIfExistsKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -991,26 +1251,42 @@ func (x *DelMapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelMapRequest.ProtoReflect.Descriptor instead.
-func (*DelMapRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetIfExistsKVRequest.ProtoReflect.Descriptor instead.
+func (*SetIfExistsKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DelMapRequest) GetLocation() *LocationType {
+func (x *SetIfExistsKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type DelMapResponse struct {
+func (x *SetIfExistsKVRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *SetIfExistsKVRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetIfExistsKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *DelMapResponse) Reset() {
-	*x = DelMapResponse{}
+func (x *SetIfExistsKVResponse) Reset() {
+	*x = SetIfExistsKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,13 +1294,13 @@ func (x *DelMapResponse) Reset() {
 	}
 }
 
-func (x *DelMapResponse) String() string {
+func (x *SetIfExistsKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelMapResponse) ProtoMessage() {}
+func (*SetIfExistsKVResponse) ProtoMessage() {}
 
-func (x *DelMapResponse) ProtoReflect() protoreflect.Message {
+func (x *SetIfExistsKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1036,21 +1312,34 @@ func (x *DelMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelMapResponse.ProtoReflect.Descriptor instead.
-func (*DelMapResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetIfExistsKVResponse.ProtoReflect.Descriptor instead.
+func (*SetIfExistsKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{19}
 }
 
-type GetAllMapFieldsRequest struct {
+func (x *SetIfExistsKVResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type LockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Timeout  int32         `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// owner optionally identifies the holder of the lock.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// wait is the number of seconds to wait in line for the lock
+	// when the key is locked, zero meaning to fail right away.
+	Wait int32 `protobuf:"varint,4,opt,name=wait,proto3" json:"wait,omitempty"`
 }
 
-func (x *GetAllMapFieldsRequest) Reset() {
-	*x = GetAllMapFieldsRequest{}
+func (x *LockRequest) Reset() {
+	*x = LockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1058,13 +1347,13 @@ func (x *GetAllMapFieldsRequest) Reset() {
 	}
 }
 
-func (x *GetAllMapFieldsRequest) String() string {
+func (x *LockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllMapFieldsRequest) ProtoMessage() {}
+func (*LockRequest) ProtoMessage() {}
 
-func (x *GetAllMapFieldsRequest) ProtoReflect() protoreflect.Message {
+func (x *LockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1076,28 +1365,52 @@ func (x *GetAllMapFieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllMapFieldsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllMapFieldsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LockRequest.ProtoReflect.Descriptor instead.
+func (*LockRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetAllMapFieldsRequest) GetLocation() *LocationType {
+func (x *LockRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type GetAllMapFieldsResponse struct {
+func (x *LockRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *LockRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockRequest) GetWait() int32 {
+	if x != nil {
+		return x.Wait
+	}
+	return 0
+}
+
+type LockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values map[string][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Unlock string `protobuf:"bytes,1,opt,name=unlock,proto3" json:"unlock,omitempty"`
+	// fencing_token is greater than the token of any lock
+	// previously acquired.
+	FencingToken uint64 `protobuf:"varint,2,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
 }
 
-func (x *GetAllMapFieldsResponse) Reset() {
-	*x = GetAllMapFieldsResponse{}
+func (x *LockResponse) Reset() {
+	*x = LockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1105,13 +1418,13 @@ func (x *GetAllMapFieldsResponse) Reset() {
 	}
 }
 
-func (x *GetAllMapFieldsResponse) String() string {
+func (x *LockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllMapFieldsResponse) ProtoMessage() {}
+func (*LockResponse) ProtoMessage() {}
 
-func (x *GetAllMapFieldsResponse) ProtoReflect() protoreflect.Message {
+func (x *LockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1123,28 +1436,36 @@ func (x *GetAllMapFieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllMapFieldsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllMapFieldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LockResponse.ProtoReflect.Descriptor instead.
+func (*LockResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetAllMapFieldsResponse) GetValues() map[string][]byte {
+func (x *LockResponse) GetUnlock() string {
 	if x != nil {
-		return x.Values
+		return x.Unlock
 	}
-	return nil
+	return ""
 }
 
-type LenMapRequest struct {
+func (x *LockResponse) GetFencingToken() uint64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+type UnlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Unlock   string        `protobuf:"bytes,2,opt,name=unlock,proto3" json:"unlock,omitempty"`
 }
 
-func (x *LenMapRequest) Reset() {
-	*x = LenMapRequest{}
+func (x *UnlockRequest) Reset() {
+	*x = UnlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1152,13 +1473,13 @@ func (x *LenMapRequest) Reset() {
 	}
 }
 
-func (x *LenMapRequest) String() string {
+func (x *UnlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LenMapRequest) ProtoMessage() {}
+func (*UnlockRequest) ProtoMessage() {}
 
-func (x *LenMapRequest) ProtoReflect() protoreflect.Message {
+func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1170,28 +1491,33 @@ func (x *LenMapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LenMapRequest.ProtoReflect.Descriptor instead.
-func (*LenMapRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LenMapRequest) GetLocation() *LocationType {
+func (x *UnlockRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type LenMapResponse struct {
+func (x *UnlockRequest) GetUnlock() string {
+	if x != nil {
+		return x.Unlock
+	}
+	return ""
+}
+
+type UnlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Len int32 `protobuf:"varint,1,opt,name=len,proto3" json:"len,omitempty"`
 }
 
-func (x *LenMapResponse) Reset() {
-	*x = LenMapResponse{}
+func (x *UnlockResponse) Reset() {
+	*x = UnlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1199,13 +1525,13 @@ func (x *LenMapResponse) Reset() {
 	}
 }
 
-func (x *LenMapResponse) String() string {
+func (x *UnlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LenMapResponse) ProtoMessage() {}
+func (*UnlockResponse) ProtoMessage() {}
 
-func (x *LenMapResponse) ProtoReflect() protoreflect.Message {
+func (x *UnlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,30 +1543,25 @@ func (x *LenMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LenMapResponse.ProtoReflect.Descriptor instead.
-func (*LenMapResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockResponse.ProtoReflect.Descriptor instead.
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *LenMapResponse) GetLen() int32 {
-	if x != nil {
-		return x.Len
-	}
-	return 0
-}
-
-type SetMapFieldRequest struct {
+type RefreshLockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
-	Value    []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Unlock   string        `protobuf:"bytes,2,opt,name=unlock,proto3" json:"unlock,omitempty"`
+	// timeout replaces the remaining time of the lock,
+	// zero meaning that it is held until unlocked.
+	Timeout int32 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *SetMapFieldRequest) Reset() {
-	*x = SetMapFieldRequest{}
+func (x *RefreshLockRequest) Reset() {
+	*x = RefreshLockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1248,13 +1569,13 @@ func (x *SetMapFieldRequest) Reset() {
 	}
 }
 
-func (x *SetMapFieldRequest) String() string {
+func (x *RefreshLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMapFieldRequest) ProtoMessage() {}
+func (*RefreshLockRequest) ProtoMessage() {}
 
-func (x *SetMapFieldRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshLockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,40 +1587,40 @@ func (x *SetMapFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMapFieldRequest.ProtoReflect.Descriptor instead.
-func (*SetMapFieldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshLockRequest.ProtoReflect.Descriptor instead.
+func (*RefreshLockRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SetMapFieldRequest) GetLocation() *LocationType {
+func (x *RefreshLockRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *SetMapFieldRequest) GetField() string {
+func (x *RefreshLockRequest) GetUnlock() string {
 	if x != nil {
-		return x.Field
+		return x.Unlock
 	}
 	return ""
 }
 
-func (x *SetMapFieldRequest) GetValue() []byte {
+func (x *RefreshLockRequest) GetTimeout() int32 {
 	if x != nil {
-		return x.Value
+		return x.Timeout
 	}
-	return nil
+	return 0
 }
 
-type SetMapFieldResponse struct {
+type RefreshLockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetMapFieldResponse) Reset() {
-	*x = SetMapFieldResponse{}
+func (x *RefreshLockResponse) Reset() {
+	*x = RefreshLockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1307,13 +1628,13 @@ func (x *SetMapFieldResponse) Reset() {
 	}
 }
 
-func (x *SetMapFieldResponse) String() string {
+func (x *RefreshLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMapFieldResponse) ProtoMessage() {}
+func (*RefreshLockResponse) ProtoMessage() {}
 
-func (x *SetMapFieldResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshLockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1325,23 +1646,24 @@ func (x *SetMapFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMapFieldResponse.ProtoReflect.Descriptor instead.
-func (*SetMapFieldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshLockResponse.ProtoReflect.Descriptor instead.
+func (*RefreshLockResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{25}
 }
 
-type IncrMapFieldRequest struct {
+type LockInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
-	Incr     int32         `protobuf:"varint,3,opt,name=incr,proto3" json:"incr,omitempty"`
+	// unlock is optionally informed to check whether
+	// the lock is held using it.
+	Unlock string `protobuf:"bytes,2,opt,name=unlock,proto3" json:"unlock,omitempty"`
 }
 
-func (x *IncrMapFieldRequest) Reset() {
-	*x = IncrMapFieldRequest{}
+func (x *LockInfoRequest) Reset() {
+	*x = LockInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1349,13 +1671,13 @@ func (x *IncrMapFieldRequest) Reset() {
 	}
 }
 
-func (x *IncrMapFieldRequest) String() string {
+func (x *LockInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrMapFieldRequest) ProtoMessage() {}
+func (*LockInfoRequest) ProtoMessage() {}
 
-func (x *IncrMapFieldRequest) ProtoReflect() protoreflect.Message {
+func (x *LockInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,40 +1689,45 @@ func (x *IncrMapFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrMapFieldRequest.ProtoReflect.Descriptor instead.
-func (*IncrMapFieldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LockInfoRequest.ProtoReflect.Descriptor instead.
+func (*LockInfoRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *IncrMapFieldRequest) GetLocation() *LocationType {
+func (x *LockInfoRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *IncrMapFieldRequest) GetField() string {
+func (x *LockInfoRequest) GetUnlock() string {
 	if x != nil {
-		return x.Field
+		return x.Unlock
 	}
 	return ""
 }
 
-func (x *IncrMapFieldRequest) GetIncr() int32 {
-	if x != nil {
-		return x.Incr
-	}
-	return 0
-}
-
-type IncrMapFieldResponse struct {
+type LockInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *IncrMapFieldResponse) Reset() {
-	*x = IncrMapFieldResponse{}
+	Locked bool   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// ttl is the remaining time of the lock in seconds,
+	// zero meaning that it is held until unlocked.
+	Ttl          int32  `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	FencingToken uint64 `protobuf:"varint,4,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
+	// held is true when the lock is held using the
+	// informed unlock code.
+	Held bool `protobuf:"varint,5,opt,name=held,proto3" json:"held,omitempty"`
+	// readers is the number of shared holders.
+	Readers int32 `protobuf:"varint,6,opt,name=readers,proto3" json:"readers,omitempty"`
+}
+
+func (x *LockInfoResponse) Reset() {
+	*x = LockInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1408,13 +1735,13 @@ func (x *IncrMapFieldResponse) Reset() {
 	}
 }
 
-func (x *IncrMapFieldResponse) String() string {
+func (x *LockInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrMapFieldResponse) ProtoMessage() {}
+func (*LockInfoResponse) ProtoMessage() {}
 
-func (x *IncrMapFieldResponse) ProtoReflect() protoreflect.Message {
+func (x *LockInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1426,23 +1753,64 @@ func (x *IncrMapFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrMapFieldResponse.ProtoReflect.Descriptor instead.
-func (*IncrMapFieldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LockInfoResponse.ProtoReflect.Descriptor instead.
+func (*LockInfoResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{27}
 }
 
-type DecrMapFieldRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LockInfoResponse) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *LockInfoResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockInfoResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *LockInfoResponse) GetFencingToken() uint64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+func (x *LockInfoResponse) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+func (x *LockInfoResponse) GetReaders() int32 {
+	if x != nil {
+		return x.Readers
+	}
+	return 0
+}
+
+type RLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
-	Decr     int32         `protobuf:"varint,3,opt,name=decr,proto3" json:"decr,omitempty"`
+	Timeout  int32         `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *DecrMapFieldRequest) Reset() {
-	*x = DecrMapFieldRequest{}
+func (x *RLockRequest) Reset() {
+	*x = RLockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1450,13 +1818,13 @@ func (x *DecrMapFieldRequest) Reset() {
 	}
 }
 
-func (x *DecrMapFieldRequest) String() string {
+func (x *RLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecrMapFieldRequest) ProtoMessage() {}
+func (*RLockRequest) ProtoMessage() {}
 
-func (x *DecrMapFieldRequest) ProtoReflect() protoreflect.Message {
+func (x *RLockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1468,40 +1836,35 @@ func (x *DecrMapFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecrMapFieldRequest.ProtoReflect.Descriptor instead.
-func (*DecrMapFieldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RLockRequest.ProtoReflect.Descriptor instead.
+func (*RLockRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DecrMapFieldRequest) GetLocation() *LocationType {
+func (x *RLockRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *DecrMapFieldRequest) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
-}
-
-func (x *DecrMapFieldRequest) GetDecr() int32 {
+func (x *RLockRequest) GetTimeout() int32 {
 	if x != nil {
-		return x.Decr
+		return x.Timeout
 	}
 	return 0
 }
 
-type DecrMapFieldResponse struct {
+type RLockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Unlock string `protobuf:"bytes,1,opt,name=unlock,proto3" json:"unlock,omitempty"`
 }
 
-func (x *DecrMapFieldResponse) Reset() {
-	*x = DecrMapFieldResponse{}
+func (x *RLockResponse) Reset() {
+	*x = RLockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1509,13 +1872,13 @@ func (x *DecrMapFieldResponse) Reset() {
 	}
 }
 
-func (x *DecrMapFieldResponse) String() string {
+func (x *RLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecrMapFieldResponse) ProtoMessage() {}
+func (*RLockResponse) ProtoMessage() {}
 
-func (x *DecrMapFieldResponse) ProtoReflect() protoreflect.Message {
+func (x *RLockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1527,22 +1890,29 @@ func (x *DecrMapFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecrMapFieldResponse.ProtoReflect.Descriptor instead.
-func (*DecrMapFieldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RLockResponse.ProtoReflect.Descriptor instead.
+func (*RLockResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{29}
 }
 
-type DelMapFieldRequest struct {
+func (x *RLockResponse) GetUnlock() string {
+	if x != nil {
+		return x.Unlock
+	}
+	return ""
+}
+
+type RUnlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Unlock   string        `protobuf:"bytes,2,opt,name=unlock,proto3" json:"unlock,omitempty"`
 }
 
-func (x *DelMapFieldRequest) Reset() {
-	*x = DelMapFieldRequest{}
+func (x *RUnlockRequest) Reset() {
+	*x = RUnlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1550,13 +1920,13 @@ func (x *DelMapFieldRequest) Reset() {
 	}
 }
 
-func (x *DelMapFieldRequest) String() string {
+func (x *RUnlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelMapFieldRequest) ProtoMessage() {}
+func (*RUnlockRequest) ProtoMessage() {}
 
-func (x *DelMapFieldRequest) ProtoReflect() protoreflect.Message {
+func (x *RUnlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1568,33 +1938,33 @@ func (x *DelMapFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelMapFieldRequest.ProtoReflect.Descriptor instead.
-func (*DelMapFieldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RUnlockRequest.ProtoReflect.Descriptor instead.
+func (*RUnlockRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DelMapFieldRequest) GetLocation() *LocationType {
+func (x *RUnlockRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *DelMapFieldRequest) GetField() string {
+func (x *RUnlockRequest) GetUnlock() string {
 	if x != nil {
-		return x.Field
+		return x.Unlock
 	}
 	return ""
 }
 
-type DelMapFieldResponse struct {
+type RUnlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelMapFieldResponse) Reset() {
-	*x = DelMapFieldResponse{}
+func (x *RUnlockResponse) Reset() {
+	*x = RUnlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1602,13 +1972,13 @@ func (x *DelMapFieldResponse) Reset() {
 	}
 }
 
-func (x *DelMapFieldResponse) String() string {
+func (x *RUnlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelMapFieldResponse) ProtoMessage() {}
+func (*RUnlockResponse) ProtoMessage() {}
 
-func (x *DelMapFieldResponse) ProtoReflect() protoreflect.Message {
+func (x *RUnlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1620,22 +1990,27 @@ func (x *DelMapFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelMapFieldResponse.ProtoReflect.Descriptor instead.
-func (*DelMapFieldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RUnlockResponse.ProtoReflect.Descriptor instead.
+func (*RUnlockResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{31}
 }
 
-type GetMapFieldRequest struct {
+type AcquireRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// permits to take from the semaphore.
+	Permits int32 `protobuf:"varint,2,opt,name=permits,proto3" json:"permits,omitempty"`
+	// limit is the number of permits the semaphore grants
+	// at the same time.
+	Limit   int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Timeout int32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *GetMapFieldRequest) Reset() {
-	*x = GetMapFieldRequest{}
+func (x *AcquireRequest) Reset() {
+	*x = AcquireRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1643,13 +2018,13 @@ func (x *GetMapFieldRequest) Reset() {
 	}
 }
 
-func (x *GetMapFieldRequest) String() string {
+func (x *AcquireRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMapFieldRequest) ProtoMessage() {}
+func (*AcquireRequest) ProtoMessage() {}
 
-func (x *GetMapFieldRequest) ProtoReflect() protoreflect.Message {
+func (x *AcquireRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,35 +2036,49 @@ func (x *GetMapFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMapFieldRequest.ProtoReflect.Descriptor instead.
-func (*GetMapFieldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcquireRequest.ProtoReflect.Descriptor instead.
+func (*AcquireRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetMapFieldRequest) GetLocation() *LocationType {
+func (x *AcquireRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *GetMapFieldRequest) GetField() string {
+func (x *AcquireRequest) GetPermits() int32 {
 	if x != nil {
-		return x.Field
+		return x.Permits
 	}
-	return ""
+	return 0
 }
 
-type GetMapFieldResponse struct {
+func (x *AcquireRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AcquireRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type AcquireResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Release string `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
 }
 
-func (x *GetMapFieldResponse) Reset() {
-	*x = GetMapFieldResponse{}
+func (x *AcquireResponse) Reset() {
+	*x = AcquireResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1697,13 +2086,13 @@ func (x *GetMapFieldResponse) Reset() {
 	}
 }
 
-func (x *GetMapFieldResponse) String() string {
+func (x *AcquireResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMapFieldResponse) ProtoMessage() {}
+func (*AcquireResponse) ProtoMessage() {}
 
-func (x *GetMapFieldResponse) ProtoReflect() protoreflect.Message {
+func (x *AcquireResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1715,29 +2104,29 @@ func (x *GetMapFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMapFieldResponse.ProtoReflect.Descriptor instead.
-func (*GetMapFieldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcquireResponse.ProtoReflect.Descriptor instead.
+func (*AcquireResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetMapFieldResponse) GetValue() []byte {
+func (x *AcquireResponse) GetRelease() string {
 	if x != nil {
-		return x.Value
+		return x.Release
 	}
-	return nil
+	return ""
 }
 
-type NewQueueRequest struct {
+type ReleaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Release  string        `protobuf:"bytes,2,opt,name=release,proto3" json:"release,omitempty"`
 }
 
-func (x *NewQueueRequest) Reset() {
-	*x = NewQueueRequest{}
+func (x *ReleaseRequest) Reset() {
+	*x = ReleaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1745,13 +2134,13 @@ func (x *NewQueueRequest) Reset() {
 	}
 }
 
-func (x *NewQueueRequest) String() string {
+func (x *ReleaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewQueueRequest) ProtoMessage() {}
+func (*ReleaseRequest) ProtoMessage() {}
 
-func (x *NewQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *ReleaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1763,33 +2152,33 @@ func (x *NewQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewQueueRequest.ProtoReflect.Descriptor instead.
-func (*NewQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *NewQueueRequest) GetLocation() *LocationType {
+func (x *ReleaseRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *NewQueueRequest) GetTtl() int32 {
+func (x *ReleaseRequest) GetRelease() string {
 	if x != nil {
-		return x.Ttl
+		return x.Release
 	}
-	return 0
+	return ""
 }
 
-type NewQueueResponse struct {
+type ReleaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *NewQueueResponse) Reset() {
-	*x = NewQueueResponse{}
+func (x *ReleaseResponse) Reset() {
+	*x = ReleaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1797,13 +2186,13 @@ func (x *NewQueueResponse) Reset() {
 	}
 }
 
-func (x *NewQueueResponse) String() string {
+func (x *ReleaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewQueueResponse) ProtoMessage() {}
+func (*ReleaseResponse) ProtoMessage() {}
 
-func (x *NewQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *ReleaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,12 +2204,12 @@ func (x *NewQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewQueueResponse.ProtoReflect.Descriptor instead.
-func (*NewQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{35}
 }
 
-type DelQueueRequest struct {
+type TTLKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1828,8 +2217,8 @@ type DelQueueRequest struct {
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *DelQueueRequest) Reset() {
-	*x = DelQueueRequest{}
+func (x *TTLKVRequest) Reset() {
+	*x = TTLKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,13 +2226,13 @@ func (x *DelQueueRequest) Reset() {
 	}
 }
 
-func (x *DelQueueRequest) String() string {
+func (x *TTLKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelQueueRequest) ProtoMessage() {}
+func (*TTLKVRequest) ProtoMessage() {}
 
-func (x *DelQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *TTLKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,26 +2244,30 @@ func (x *DelQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelQueueRequest.ProtoReflect.Descriptor instead.
-func (*DelQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TTLKVRequest.ProtoReflect.Descriptor instead.
+func (*TTLKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DelQueueRequest) GetLocation() *LocationType {
+func (x *TTLKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type DelQueueResponse struct {
+type TTLKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *DelQueueResponse) Reset() {
-	*x = DelQueueResponse{}
+func (x *TTLKVResponse) Reset() {
+	*x = TTLKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1882,13 +2275,13 @@ func (x *DelQueueResponse) Reset() {
 	}
 }
 
-func (x *DelQueueResponse) String() string {
+func (x *TTLKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelQueueResponse) ProtoMessage() {}
+func (*TTLKVResponse) ProtoMessage() {}
 
-func (x *DelQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *TTLKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1900,21 +2293,29 @@ func (x *DelQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelQueueResponse.ProtoReflect.Descriptor instead.
-func (*DelQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TTLKVResponse.ProtoReflect.Descriptor instead.
+func (*TTLKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{37}
 }
 
-type GetAllQueuesRequest struct {
+func (x *TTLKVResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *GetAllQueuesRequest) Reset() {
-	*x = GetAllQueuesRequest{}
+func (x *ExpireKVRequest) Reset() {
+	*x = ExpireKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1922,13 +2323,13 @@ func (x *GetAllQueuesRequest) Reset() {
 	}
 }
 
-func (x *GetAllQueuesRequest) String() string {
+func (x *ExpireKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllQueuesRequest) ProtoMessage() {}
+func (*ExpireKVRequest) ProtoMessage() {}
 
-func (x *GetAllQueuesRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpireKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1940,28 +2341,33 @@ func (x *GetAllQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllQueuesRequest.ProtoReflect.Descriptor instead.
-func (*GetAllQueuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpireKVRequest.ProtoReflect.Descriptor instead.
+func (*ExpireKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetAllQueuesRequest) GetLocation() *LocationType {
+func (x *ExpireKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type GetAllQueuesResponse struct {
+func (x *ExpireKVRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Values [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *GetAllQueuesResponse) Reset() {
-	*x = GetAllQueuesResponse{}
+func (x *ExpireKVResponse) Reset() {
+	*x = ExpireKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1969,13 +2375,13 @@ func (x *GetAllQueuesResponse) Reset() {
 	}
 }
 
-func (x *GetAllQueuesResponse) String() string {
+func (x *ExpireKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllQueuesResponse) ProtoMessage() {}
+func (*ExpireKVResponse) ProtoMessage() {}
 
-func (x *GetAllQueuesResponse) ProtoReflect() protoreflect.Message {
+func (x *ExpireKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1987,19 +2393,12 @@ func (x *GetAllQueuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllQueuesResponse.ProtoReflect.Descriptor instead.
-func (*GetAllQueuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpireKVResponse.ProtoReflect.Descriptor instead.
+func (*ExpireKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetAllQueuesResponse) GetValues() [][]byte {
-	if x != nil {
-		return x.Values
-	}
-	return nil
-}
-
-type LenQueueRequest struct {
+type PersistKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2007,8 +2406,8 @@ type LenQueueRequest struct {
 	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *LenQueueRequest) Reset() {
-	*x = LenQueueRequest{}
+func (x *PersistKVRequest) Reset() {
+	*x = PersistKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2016,13 +2415,13 @@ func (x *LenQueueRequest) Reset() {
 	}
 }
 
-func (x *LenQueueRequest) String() string {
+func (x *PersistKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LenQueueRequest) ProtoMessage() {}
+func (*PersistKVRequest) ProtoMessage() {}
 
-func (x *LenQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *PersistKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,28 +2433,26 @@ func (x *LenQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LenQueueRequest.ProtoReflect.Descriptor instead.
-func (*LenQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PersistKVRequest.ProtoReflect.Descriptor instead.
+func (*PersistKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LenQueueRequest) GetLocation() *LocationType {
+func (x *PersistKVRequest) GetLocation() *LocationType {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-type LenQueueResponse struct {
+type PersistKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Len int32 `protobuf:"varint,1,opt,name=len,proto3" json:"len,omitempty"`
 }
 
-func (x *LenQueueResponse) Reset() {
-	*x = LenQueueResponse{}
+func (x *PersistKVResponse) Reset() {
+	*x = PersistKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,13 +2460,13 @@ func (x *LenQueueResponse) Reset() {
 	}
 }
 
-func (x *LenQueueResponse) String() string {
+func (x *PersistKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LenQueueResponse) ProtoMessage() {}
+func (*PersistKVResponse) ProtoMessage() {}
 
-func (x *LenQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *PersistKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2081,29 +2478,22 @@ func (x *LenQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LenQueueResponse.ProtoReflect.Descriptor instead.
-func (*LenQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PersistKVResponse.ProtoReflect.Descriptor instead.
+func (*PersistKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *LenQueueResponse) GetLen() int32 {
-	if x != nil {
-		return x.Len
-	}
-	return 0
-}
-
-type PushQueueRequest struct {
+type MGetKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Value    []byte        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Scope *ScopeType `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Keys  []string   `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *PushQueueRequest) Reset() {
-	*x = PushQueueRequest{}
+func (x *MGetKVRequest) Reset() {
+	*x = MGetKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2111,13 +2501,13 @@ func (x *PushQueueRequest) Reset() {
 	}
 }
 
-func (x *PushQueueRequest) String() string {
+func (x *MGetKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushQueueRequest) ProtoMessage() {}
+func (*MGetKVRequest) ProtoMessage() {}
 
-func (x *PushQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *MGetKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2129,33 +2519,39 @@ func (x *PushQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushQueueRequest.ProtoReflect.Descriptor instead.
-func (*PushQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MGetKVRequest.ProtoReflect.Descriptor instead.
+func (*MGetKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PushQueueRequest) GetLocation() *LocationType {
+func (x *MGetKVRequest) GetScope() *ScopeType {
 	if x != nil {
-		return x.Location
+		return x.Scope
 	}
 	return nil
 }
 
-func (x *PushQueueRequest) GetValue() []byte {
+func (x *MGetKVRequest) GetKeys() []string {
 	if x != nil {
-		return x.Value
+		return x.Keys
 	}
 	return nil
 }
 
-type PushQueueResponse struct {
+type KVItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// found is false when the key does not exist.
+	Found bool `protobuf:"varint,4,opt,name=found,proto3" json:"found,omitempty"`
 }
 
-func (x *PushQueueResponse) Reset() {
-	*x = PushQueueResponse{}
+func (x *KVItem) Reset() {
+	*x = KVItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2163,13 +2559,13 @@ func (x *PushQueueResponse) Reset() {
 	}
 }
 
-func (x *PushQueueResponse) String() string {
+func (x *KVItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushQueueResponse) ProtoMessage() {}
+func (*KVItem) ProtoMessage() {}
 
-func (x *PushQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *KVItem) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2181,22 +2577,50 @@ func (x *PushQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushQueueResponse.ProtoReflect.Descriptor instead.
-func (*PushQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KVItem.ProtoReflect.Descriptor instead.
+func (*KVItem) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{43}
 }
 
-type IndexQueueRequest struct {
-	state         protoimpl.MessageState
+func (x *KVItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVItem) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVItem) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *KVItem) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type MGetKVResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Index    int32         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// items in the same order as the requested keys.
+	Items []*KVItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *IndexQueueRequest) Reset() {
-	*x = IndexQueueRequest{}
+func (x *MGetKVResponse) Reset() {
+	*x = MGetKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2204,13 +2628,13 @@ func (x *IndexQueueRequest) Reset() {
 	}
 }
 
-func (x *IndexQueueRequest) String() string {
+func (x *MGetKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndexQueueRequest) ProtoMessage() {}
+func (*MGetKVResponse) ProtoMessage() {}
 
-func (x *IndexQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *MGetKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2222,35 +2646,30 @@ func (x *IndexQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndexQueueRequest.ProtoReflect.Descriptor instead.
-func (*IndexQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MGetKVResponse.ProtoReflect.Descriptor instead.
+func (*MGetKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *IndexQueueRequest) GetLocation() *LocationType {
+func (x *MGetKVResponse) GetItems() []*KVItem {
 	if x != nil {
-		return x.Location
+		return x.Items
 	}
 	return nil
 }
 
-func (x *IndexQueueRequest) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-type IndexQueueResponse struct {
+type KVEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ttl   int32  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *IndexQueueResponse) Reset() {
-	*x = IndexQueueResponse{}
+func (x *KVEntry) Reset() {
+	*x = KVEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2258,13 +2677,13 @@ func (x *IndexQueueResponse) Reset() {
 	}
 }
 
-func (x *IndexQueueResponse) String() string {
+func (x *KVEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndexQueueResponse) ProtoMessage() {}
+func (*KVEntry) ProtoMessage() {}
 
-func (x *IndexQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *KVEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2276,28 +2695,43 @@ func (x *IndexQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndexQueueResponse.ProtoReflect.Descriptor instead.
-func (*IndexQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KVEntry.ProtoReflect.Descriptor instead.
+func (*KVEntry) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *IndexQueueResponse) GetValue() []byte {
+func (x *KVEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVEntry) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *KVEntry) GetValue() []byte {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type PopQueueRequest struct {
+type MSetKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Scope   *ScopeType `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Entries []*KVEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *PopQueueRequest) Reset() {
-	*x = PopQueueRequest{}
+func (x *MSetKVRequest) Reset() {
+	*x = MSetKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2305,13 +2739,13 @@ func (x *PopQueueRequest) Reset() {
 	}
 }
 
-func (x *PopQueueRequest) String() string {
+func (x *MSetKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PopQueueRequest) ProtoMessage() {}
+func (*MSetKVRequest) ProtoMessage() {}
 
-func (x *PopQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *MSetKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2323,28 +2757,36 @@ func (x *PopQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PopQueueRequest.ProtoReflect.Descriptor instead.
-func (*PopQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MSetKVRequest.ProtoReflect.Descriptor instead.
+func (*MSetKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *PopQueueRequest) GetLocation() *LocationType {
+func (x *MSetKVRequest) GetScope() *ScopeType {
 	if x != nil {
-		return x.Location
+		return x.Scope
 	}
 	return nil
 }
 
-type PopQueueResponse struct {
+func (x *MSetKVRequest) GetEntries() []*KVEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MSetKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// versions in the same order as the requested entries.
+	Versions []uint64 `protobuf:"varint,1,rep,packed,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *PopQueueResponse) Reset() {
-	*x = PopQueueResponse{}
+func (x *MSetKVResponse) Reset() {
+	*x = MSetKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2352,13 +2794,13 @@ func (x *PopQueueResponse) Reset() {
 	}
 }
 
-func (x *PopQueueResponse) String() string {
+func (x *MSetKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PopQueueResponse) ProtoMessage() {}
+func (*MSetKVResponse) ProtoMessage() {}
 
-func (x *PopQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *MSetKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2370,28 +2812,29 @@ func (x *PopQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PopQueueResponse.ProtoReflect.Descriptor instead.
-func (*PopQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MSetKVResponse.ProtoReflect.Descriptor instead.
+func (*MSetKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PopQueueResponse) GetValue() []byte {
+func (x *MSetKVResponse) GetVersions() []uint64 {
 	if x != nil {
-		return x.Value
+		return x.Versions
 	}
 	return nil
 }
 
-type PeekQueueRequest struct {
+type MDelKVRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Scope *ScopeType `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Keys  []string   `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *PeekQueueRequest) Reset() {
-	*x = PeekQueueRequest{}
+func (x *MDelKVRequest) Reset() {
+	*x = MDelKVRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2399,13 +2842,13 @@ func (x *PeekQueueRequest) Reset() {
 	}
 }
 
-func (x *PeekQueueRequest) String() string {
+func (x *MDelKVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeekQueueRequest) ProtoMessage() {}
+func (*MDelKVRequest) ProtoMessage() {}
 
-func (x *PeekQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *MDelKVRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2417,28 +2860,36 @@ func (x *PeekQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeekQueueRequest.ProtoReflect.Descriptor instead.
-func (*PeekQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MDelKVRequest.ProtoReflect.Descriptor instead.
+func (*MDelKVRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PeekQueueRequest) GetLocation() *LocationType {
+func (x *MDelKVRequest) GetScope() *ScopeType {
 	if x != nil {
-		return x.Location
+		return x.Scope
 	}
 	return nil
 }
 
-type PeekQueueResponse struct {
+func (x *MDelKVRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type MDelKVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// keys is the number of keys removed, missing keys are skipped.
+	Keys int32 `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *PeekQueueResponse) Reset() {
-	*x = PeekQueueResponse{}
+func (x *MDelKVResponse) Reset() {
+	*x = MDelKVResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_protob_eventstore_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2446,13 +2897,13 @@ func (x *PeekQueueResponse) Reset() {
 	}
 }
 
-func (x *PeekQueueResponse) String() string {
+func (x *MDelKVResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeekQueueResponse) ProtoMessage() {}
+func (*MDelKVResponse) ProtoMessage() {}
 
-func (x *PeekQueueResponse) ProtoReflect() protoreflect.Message {
+func (x *MDelKVResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_protob_eventstore_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2464,499 +2915,12688 @@ func (x *PeekQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeekQueueResponse.ProtoReflect.Descriptor instead.
-func (*PeekQueueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MDelKVResponse.ProtoReflect.Descriptor instead.
+func (*MDelKVResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PeekQueueResponse) GetValue() []byte {
+func (x *MDelKVResponse) GetKeys() int32 {
 	if x != nil {
-		return x.Value
+		return x.Keys
 	}
-	return nil
+	return 0
 }
 
-var File_pkg_protob_eventstore_proto protoreflect.FileDescriptor
+type NewMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_protob_eventstore_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x22, 0x68, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x43,
-	0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
-	0x49, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x68, 0x0a, 0x0c, 0x53, 0x65,
-	0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x22, 0x10, 0x0a, 0x0e,
-	0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55,
-	0x0a, 0x0d, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x63, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x64, 0x65, 0x63, 0x72, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4b, 0x56,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x40, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x26,
-	0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x59, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x10, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x4d,
-	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x10, 0x0a,
-	0x0e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a, 0x0d, 0x4c, 0x65, 0x6e, 0x4d, 0x61,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x0e, 0x4c, 0x65,
-	0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0x72,
-	0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x13, 0x49, 0x6e, 0x63,
-	0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x22, 0x16, 0x0a, 0x14,
-	0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x63, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x64, 0x65, 0x63, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x72, 0x4d,
-	0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x5c, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x15, 0x0a,
-	0x13, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x55, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x0f, 0x44, 0x65,
-	0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x12, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x0f,
-	0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x24, 0x0a, 0x10, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x12, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x43, 0x0a, 0x0f, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x10, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x44, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x11, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x2a, 0x33, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
-	0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x10, 0x02, 0x32, 0x88, 0x03, 0x0a, 0x02, 0x4b, 0x56, 0x12, 0x34, 0x0a,
-	0x03, 0x53, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65,
-	0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72,
-	0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04,
-	0x44, 0x65, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65,
-	0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x14, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4b,
-	0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x47,
-	0x65, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b,
-	0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x32, 0xd6, 0x05, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x03, 0x4e, 0x65, 0x77,
-	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61,
-	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61,
-	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x36, 0x0a, 0x03, 0x4c, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x03, 0x44, 0x65, 0x6c,
-	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49,
-	0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72,
-	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x63, 0x72, 0x12,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x65, 0x74, 0x12,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x6f,
-	0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x39, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfe, 0x03, 0x0a, 0x05, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x12, 0x3a, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65,
-	0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x03, 0x4c, 0x65, 0x6e, 0x12, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3d, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x03, 0x50, 0x6f, 0x70, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x2e, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x04,
-	0x50, 0x65, 0x65, 0x6b, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65,
-	0x65, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x76, 0x0a, 0x04, 0x53,
-	0x79, 0x6e, 0x63, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-var (
-	file_pkg_protob_eventstore_proto_rawDescOnce sync.Once
-	file_pkg_protob_eventstore_proto_rawDescData = file_pkg_protob_eventstore_proto_rawDesc
-)
+func (x *NewMapRequest) Reset() {
+	*x = NewMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pkg_protob_eventstore_proto_rawDescGZIP() []byte {
-	file_pkg_protob_eventstore_proto_rawDescOnce.Do(func() {
-		file_pkg_protob_eventstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_protob_eventstore_proto_rawDescData)
-	})
-	return file_pkg_protob_eventstore_proto_rawDescData
+func (x *NewMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pkg_protob_eventstore_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_protob_eventstore_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
-var file_pkg_protob_eventstore_proto_goTypes = []interface{}{
-	(ScopeChoice)(0),                // 0: protob.ScopeChoice
-	(*ScopeType)(nil),               // 1: protob.ScopeType
-	(*LocationType)(nil),            // 2: protob.LocationType
-	(*SetKVRequest)(nil),            // 3: protob.SetKVRequest
-	(*SetKVResponse)(nil),           // 4: protob.SetKVResponse
-	(*IncrKVRequest)(nil),           // 5: protob.IncrKVRequest
-	(*IncrKVResponse)(nil),          // 6: protob.IncrKVResponse
-	(*DecrKVRequest)(nil),           // 7: protob.DecrKVRequest
-	(*DecrKVResponse)(nil),          // 8: protob.DecrKVResponse
-	(*GetKVRequest)(nil),            // 9: protob.GetKVRequest
-	(*GetKVResponse)(nil),           // 10: protob.GetKVResponse
-	(*DelKVRequest)(nil),            // 11: protob.DelKVRequest
-	(*DelKVResponse)(nil),           // 12: protob.DelKVResponse
-	(*LockRequest)(nil),             // 13: protob.LockRequest
-	(*LockResponse)(nil),            // 14: protob.LockResponse
-	(*UnlockRequest)(nil),           // 15: protob.UnlockRequest
-	(*UnlockResponse)(nil),          // 16: protob.UnlockResponse
-	(*NewMapRequest)(nil),           // 17: protob.NewMapRequest
-	(*NewMapResponse)(nil),          // 18: protob.NewMapResponse
-	(*DelMapRequest)(nil),           // 19: protob.DelMapRequest
-	(*DelMapResponse)(nil),          // 20: protob.DelMapResponse
-	(*GetAllMapFieldsRequest)(nil),  // 21: protob.GetAllMapFieldsRequest
-	(*GetAllMapFieldsResponse)(nil), // 22: protob.GetAllMapFieldsResponse
-	(*LenMapRequest)(nil),           // 23: protob.LenMapRequest
-	(*LenMapResponse)(nil),          // 24: protob.LenMapResponse
-	(*SetMapFieldRequest)(nil),      // 25: protob.SetMapFieldRequest
-	(*SetMapFieldResponse)(nil),     // 26: protob.SetMapFieldResponse
-	(*IncrMapFieldRequest)(nil),     // 27: protob.IncrMapFieldRequest
-	(*IncrMapFieldResponse)(nil),    // 28: protob.IncrMapFieldResponse
-	(*DecrMapFieldRequest)(nil),     // 29: protob.DecrMapFieldRequest
-	(*DecrMapFieldResponse)(nil),    // 30: protob.DecrMapFieldResponse
-	(*DelMapFieldRequest)(nil),      // 31: protob.DelMapFieldRequest
-	(*DelMapFieldResponse)(nil),     // 32: protob.DelMapFieldResponse
-	(*GetMapFieldRequest)(nil),      // 33: protob.GetMapFieldRequest
-	(*GetMapFieldResponse)(nil),     // 34: protob.GetMapFieldResponse
-	(*NewQueueRequest)(nil),         // 35: protob.NewQueueRequest
-	(*NewQueueResponse)(nil),        // 36: protob.NewQueueResponse
-	(*DelQueueRequest)(nil),         // 37: protob.DelQueueRequest
-	(*DelQueueResponse)(nil),        // 38: protob.DelQueueResponse
-	(*GetAllQueuesRequest)(nil),     // 39: protob.GetAllQueuesRequest
-	(*GetAllQueuesResponse)(nil),    // 40: protob.GetAllQueuesResponse
-	(*LenQueueRequest)(nil),         // 41: protob.LenQueueRequest
-	(*LenQueueResponse)(nil),        // 42: protob.LenQueueResponse
-	(*PushQueueRequest)(nil),        // 43: protob.PushQueueRequest
-	(*PushQueueResponse)(nil),       // 44: protob.PushQueueResponse
-	(*IndexQueueRequest)(nil),       // 45: protob.IndexQueueRequest
-	(*IndexQueueResponse)(nil),      // 46: protob.IndexQueueResponse
-	(*PopQueueRequest)(nil),         // 47: protob.PopQueueRequest
-	(*PopQueueResponse)(nil),        // 48: protob.PopQueueResponse
-	(*PeekQueueRequest)(nil),        // 49: protob.PeekQueueRequest
-	(*PeekQueueResponse)(nil),       // 50: protob.PeekQueueResponse
-	nil,                             // 51: protob.GetAllMapFieldsResponse.ValuesEntry
+func (*NewMapRequest) ProtoMessage() {}
+
+func (x *NewMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pkg_protob_eventstore_proto_depIdxs = []int32{
-	0,  // 0: protob.ScopeType.type:type_name -> protob.ScopeChoice
-	1,  // 1: protob.LocationType.scope:type_name -> protob.ScopeType
-	2,  // 2: protob.SetKVRequest.location:type_name -> protob.LocationType
-	2,  // 3: protob.IncrKVRequest.location:type_name -> protob.LocationType
-	2,  // 4: protob.DecrKVRequest.location:type_name -> protob.LocationType
-	2,  // 5: protob.GetKVRequest.location:type_name -> protob.LocationType
-	2,  // 6: protob.DelKVRequest.location:type_name -> protob.LocationType
-	2,  // 7: protob.LockRequest.location:type_name -> protob.LocationType
-	2,  // 8: protob.UnlockRequest.location:type_name -> protob.LocationType
-	2,  // 9: protob.NewMapRequest.location:type_name -> protob.LocationType
-	2,  // 10: protob.DelMapRequest.location:type_name -> protob.LocationType
-	2,  // 11: protob.GetAllMapFieldsRequest.location:type_name -> protob.LocationType
-	51, // 12: protob.GetAllMapFieldsResponse.values:type_name -> protob.GetAllMapFieldsResponse.ValuesEntry
-	2,  // 13: protob.LenMapRequest.location:type_name -> protob.LocationType
-	2,  // 14: protob.SetMapFieldRequest.location:type_name -> protob.LocationType
-	2,  // 15: protob.IncrMapFieldRequest.location:type_name -> protob.LocationType
-	2,  // 16: protob.DecrMapFieldRequest.location:type_name -> protob.LocationType
-	2,  // 17: protob.DelMapFieldRequest.location:type_name -> protob.LocationType
-	2,  // 18: protob.GetMapFieldRequest.location:type_name -> protob.LocationType
-	2,  // 19: protob.NewQueueRequest.location:type_name -> protob.LocationType
-	2,  // 20: protob.DelQueueRequest.location:type_name -> protob.LocationType
-	2,  // 21: protob.GetAllQueuesRequest.location:type_name -> protob.LocationType
-	2,  // 22: protob.LenQueueRequest.location:type_name -> protob.LocationType
-	2,  // 23: protob.PushQueueRequest.location:type_name -> protob.LocationType
-	2,  // 24: protob.IndexQueueRequest.location:type_name -> protob.LocationType
-	2,  // 25: protob.PopQueueRequest.location:type_name -> protob.LocationType
-	2,  // 26: protob.PeekQueueRequest.location:type_name -> protob.LocationType
-	3,  // 27: protob.KV.Set:input_type -> protob.SetKVRequest
-	5,  // 28: protob.KV.Incr:input_type -> protob.IncrKVRequest
-	7,  // 29: protob.KV.Decr:input_type -> protob.DecrKVRequest
-	11, // 30: protob.KV.Del:input_type -> protob.DelKVRequest
-	9,  // 31: protob.KV.Get:input_type -> protob.GetKVRequest
-	13, // 32: protob.KV.Lock:input_type -> protob.LockRequest
-	15, // 33: protob.KV.Unlock:input_type -> protob.UnlockRequest
-	17, // 34: protob.Map.New:input_type -> protob.NewMapRequest
-	21, // 35: protob.Map.GetFields:input_type -> protob.GetAllMapFieldsRequest
-	23, // 36: protob.Map.Len:input_type -> protob.LenMapRequest
-	19, // 37: protob.Map.Del:input_type -> protob.DelMapRequest
-	25, // 38: protob.Map.FieldSet:input_type -> protob.SetMapFieldRequest
-	27, // 39: protob.Map.FieldIncr:input_type -> protob.IncrMapFieldRequest
-	29, // 40: protob.Map.FieldDecr:input_type -> protob.DecrMapFieldRequest
-	31, // 41: protob.Map.FieldDel:input_type -> protob.DelMapFieldRequest
-	33, // 42: protob.Map.FieldGet:input_type -> protob.GetMapFieldRequest
-	13, // 43: protob.Map.Lock:input_type -> protob.LockRequest
-	15, // 44: protob.Map.Unlock:input_type -> protob.UnlockRequest
-	35, // 45: protob.Queue.New:input_type -> protob.NewQueueRequest
-	39, // 46: protob.Queue.GetAll:input_type -> protob.GetAllQueuesRequest
-	41, // 47: protob.Queue.Len:input_type -> protob.LenQueueRequest
-	37, // 48: protob.Queue.Del:input_type -> protob.DelQueueRequest
-	43, // 49: protob.Queue.Push:input_type -> protob.PushQueueRequest
-	45, // 50: protob.Queue.Index:input_type -> protob.IndexQueueRequest
-	47, // 51: protob.Queue.Pop:input_type -> protob.PopQueueRequest
-	49, // 52: protob.Queue.Peek:input_type -> protob.PeekQueueRequest
-	13, // 53: protob.Sync.Lock:input_type -> protob.LockRequest
-	15, // 54: protob.Sync.Unlock:input_type -> protob.UnlockRequest
-	4,  // 55: protob.KV.Set:output_type -> protob.SetKVResponse
-	6,  // 56: protob.KV.Incr:output_type -> protob.IncrKVResponse
-	8,  // 57: protob.KV.Decr:output_type -> protob.DecrKVResponse
-	12, // 58: protob.KV.Del:output_type -> protob.DelKVResponse
-	10, // 59: protob.KV.Get:output_type -> protob.GetKVResponse
-	14, // 60: protob.KV.Lock:output_type -> protob.LockResponse
-	16, // 61: protob.KV.Unlock:output_type -> protob.UnlockResponse
-	18, // 62: protob.Map.New:output_type -> protob.NewMapResponse
-	22, // 63: protob.Map.GetFields:output_type -> protob.GetAllMapFieldsResponse
-	24, // 64: protob.Map.Len:output_type -> protob.LenMapResponse
-	20, // 65: protob.Map.Del:output_type -> protob.DelMapResponse
-	26, // 66: protob.Map.FieldSet:output_type -> protob.SetMapFieldResponse
-	28, // 67: protob.Map.FieldIncr:output_type -> protob.IncrMapFieldResponse
-	30, // 68: protob.Map.FieldDecr:output_type -> protob.DecrMapFieldResponse
-	32, // 69: protob.Map.FieldDel:output_type -> protob.DelMapFieldResponse
-	34, // 70: protob.Map.FieldGet:output_type -> protob.GetMapFieldResponse
-	14, // 71: protob.Map.Lock:output_type -> protob.LockResponse
-	16, // 72: protob.Map.Unlock:output_type -> protob.UnlockResponse
-	36, // 73: protob.Queue.New:output_type -> protob.NewQueueResponse
-	40, // 74: protob.Queue.GetAll:output_type -> protob.GetAllQueuesResponse
-	42, // 75: protob.Queue.Len:output_type -> protob.LenQueueResponse
-	38, // 76: protob.Queue.Del:output_type -> protob.DelQueueResponse
-	44, // 77: protob.Queue.Push:output_type -> protob.PushQueueResponse
-	46, // 78: protob.Queue.Index:output_type -> protob.IndexQueueResponse
-	48, // 79: protob.Queue.Pop:output_type -> protob.PopQueueResponse
-	50, // 80: protob.Queue.Peek:output_type -> protob.PeekQueueResponse
-	14, // 81: protob.Sync.Lock:output_type -> protob.LockResponse
-	16, // 82: protob.Sync.Unlock:output_type -> protob.UnlockResponse
-	55, // [55:83] is the sub-list for method output_type
-	27, // [27:55] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+
+// Deprecated: Use NewMapRequest.ProtoReflect.Descriptor instead.
+func (*NewMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{50}
 }
 
-func init() { file_pkg_protob_eventstore_proto_init() }
-func file_pkg_protob_eventstore_proto_init() {
-	if File_pkg_protob_eventstore_proto != nil {
-		return
+func (x *NewMapRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_protob_eventstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScopeType); i {
+	return nil
+}
+
+func (x *NewMapRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type NewMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NewMapResponse) Reset() {
+	*x = NewMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewMapResponse) ProtoMessage() {}
+
+func (x *NewMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewMapResponse.ProtoReflect.Descriptor instead.
+func (*NewMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{51}
+}
+
+type DelMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *DelMapRequest) Reset() {
+	*x = DelMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelMapRequest) ProtoMessage() {}
+
+func (x *DelMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelMapRequest.ProtoReflect.Descriptor instead.
+func (*DelMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DelMapRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DelMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelMapResponse) Reset() {
+	*x = DelMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelMapResponse) ProtoMessage() {}
+
+func (x *DelMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelMapResponse.ProtoReflect.Descriptor instead.
+func (*DelMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{53}
+}
+
+type GetAllMapFieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *GetAllMapFieldsRequest) Reset() {
+	*x = GetAllMapFieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllMapFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllMapFieldsRequest) ProtoMessage() {}
+
+func (x *GetAllMapFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllMapFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllMapFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetAllMapFieldsRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetAllMapFieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values map[string][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetAllMapFieldsResponse) Reset() {
+	*x = GetAllMapFieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllMapFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllMapFieldsResponse) ProtoMessage() {}
+
+func (x *GetAllMapFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllMapFieldsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllMapFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetAllMapFieldsResponse) GetValues() map[string][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LenMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *LenMapRequest) Reset() {
+	*x = LenMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LenMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LenMapRequest) ProtoMessage() {}
+
+func (x *LenMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LenMapRequest.ProtoReflect.Descriptor instead.
+func (*LenMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LenMapRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type LenMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Len int32 `protobuf:"varint,1,opt,name=len,proto3" json:"len,omitempty"`
+}
+
+func (x *LenMapResponse) Reset() {
+	*x = LenMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LenMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LenMapResponse) ProtoMessage() {}
+
+func (x *LenMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LenMapResponse.ProtoReflect.Descriptor instead.
+func (*LenMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LenMapResponse) GetLen() int32 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+type SetMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value    []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// ttl of the field in seconds, zero meaning
+	// that the field lives as long as the map.
+	Ttl int32 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *SetMapFieldRequest) Reset() {
+	*x = SetMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMapFieldRequest) ProtoMessage() {}
+
+func (x *SetMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*SetMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SetMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SetMapFieldRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetMapFieldRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type SetMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetMapFieldResponse) Reset() {
+	*x = SetMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMapFieldResponse) ProtoMessage() {}
+
+func (x *SetMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*SetMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{59}
+}
+
+type SetIfNotExistsMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value    []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Ttl      int32         `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *SetIfNotExistsMapFieldRequest) Reset() {
+	*x = SetIfNotExistsMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIfNotExistsMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIfNotExistsMapFieldRequest) ProtoMessage() {}
+
+func (x *SetIfNotExistsMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIfNotExistsMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*SetIfNotExistsMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetIfNotExistsMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SetIfNotExistsMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SetIfNotExistsMapFieldRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetIfNotExistsMapFieldRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type SetIfNotExistsMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetIfNotExistsMapFieldResponse) Reset() {
+	*x = SetIfNotExistsMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIfNotExistsMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIfNotExistsMapFieldResponse) ProtoMessage() {}
+
+func (x *SetIfNotExistsMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIfNotExistsMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*SetIfNotExistsMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{61}
+}
+
+type MapFieldEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Ttl   int32  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MapFieldEntry) Reset() {
+	*x = MapFieldEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapFieldEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapFieldEntry) ProtoMessage() {}
+
+func (x *MapFieldEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapFieldEntry.ProtoReflect.Descriptor instead.
+func (*MapFieldEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MapFieldEntry) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *MapFieldEntry) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *MapFieldEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type MSetMapFieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType    `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Entries  []*MapFieldEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *MSetMapFieldsRequest) Reset() {
+	*x = MSetMapFieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetMapFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetMapFieldsRequest) ProtoMessage() {}
+
+func (x *MSetMapFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetMapFieldsRequest.ProtoReflect.Descriptor instead.
+func (*MSetMapFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MSetMapFieldsRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *MSetMapFieldsRequest) GetEntries() []*MapFieldEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MSetMapFieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MSetMapFieldsResponse) Reset() {
+	*x = MSetMapFieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetMapFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetMapFieldsResponse) ProtoMessage() {}
+
+func (x *MSetMapFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetMapFieldsResponse.ProtoReflect.Descriptor instead.
+func (*MSetMapFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{64}
+}
+
+type MGetMapFieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Fields   []string      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *MGetMapFieldsRequest) Reset() {
+	*x = MGetMapFieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetMapFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetMapFieldsRequest) ProtoMessage() {}
+
+func (x *MGetMapFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetMapFieldsRequest.ProtoReflect.Descriptor instead.
+func (*MGetMapFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MGetMapFieldsRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *MGetMapFieldsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type MapFieldItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// found is false when the field does not exist.
+	Found bool `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *MapFieldItem) Reset() {
+	*x = MapFieldItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapFieldItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapFieldItem) ProtoMessage() {}
+
+func (x *MapFieldItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapFieldItem.ProtoReflect.Descriptor instead.
+func (*MapFieldItem) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MapFieldItem) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *MapFieldItem) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MapFieldItem) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type MGetMapFieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// items in the same order as the requested fields.
+	Items []*MapFieldItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *MGetMapFieldsResponse) Reset() {
+	*x = MGetMapFieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetMapFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetMapFieldsResponse) ProtoMessage() {}
+
+func (x *MGetMapFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetMapFieldsResponse.ProtoReflect.Descriptor instead.
+func (*MGetMapFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MGetMapFieldsResponse) GetItems() []*MapFieldItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type IncrMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Incr     int64         `protobuf:"varint,3,opt,name=incr,proto3" json:"incr,omitempty"`
+}
+
+func (x *IncrMapFieldRequest) Reset() {
+	*x = IncrMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrMapFieldRequest) ProtoMessage() {}
+
+func (x *IncrMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*IncrMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *IncrMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *IncrMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *IncrMapFieldRequest) GetIncr() int64 {
+	if x != nil {
+		return x.Incr
+	}
+	return 0
+}
+
+type IncrMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// value after the increment.
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *IncrMapFieldResponse) Reset() {
+	*x = IncrMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrMapFieldResponse) ProtoMessage() {}
+
+func (x *IncrMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*IncrMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *IncrMapFieldResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DecrMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Decr     int64         `protobuf:"varint,3,opt,name=decr,proto3" json:"decr,omitempty"`
+}
+
+func (x *DecrMapFieldRequest) Reset() {
+	*x = DecrMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecrMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrMapFieldRequest) ProtoMessage() {}
+
+func (x *DecrMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*DecrMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DecrMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *DecrMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DecrMapFieldRequest) GetDecr() int64 {
+	if x != nil {
+		return x.Decr
+	}
+	return 0
+}
+
+type DecrMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// value after the decrement.
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DecrMapFieldResponse) Reset() {
+	*x = DecrMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecrMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrMapFieldResponse) ProtoMessage() {}
+
+func (x *DecrMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*DecrMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DecrMapFieldResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type IncrFloatMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Incr     float64       `protobuf:"fixed64,3,opt,name=incr,proto3" json:"incr,omitempty"`
+}
+
+func (x *IncrFloatMapFieldRequest) Reset() {
+	*x = IncrFloatMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrFloatMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrFloatMapFieldRequest) ProtoMessage() {}
+
+func (x *IncrFloatMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrFloatMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*IncrFloatMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *IncrFloatMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *IncrFloatMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *IncrFloatMapFieldRequest) GetIncr() float64 {
+	if x != nil {
+		return x.Incr
+	}
+	return 0
+}
+
+type IncrFloatMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// value after the increment.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *IncrFloatMapFieldResponse) Reset() {
+	*x = IncrFloatMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrFloatMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrFloatMapFieldResponse) ProtoMessage() {}
+
+func (x *IncrFloatMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrFloatMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*IncrFloatMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *IncrFloatMapFieldResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DelMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *DelMapFieldRequest) Reset() {
+	*x = DelMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelMapFieldRequest) ProtoMessage() {}
+
+func (x *DelMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*DelMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DelMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *DelMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type DelMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelMapFieldResponse) Reset() {
+	*x = DelMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelMapFieldResponse) ProtoMessage() {}
+
+func (x *DelMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*DelMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{75}
+}
+
+type GetMapFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string        `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *GetMapFieldRequest) Reset() {
+	*x = GetMapFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMapFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapFieldRequest) ProtoMessage() {}
+
+func (x *GetMapFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapFieldRequest.ProtoReflect.Descriptor instead.
+func (*GetMapFieldRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetMapFieldRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetMapFieldRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type GetMapFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetMapFieldResponse) Reset() {
+	*x = GetMapFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMapFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapFieldResponse) ProtoMessage() {}
+
+func (x *GetMapFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapFieldResponse.ProtoReflect.Descriptor instead.
+func (*GetMapFieldResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetMapFieldResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type TTLMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *TTLMapRequest) Reset() {
+	*x = TTLMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLMapRequest) ProtoMessage() {}
+
+func (x *TTLMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLMapRequest.ProtoReflect.Descriptor instead.
+func (*TTLMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TTLMapRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type TTLMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TTLMapResponse) Reset() {
+	*x = TTLMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLMapResponse) ProtoMessage() {}
+
+func (x *TTLMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLMapResponse.ProtoReflect.Descriptor instead.
+func (*TTLMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TTLMapResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *ExpireMapRequest) Reset() {
+	*x = ExpireMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireMapRequest) ProtoMessage() {}
+
+func (x *ExpireMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireMapRequest.ProtoReflect.Descriptor instead.
+func (*ExpireMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExpireMapRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ExpireMapRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireMapResponse) Reset() {
+	*x = ExpireMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireMapResponse) ProtoMessage() {}
+
+func (x *ExpireMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireMapResponse.ProtoReflect.Descriptor instead.
+func (*ExpireMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{81}
+}
+
+type PersistMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PersistMapRequest) Reset() {
+	*x = PersistMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistMapRequest) ProtoMessage() {}
+
+func (x *PersistMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistMapRequest.ProtoReflect.Descriptor instead.
+func (*PersistMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PersistMapRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PersistMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PersistMapResponse) Reset() {
+	*x = PersistMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistMapResponse) ProtoMessage() {}
+
+func (x *PersistMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistMapResponse.ProtoReflect.Descriptor instead.
+func (*PersistMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{83}
+}
+
+type NewQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// max_len is the maximum number of elements,
+	// zero meaning that the queue is unbounded.
+	MaxLen   int32         `protobuf:"varint,3,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`
+	Overflow QueueOverflow `protobuf:"varint,4,opt,name=overflow,proto3,enum=protob.QueueOverflow" json:"overflow,omitempty"`
+}
+
+func (x *NewQueueRequest) Reset() {
+	*x = NewQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewQueueRequest) ProtoMessage() {}
+
+func (x *NewQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewQueueRequest.ProtoReflect.Descriptor instead.
+func (*NewQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *NewQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NewQueueRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *NewQueueRequest) GetMaxLen() int32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *NewQueueRequest) GetOverflow() QueueOverflow {
+	if x != nil {
+		return x.Overflow
+	}
+	return QueueOverflow_OverflowReject
+}
+
+type NewQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NewQueueResponse) Reset() {
+	*x = NewQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewQueueResponse) ProtoMessage() {}
+
+func (x *NewQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewQueueResponse.ProtoReflect.Descriptor instead.
+func (*NewQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{85}
+}
+
+type DelQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *DelQueueRequest) Reset() {
+	*x = DelQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelQueueRequest) ProtoMessage() {}
+
+func (x *DelQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelQueueRequest.ProtoReflect.Descriptor instead.
+func (*DelQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DelQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DelQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelQueueResponse) Reset() {
+	*x = DelQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelQueueResponse) ProtoMessage() {}
+
+func (x *DelQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelQueueResponse.ProtoReflect.Descriptor instead.
+func (*DelQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{87}
+}
+
+type GetAllQueuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *GetAllQueuesRequest) Reset() {
+	*x = GetAllQueuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllQueuesRequest) ProtoMessage() {}
+
+func (x *GetAllQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllQueuesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetAllQueuesRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetAllQueuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetAllQueuesResponse) Reset() {
+	*x = GetAllQueuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllQueuesResponse) ProtoMessage() {}
+
+func (x *GetAllQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllQueuesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetAllQueuesResponse) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LenQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *LenQueueRequest) Reset() {
+	*x = LenQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LenQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LenQueueRequest) ProtoMessage() {}
+
+func (x *LenQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LenQueueRequest.ProtoReflect.Descriptor instead.
+func (*LenQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LenQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type LenQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Len int32 `protobuf:"varint,1,opt,name=len,proto3" json:"len,omitempty"`
+}
+
+func (x *LenQueueResponse) Reset() {
+	*x = LenQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LenQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LenQueueResponse) ProtoMessage() {}
+
+func (x *LenQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LenQueueResponse.ProtoReflect.Descriptor instead.
+func (*LenQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *LenQueueResponse) GetLen() int32 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+type PushQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Value    []byte        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// priority of the item, items with higher priorities are popped
+	// first. Items with the same priority are popped in order.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// delay is the number of seconds the item is hidden
+	// after being pushed.
+	Delay int32 `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *PushQueueRequest) Reset() {
+	*x = PushQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushQueueRequest) ProtoMessage() {}
+
+func (x *PushQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushQueueRequest.ProtoReflect.Descriptor instead.
+func (*PushQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PushQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PushQueueRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PushQueueRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PushQueueRequest) GetDelay() int32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type PushQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushQueueResponse) Reset() {
+	*x = PushQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushQueueResponse) ProtoMessage() {}
+
+func (x *PushQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushQueueResponse.ProtoReflect.Descriptor instead.
+func (*PushQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{93}
+}
+
+type PushFrontQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Value    []byte        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PushFrontQueueRequest) Reset() {
+	*x = PushFrontQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFrontQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFrontQueueRequest) ProtoMessage() {}
+
+func (x *PushFrontQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFrontQueueRequest.ProtoReflect.Descriptor instead.
+func (*PushFrontQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PushFrontQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PushFrontQueueRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type PushFrontQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushFrontQueueResponse) Reset() {
+	*x = PushFrontQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFrontQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFrontQueueResponse) ProtoMessage() {}
+
+func (x *PushFrontQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFrontQueueResponse.ProtoReflect.Descriptor instead.
+func (*PushFrontQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{95}
+}
+
+type IndexQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Index    int32         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *IndexQueueRequest) Reset() {
+	*x = IndexQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexQueueRequest) ProtoMessage() {}
+
+func (x *IndexQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexQueueRequest.ProtoReflect.Descriptor instead.
+func (*IndexQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *IndexQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *IndexQueueRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type IndexQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *IndexQueueResponse) Reset() {
+	*x = IndexQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexQueueResponse) ProtoMessage() {}
+
+func (x *IndexQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexQueueResponse.ProtoReflect.Descriptor instead.
+func (*IndexQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *IndexQueueResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type PopQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PopQueueRequest) Reset() {
+	*x = PopQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopQueueRequest) ProtoMessage() {}
+
+func (x *PopQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopQueueRequest.ProtoReflect.Descriptor instead.
+func (*PopQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PopQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PopQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PopQueueResponse) Reset() {
+	*x = PopQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopQueueResponse) ProtoMessage() {}
+
+func (x *PopQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopQueueResponse.ProtoReflect.Descriptor instead.
+func (*PopQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PopQueueResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type PopBackQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PopBackQueueRequest) Reset() {
+	*x = PopBackQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopBackQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopBackQueueRequest) ProtoMessage() {}
+
+func (x *PopBackQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopBackQueueRequest.ProtoReflect.Descriptor instead.
+func (*PopBackQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PopBackQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PopBackQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PopBackQueueResponse) Reset() {
+	*x = PopBackQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopBackQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopBackQueueResponse) ProtoMessage() {}
+
+func (x *PopBackQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopBackQueueResponse.ProtoReflect.Descriptor instead.
+func (*PopBackQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PopBackQueueResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type RangeQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// start and stop are inclusive,
+	// negative indexes count from the tail.
+	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop  int32 `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *RangeQueueRequest) Reset() {
+	*x = RangeQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeQueueRequest) ProtoMessage() {}
+
+func (x *RangeQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeQueueRequest.ProtoReflect.Descriptor instead.
+func (*RangeQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RangeQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RangeQueueRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *RangeQueueRequest) GetStop() int32 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type RangeQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *RangeQueueResponse) Reset() {
+	*x = RangeQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeQueueResponse) ProtoMessage() {}
+
+func (x *RangeQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeQueueResponse.ProtoReflect.Descriptor instead.
+func (*RangeQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RangeQueueResponse) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type TrimQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// start and stop are inclusive,
+	// negative indexes count from the tail.
+	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop  int32 `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *TrimQueueRequest) Reset() {
+	*x = TrimQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrimQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrimQueueRequest) ProtoMessage() {}
+
+func (x *TrimQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrimQueueRequest.ProtoReflect.Descriptor instead.
+func (*TrimQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TrimQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *TrimQueueRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TrimQueueRequest) GetStop() int32 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type TrimQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrimQueueResponse) Reset() {
+	*x = TrimQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrimQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrimQueueResponse) ProtoMessage() {}
+
+func (x *TrimQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrimQueueResponse.ProtoReflect.Descriptor instead.
+func (*TrimQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{105}
+}
+
+type PeekQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PeekQueueRequest) Reset() {
+	*x = PeekQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeekQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeekQueueRequest) ProtoMessage() {}
+
+func (x *PeekQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeekQueueRequest.ProtoReflect.Descriptor instead.
+func (*PeekQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *PeekQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PeekQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PeekQueueResponse) Reset() {
+	*x = PeekQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeekQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeekQueueResponse) ProtoMessage() {}
+
+func (x *PeekQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeekQueueResponse.ProtoReflect.Descriptor instead.
+func (*PeekQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *PeekQueueResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BlockingPopQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// timeout is the number of seconds to wait for an item,
+	// zero meaning that there is no timeout.
+	Timeout int32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *BlockingPopQueueRequest) Reset() {
+	*x = BlockingPopQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockingPopQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingPopQueueRequest) ProtoMessage() {}
+
+func (x *BlockingPopQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingPopQueueRequest.ProtoReflect.Descriptor instead.
+func (*BlockingPopQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BlockingPopQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *BlockingPopQueueRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type BlockingPopQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BlockingPopQueueResponse) Reset() {
+	*x = BlockingPopQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockingPopQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingPopQueueResponse) ProtoMessage() {}
+
+func (x *BlockingPopQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingPopQueueResponse.ProtoReflect.Descriptor instead.
+func (*BlockingPopQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BlockingPopQueueResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ReserveQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// visibility is the number of seconds the item is hidden from
+	// other consumers until it is acknowledged.
+	Visibility int32 `protobuf:"varint,2,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// max_deliveries is the number of times an item is delivered before
+	// being moved to the dead-letter queue, zero meaning unlimited.
+	MaxDeliveries int32 `protobuf:"varint,3,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"`
+	// dead_letter is the key of the queue, at the same scope, where
+	// items are moved after max_deliveries. Items are dropped when
+	// it is not informed.
+	DeadLetter string `protobuf:"bytes,4,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+}
+
+func (x *ReserveQueueRequest) Reset() {
+	*x = ReserveQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveQueueRequest) ProtoMessage() {}
+
+func (x *ReserveQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReserveQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ReserveQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ReserveQueueRequest) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *ReserveQueueRequest) GetMaxDeliveries() int32 {
+	if x != nil {
+		return x.MaxDeliveries
+	}
+	return 0
+}
+
+func (x *ReserveQueueRequest) GetDeadLetter() string {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return ""
+}
+
+type ReserveQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the delivery when acknowledging the item.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// deliveries is the number of times the item has been
+	// delivered, including this one.
+	Deliveries int32 `protobuf:"varint,3,opt,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ReserveQueueResponse) Reset() {
+	*x = ReserveQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveQueueResponse) ProtoMessage() {}
+
+func (x *ReserveQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveQueueResponse.ProtoReflect.Descriptor instead.
+func (*ReserveQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ReserveQueueResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReserveQueueResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ReserveQueueResponse) GetDeliveries() int32 {
+	if x != nil {
+		return x.Deliveries
+	}
+	return 0
+}
+
+type AckQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Id       string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AckQueueRequest) Reset() {
+	*x = AckQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckQueueRequest) ProtoMessage() {}
+
+func (x *AckQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckQueueRequest.ProtoReflect.Descriptor instead.
+func (*AckQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AckQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AckQueueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AckQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AckQueueResponse) Reset() {
+	*x = AckQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckQueueResponse) ProtoMessage() {}
+
+func (x *AckQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckQueueResponse.ProtoReflect.Descriptor instead.
+func (*AckQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{113}
+}
+
+type NackQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Id       string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NackQueueRequest) Reset() {
+	*x = NackQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NackQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NackQueueRequest) ProtoMessage() {}
+
+func (x *NackQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NackQueueRequest.ProtoReflect.Descriptor instead.
+func (*NackQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *NackQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NackQueueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NackQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NackQueueResponse) Reset() {
+	*x = NackQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NackQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NackQueueResponse) ProtoMessage() {}
+
+func (x *NackQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NackQueueResponse.ProtoReflect.Descriptor instead.
+func (*NackQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{115}
+}
+
+type TTLQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *TTLQueueRequest) Reset() {
+	*x = TTLQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLQueueRequest) ProtoMessage() {}
+
+func (x *TTLQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLQueueRequest.ProtoReflect.Descriptor instead.
+func (*TTLQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TTLQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type TTLQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TTLQueueResponse) Reset() {
+	*x = TTLQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLQueueResponse) ProtoMessage() {}
+
+func (x *TTLQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLQueueResponse.ProtoReflect.Descriptor instead.
+func (*TTLQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *TTLQueueResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *ExpireQueueRequest) Reset() {
+	*x = ExpireQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireQueueRequest) ProtoMessage() {}
+
+func (x *ExpireQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireQueueRequest.ProtoReflect.Descriptor instead.
+func (*ExpireQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ExpireQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ExpireQueueRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireQueueResponse) Reset() {
+	*x = ExpireQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireQueueResponse) ProtoMessage() {}
+
+func (x *ExpireQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireQueueResponse.ProtoReflect.Descriptor instead.
+func (*ExpireQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{119}
+}
+
+type PersistQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PersistQueueRequest) Reset() {
+	*x = PersistQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistQueueRequest) ProtoMessage() {}
+
+func (x *PersistQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistQueueRequest.ProtoReflect.Descriptor instead.
+func (*PersistQueueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PersistQueueRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PersistQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PersistQueueResponse) Reset() {
+	*x = PersistQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistQueueResponse) ProtoMessage() {}
+
+func (x *PersistQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistQueueResponse.ProtoReflect.Descriptor instead.
+func (*PersistQueueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{121}
+}
+
+type NewSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *NewSetRequest) Reset() {
+	*x = NewSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewSetRequest) ProtoMessage() {}
+
+func (x *NewSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewSetRequest.ProtoReflect.Descriptor instead.
+func (*NewSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *NewSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NewSetRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type NewSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NewSetResponse) Reset() {
+	*x = NewSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewSetResponse) ProtoMessage() {}
+
+func (x *NewSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewSetResponse.ProtoReflect.Descriptor instead.
+func (*NewSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{123}
+}
+
+type DelSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *DelSetRequest) Reset() {
+	*x = DelSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSetRequest) ProtoMessage() {}
+
+func (x *DelSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSetRequest.ProtoReflect.Descriptor instead.
+func (*DelSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DelSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DelSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelSetResponse) Reset() {
+	*x = DelSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSetResponse) ProtoMessage() {}
+
+func (x *DelSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSetResponse.ProtoReflect.Descriptor instead.
+func (*DelSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{125}
+}
+
+type AddSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Members  []string      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *AddSetRequest) Reset() {
+	*x = AddSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSetRequest) ProtoMessage() {}
+
+func (x *AddSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSetRequest.ProtoReflect.Descriptor instead.
+func (*AddSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *AddSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AddSetRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// added is the number of members that were not in the set.
+	Added int32 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *AddSetResponse) Reset() {
+	*x = AddSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSetResponse) ProtoMessage() {}
+
+func (x *AddSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSetResponse.ProtoReflect.Descriptor instead.
+func (*AddSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *AddSetResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type RemoveSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Members  []string      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *RemoveSetRequest) Reset() {
+	*x = RemoveSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSetRequest) ProtoMessage() {}
+
+func (x *RemoveSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSetRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *RemoveSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RemoveSetRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type RemoveSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// removed is the number of members that were in the set.
+	Removed int32 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *RemoveSetResponse) Reset() {
+	*x = RemoveSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSetResponse) ProtoMessage() {}
+
+func (x *RemoveSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSetResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RemoveSetResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type IsMemberSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Member   string        `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *IsMemberSetRequest) Reset() {
+	*x = IsMemberSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsMemberSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMemberSetRequest) ProtoMessage() {}
+
+func (x *IsMemberSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMemberSetRequest.ProtoReflect.Descriptor instead.
+func (*IsMemberSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *IsMemberSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *IsMemberSetRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type IsMemberSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsMember bool `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+}
+
+func (x *IsMemberSetResponse) Reset() {
+	*x = IsMemberSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsMemberSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMemberSetResponse) ProtoMessage() {}
+
+func (x *IsMemberSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMemberSetResponse.ProtoReflect.Descriptor instead.
+func (*IsMemberSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *IsMemberSetResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type MembersSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *MembersSetRequest) Reset() {
+	*x = MembersSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MembersSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembersSetRequest) ProtoMessage() {}
+
+func (x *MembersSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembersSetRequest.ProtoReflect.Descriptor instead.
+func (*MembersSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *MembersSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type MembersSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *MembersSetResponse) Reset() {
+	*x = MembersSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MembersSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembersSetResponse) ProtoMessage() {}
+
+func (x *MembersSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembersSetResponse.ProtoReflect.Descriptor instead.
+func (*MembersSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *MembersSetResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type CardSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *CardSetRequest) Reset() {
+	*x = CardSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSetRequest) ProtoMessage() {}
+
+func (x *CardSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSetRequest.ProtoReflect.Descriptor instead.
+func (*CardSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CardSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type CardSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card int32 `protobuf:"varint,1,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *CardSetResponse) Reset() {
+	*x = CardSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSetResponse) ProtoMessage() {}
+
+func (x *CardSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSetResponse.ProtoReflect.Descriptor instead.
+func (*CardSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CardSetResponse) GetCard() int32 {
+	if x != nil {
+		return x.Card
+	}
+	return 0
+}
+
+type PopSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PopSetRequest) Reset() {
+	*x = PopSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopSetRequest) ProtoMessage() {}
+
+func (x *PopSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopSetRequest.ProtoReflect.Descriptor instead.
+func (*PopSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *PopSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PopSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *PopSetResponse) Reset() {
+	*x = PopSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopSetResponse) ProtoMessage() {}
+
+func (x *PopSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopSetResponse.ProtoReflect.Descriptor instead.
+func (*PopSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *PopSetResponse) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type TTLSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *TTLSetRequest) Reset() {
+	*x = TTLSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLSetRequest) ProtoMessage() {}
+
+func (x *TTLSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLSetRequest.ProtoReflect.Descriptor instead.
+func (*TTLSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *TTLSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type TTLSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TTLSetResponse) Reset() {
+	*x = TTLSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLSetResponse) ProtoMessage() {}
+
+func (x *TTLSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLSetResponse.ProtoReflect.Descriptor instead.
+func (*TTLSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *TTLSetResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *ExpireSetRequest) Reset() {
+	*x = ExpireSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSetRequest) ProtoMessage() {}
+
+func (x *ExpireSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSetRequest.ProtoReflect.Descriptor instead.
+func (*ExpireSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ExpireSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ExpireSetRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireSetResponse) Reset() {
+	*x = ExpireSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSetResponse) ProtoMessage() {}
+
+func (x *ExpireSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSetResponse.ProtoReflect.Descriptor instead.
+func (*ExpireSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{141}
+}
+
+type PersistSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PersistSetRequest) Reset() {
+	*x = PersistSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistSetRequest) ProtoMessage() {}
+
+func (x *PersistSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistSetRequest.ProtoReflect.Descriptor instead.
+func (*PersistSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *PersistSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PersistSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PersistSetResponse) Reset() {
+	*x = PersistSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistSetResponse) ProtoMessage() {}
+
+func (x *PersistSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistSetResponse.ProtoReflect.Descriptor instead.
+func (*PersistSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{143}
+}
+
+type ScoredMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member string  `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Score  float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScoredMember) Reset() {
+	*x = ScoredMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoredMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredMember) ProtoMessage() {}
+
+func (x *ScoredMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoredMember.ProtoReflect.Descriptor instead.
+func (*ScoredMember) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ScoredMember) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ScoredMember) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type NewSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *NewSortedSetRequest) Reset() {
+	*x = NewSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewSortedSetRequest) ProtoMessage() {}
+
+func (x *NewSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*NewSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *NewSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NewSortedSetRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type NewSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NewSortedSetResponse) Reset() {
+	*x = NewSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewSortedSetResponse) ProtoMessage() {}
+
+func (x *NewSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*NewSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{146}
+}
+
+type DelSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *DelSortedSetRequest) Reset() {
+	*x = DelSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSortedSetRequest) ProtoMessage() {}
+
+func (x *DelSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*DelSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DelSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DelSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelSortedSetResponse) Reset() {
+	*x = DelSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSortedSetResponse) ProtoMessage() {}
+
+func (x *DelSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*DelSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{148}
+}
+
+type AddSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// members to add, the score of existing members is updated.
+	Members []*ScoredMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *AddSortedSetRequest) Reset() {
+	*x = AddSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSortedSetRequest) ProtoMessage() {}
+
+func (x *AddSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*AddSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AddSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AddSortedSetRequest) GetMembers() []*ScoredMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// added is the number of members that were not in the set.
+	Added int32 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *AddSortedSetResponse) Reset() {
+	*x = AddSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSortedSetResponse) ProtoMessage() {}
+
+func (x *AddSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*AddSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *AddSortedSetResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type IncrSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Member   string        `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Incr     float64       `protobuf:"fixed64,3,opt,name=incr,proto3" json:"incr,omitempty"`
+}
+
+func (x *IncrSortedSetRequest) Reset() {
+	*x = IncrSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrSortedSetRequest) ProtoMessage() {}
+
+func (x *IncrSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*IncrSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *IncrSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *IncrSortedSetRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *IncrSortedSetRequest) GetIncr() float64 {
+	if x != nil {
+		return x.Incr
+	}
+	return 0
+}
+
+type IncrSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// score after the increment.
+	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *IncrSortedSetResponse) Reset() {
+	*x = IncrSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrSortedSetResponse) ProtoMessage() {}
+
+func (x *IncrSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*IncrSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *IncrSortedSetResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type RangeByScoreSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// min and max scores, both inclusive.
+	Min float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	// limit is the maximum number of members to return,
+	// zero meaning no limit.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *RangeByScoreSortedSetRequest) Reset() {
+	*x = RangeByScoreSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeByScoreSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeByScoreSortedSetRequest) ProtoMessage() {}
+
+func (x *RangeByScoreSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeByScoreSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*RangeByScoreSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *RangeByScoreSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RangeByScoreSortedSetRequest) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RangeByScoreSortedSetRequest) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RangeByScoreSortedSetRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RangeByScoreSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ScoredMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *RangeByScoreSortedSetResponse) Reset() {
+	*x = RangeByScoreSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeByScoreSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeByScoreSortedSetResponse) ProtoMessage() {}
+
+func (x *RangeByScoreSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeByScoreSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*RangeByScoreSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *RangeByScoreSortedSetResponse) GetMembers() []*ScoredMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type RangeByRankSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// start and stop ranks, both inclusive. Negative
+	// ranks count from the member with the highest score.
+	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop  int32 `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *RangeByRankSortedSetRequest) Reset() {
+	*x = RangeByRankSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeByRankSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeByRankSortedSetRequest) ProtoMessage() {}
+
+func (x *RangeByRankSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeByRankSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*RangeByRankSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *RangeByRankSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RangeByRankSortedSetRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *RangeByRankSortedSetRequest) GetStop() int32 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type RangeByRankSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ScoredMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *RangeByRankSortedSetResponse) Reset() {
+	*x = RangeByRankSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeByRankSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeByRankSortedSetResponse) ProtoMessage() {}
+
+func (x *RangeByRankSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeByRankSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*RangeByRankSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *RangeByRankSortedSetResponse) GetMembers() []*ScoredMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type RemoveRangeByScoreSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// min and max scores, both inclusive.
+	Min float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *RemoveRangeByScoreSortedSetRequest) Reset() {
+	*x = RemoveRangeByScoreSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRangeByScoreSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRangeByScoreSortedSetRequest) ProtoMessage() {}
+
+func (x *RemoveRangeByScoreSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRangeByScoreSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRangeByScoreSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *RemoveRangeByScoreSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RemoveRangeByScoreSortedSetRequest) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RemoveRangeByScoreSortedSetRequest) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type RemoveRangeByScoreSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// removed is the number of members removed.
+	Removed int32 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *RemoveRangeByScoreSortedSetResponse) Reset() {
+	*x = RemoveRangeByScoreSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRangeByScoreSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRangeByScoreSortedSetResponse) ProtoMessage() {}
+
+func (x *RemoveRangeByScoreSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRangeByScoreSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRangeByScoreSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *RemoveRangeByScoreSortedSetResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type CardSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *CardSortedSetRequest) Reset() {
+	*x = CardSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSortedSetRequest) ProtoMessage() {}
+
+func (x *CardSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*CardSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CardSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type CardSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card int32 `protobuf:"varint,1,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *CardSortedSetResponse) Reset() {
+	*x = CardSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSortedSetResponse) ProtoMessage() {}
+
+func (x *CardSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*CardSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CardSortedSetResponse) GetCard() int32 {
+	if x != nil {
+		return x.Card
+	}
+	return 0
+}
+
+type TTLSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *TTLSortedSetRequest) Reset() {
+	*x = TTLSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLSortedSetRequest) ProtoMessage() {}
+
+func (x *TTLSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*TTLSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *TTLSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type TTLSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TTLSortedSetResponse) Reset() {
+	*x = TTLSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLSortedSetResponse) ProtoMessage() {}
+
+func (x *TTLSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*TTLSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *TTLSortedSetResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *ExpireSortedSetRequest) Reset() {
+	*x = ExpireSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSortedSetRequest) ProtoMessage() {}
+
+func (x *ExpireSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*ExpireSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ExpireSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ExpireSortedSetRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireSortedSetResponse) Reset() {
+	*x = ExpireSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSortedSetResponse) ProtoMessage() {}
+
+func (x *ExpireSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*ExpireSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{164}
+}
+
+type PersistSortedSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PersistSortedSetRequest) Reset() {
+	*x = PersistSortedSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistSortedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistSortedSetRequest) ProtoMessage() {}
+
+func (x *PersistSortedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistSortedSetRequest.ProtoReflect.Descriptor instead.
+func (*PersistSortedSetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *PersistSortedSetRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PersistSortedSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PersistSortedSetResponse) Reset() {
+	*x = PersistSortedSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistSortedSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistSortedSetResponse) ProtoMessage() {}
+
+func (x *PersistSortedSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistSortedSetResponse.ProtoReflect.Descriptor instead.
+func (*PersistSortedSetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{166}
+}
+
+type StreamEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset of the entry, assigned in increasing order.
+	Offset int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Value  []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// time when the entry was appended, in Unix milliseconds.
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StreamEntry) Reset() {
+	*x = StreamEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntry) ProtoMessage() {}
+
+func (x *StreamEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntry.ProtoReflect.Descriptor instead.
+func (*StreamEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *StreamEntry) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *StreamEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *StreamEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type NewStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// max_len is the maximum number of entries retained,
+	// zero meaning that the stream is unbounded.
+	MaxLen int32 `protobuf:"varint,3,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`
+	// max_age is the number of seconds entries are retained,
+	// zero meaning that they are retained forever.
+	MaxAge int32 `protobuf:"varint,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (x *NewStreamRequest) Reset() {
+	*x = NewStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewStreamRequest) ProtoMessage() {}
+
+func (x *NewStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewStreamRequest.ProtoReflect.Descriptor instead.
+func (*NewStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *NewStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NewStreamRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *NewStreamRequest) GetMaxLen() int32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *NewStreamRequest) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+type NewStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NewStreamResponse) Reset() {
+	*x = NewStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewStreamResponse) ProtoMessage() {}
+
+func (x *NewStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewStreamResponse.ProtoReflect.Descriptor instead.
+func (*NewStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{169}
+}
+
+type DelStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *DelStreamRequest) Reset() {
+	*x = DelStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelStreamRequest) ProtoMessage() {}
+
+func (x *DelStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelStreamRequest.ProtoReflect.Descriptor instead.
+func (*DelStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DelStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DelStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelStreamResponse) Reset() {
+	*x = DelStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelStreamResponse) ProtoMessage() {}
+
+func (x *DelStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelStreamResponse.ProtoReflect.Descriptor instead.
+func (*DelStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{171}
+}
+
+type AppendStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Value    []byte        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AppendStreamRequest) Reset() {
+	*x = AppendStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendStreamRequest) ProtoMessage() {}
+
+func (x *AppendStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendStreamRequest.ProtoReflect.Descriptor instead.
+func (*AppendStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *AppendStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AppendStreamRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AppendStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset assigned to the appended entry.
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *AppendStreamResponse) Reset() {
+	*x = AppendStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendStreamResponse) ProtoMessage() {}
+
+func (x *AppendStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendStreamResponse.ProtoReflect.Descriptor instead.
+func (*AppendStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *AppendStreamResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ReadStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// offset of the first entry to read, entries that are
+	// no longer retained are skipped.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is the maximum number of entries to return,
+	// zero meaning no limit.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ReadStreamRequest) Reset() {
+	*x = ReadStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStreamRequest) ProtoMessage() {}
+
+func (x *ReadStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStreamRequest.ProtoReflect.Descriptor instead.
+func (*ReadStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ReadStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ReadStreamRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadStreamRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReadStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StreamEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ReadStreamResponse) Reset() {
+	*x = ReadStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStreamResponse) ProtoMessage() {}
+
+func (x *ReadStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStreamResponse.ProtoReflect.Descriptor instead.
+func (*ReadStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ReadStreamResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type CommitStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Group    string        `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// offset of the next entry the consumer group will read.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *CommitStreamRequest) Reset() {
+	*x = CommitStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStreamRequest) ProtoMessage() {}
+
+func (x *CommitStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStreamRequest.ProtoReflect.Descriptor instead.
+func (*CommitStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *CommitStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CommitStreamRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *CommitStreamRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CommitStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommitStreamResponse) Reset() {
+	*x = CommitStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStreamResponse) ProtoMessage() {}
+
+func (x *CommitStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStreamResponse.ProtoReflect.Descriptor instead.
+func (*CommitStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{177}
+}
+
+type OffsetStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Group    string        `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *OffsetStreamRequest) Reset() {
+	*x = OffsetStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OffsetStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffsetStreamRequest) ProtoMessage() {}
+
+func (x *OffsetStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffsetStreamRequest.ProtoReflect.Descriptor instead.
+func (*OffsetStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *OffsetStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *OffsetStreamRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type OffsetStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset committed by the consumer group, zero
+	// when the group has not committed any.
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *OffsetStreamResponse) Reset() {
+	*x = OffsetStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OffsetStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffsetStreamResponse) ProtoMessage() {}
+
+func (x *OffsetStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffsetStreamResponse.ProtoReflect.Descriptor instead.
+func (*OffsetStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *OffsetStreamResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TTLStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *TTLStreamRequest) Reset() {
+	*x = TTLStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLStreamRequest) ProtoMessage() {}
+
+func (x *TTLStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLStreamRequest.ProtoReflect.Descriptor instead.
+func (*TTLStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *TTLStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type TTLStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TTLStreamResponse) Reset() {
+	*x = TTLStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLStreamResponse) ProtoMessage() {}
+
+func (x *TTLStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLStreamResponse.ProtoReflect.Descriptor instead.
+func (*TTLStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *TTLStreamResponse) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Ttl      int32         `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *ExpireStreamRequest) Reset() {
+	*x = ExpireStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireStreamRequest) ProtoMessage() {}
+
+func (x *ExpireStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireStreamRequest.ProtoReflect.Descriptor instead.
+func (*ExpireStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *ExpireStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ExpireStreamRequest) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireStreamResponse) Reset() {
+	*x = ExpireStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireStreamResponse) ProtoMessage() {}
+
+func (x *ExpireStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireStreamResponse.ProtoReflect.Descriptor instead.
+func (*ExpireStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{183}
+}
+
+type PersistStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *PersistStreamRequest) Reset() {
+	*x = PersistStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistStreamRequest) ProtoMessage() {}
+
+func (x *PersistStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistStreamRequest.ProtoReflect.Descriptor instead.
+func (*PersistStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *PersistStreamRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PersistStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PersistStreamResponse) Reset() {
+	*x = PersistStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistStreamResponse) ProtoMessage() {}
+
+func (x *PersistStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistStreamResponse.ProtoReflect.Descriptor instead.
+func (*PersistStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{185}
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// prefix watches every key at the scope that starts with the
+	// location key, which can be empty to watch the whole scope.
+	Prefix bool `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// revision of the last event received, or the one informed by the
+	// eventstore-revision header when no events were received, used to
+	// resume watching after a disconnection. Resuming fails with
+	// OutOfRange when the server no longer keeps the events after it.
+	Revision uint64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *WatchRequest) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *WatchRequest) GetPrefix() bool {
+	if x != nil {
+		return x.Prefix
+	}
+	return false
+}
+
+func (x *WatchRequest) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=protob.WatchEventType" json:"type,omitempty"`
+	Location *LocationType  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Field    string         `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Value    []byte         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Revision uint64         `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *WatchEvent) GetType() WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchEventType_WATCH_EVENT_TYPE_SET
+}
+
+func (x *WatchEvent) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type KeyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type KeyType `protobuf:"varint,2,opt,name=type,proto3,enum=protob.KeyType" json:"type,omitempty"`
+	// ttl is the remaining time to live in seconds,
+	// zero meaning that the key never expires.
+	Ttl int32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *KeyInfo) Reset() {
+	*x = KeyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyInfo) ProtoMessage() {}
+
+func (x *KeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyInfo.ProtoReflect.Descriptor instead.
+func (*KeyInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *KeyInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyInfo) GetType() KeyType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyType_TypeKV
+}
+
+func (x *KeyInfo) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ScanScopeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope  *ScopeType `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Prefix string     `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// cursor returned by the previous page, empty for the first one.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// count is the maximum number of keys to return.
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ScanScopeRequest) Reset() {
+	*x = ScanScopeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanScopeRequest) ProtoMessage() {}
+
+func (x *ScanScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanScopeRequest.ProtoReflect.Descriptor instead.
+func (*ScanScopeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *ScanScopeRequest) GetScope() *ScopeType {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ScanScopeRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ScanScopeRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ScanScopeRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ScanScopeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*KeyInfo `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// cursor for the next page, empty when there are no more keys.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ScanScopeResponse) Reset() {
+	*x = ScanScopeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanScopeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanScopeResponse) ProtoMessage() {}
+
+func (x *ScanScopeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanScopeResponse.ProtoReflect.Descriptor instead.
+func (*ScanScopeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *ScanScopeResponse) GetKeys() []*KeyInfo {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ScanScopeResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type DropScopeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// scope to drop, dropping a bridge also drops its instances.
+	Scope *ScopeType `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *DropScopeRequest) Reset() {
+	*x = DropScopeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropScopeRequest) ProtoMessage() {}
+
+func (x *DropScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropScopeRequest.ProtoReflect.Descriptor instead.
+func (*DropScopeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *DropScopeRequest) GetScope() *ScopeType {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type DropScopeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keys is the number of keys removed.
+	Keys int32 `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *DropScopeResponse) Reset() {
+	*x = DropScopeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropScopeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropScopeResponse) ProtoMessage() {}
+
+func (x *DropScopeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropScopeResponse.ProtoReflect.Descriptor instead.
+func (*DropScopeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *DropScopeResponse) GetKeys() int32 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+type BatchOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Op:
+	//	*BatchOperation_KvSet
+	//	*BatchOperation_KvGet
+	//	*BatchOperation_KvDel
+	//	*BatchOperation_KvIncr
+	//	*BatchOperation_KvDecr
+	//	*BatchOperation_MapNew
+	//	*BatchOperation_MapDel
+	//	*BatchOperation_MapGetFields
+	//	*BatchOperation_MapLen
+	//	*BatchOperation_MapFieldSet
+	//	*BatchOperation_MapFieldGet
+	//	*BatchOperation_MapFieldDel
+	//	*BatchOperation_MapFieldIncr
+	//	*BatchOperation_MapFieldDecr
+	//	*BatchOperation_QueueNew
+	//	*BatchOperation_QueueDel
+	//	*BatchOperation_QueueLen
+	//	*BatchOperation_QueuePush
+	//	*BatchOperation_QueuePop
+	//	*BatchOperation_QueuePeek
+	Op isBatchOperation_Op `protobuf_oneof:"op"`
+}
+
+func (x *BatchOperation) Reset() {
+	*x = BatchOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperation) ProtoMessage() {}
+
+func (x *BatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperation.ProtoReflect.Descriptor instead.
+func (*BatchOperation) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{193}
+}
+
+func (m *BatchOperation) GetOp() isBatchOperation_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetKvSet() *SetKVRequest {
+	if x, ok := x.GetOp().(*BatchOperation_KvSet); ok {
+		return x.KvSet
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetKvGet() *GetKVRequest {
+	if x, ok := x.GetOp().(*BatchOperation_KvGet); ok {
+		return x.KvGet
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetKvDel() *DelKVRequest {
+	if x, ok := x.GetOp().(*BatchOperation_KvDel); ok {
+		return x.KvDel
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetKvIncr() *IncrKVRequest {
+	if x, ok := x.GetOp().(*BatchOperation_KvIncr); ok {
+		return x.KvIncr
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetKvDecr() *DecrKVRequest {
+	if x, ok := x.GetOp().(*BatchOperation_KvDecr); ok {
+		return x.KvDecr
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapNew() *NewMapRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapNew); ok {
+		return x.MapNew
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapDel() *DelMapRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapDel); ok {
+		return x.MapDel
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapGetFields() *GetAllMapFieldsRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapGetFields); ok {
+		return x.MapGetFields
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapLen() *LenMapRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapLen); ok {
+		return x.MapLen
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapFieldSet() *SetMapFieldRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapFieldSet); ok {
+		return x.MapFieldSet
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapFieldGet() *GetMapFieldRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapFieldGet); ok {
+		return x.MapFieldGet
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapFieldDel() *DelMapFieldRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapFieldDel); ok {
+		return x.MapFieldDel
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapFieldIncr() *IncrMapFieldRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapFieldIncr); ok {
+		return x.MapFieldIncr
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetMapFieldDecr() *DecrMapFieldRequest {
+	if x, ok := x.GetOp().(*BatchOperation_MapFieldDecr); ok {
+		return x.MapFieldDecr
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetQueueNew() *NewQueueRequest {
+	if x, ok := x.GetOp().(*BatchOperation_QueueNew); ok {
+		return x.QueueNew
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetQueueDel() *DelQueueRequest {
+	if x, ok := x.GetOp().(*BatchOperation_QueueDel); ok {
+		return x.QueueDel
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetQueueLen() *LenQueueRequest {
+	if x, ok := x.GetOp().(*BatchOperation_QueueLen); ok {
+		return x.QueueLen
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetQueuePush() *PushQueueRequest {
+	if x, ok := x.GetOp().(*BatchOperation_QueuePush); ok {
+		return x.QueuePush
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetQueuePop() *PopQueueRequest {
+	if x, ok := x.GetOp().(*BatchOperation_QueuePop); ok {
+		return x.QueuePop
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetQueuePeek() *PeekQueueRequest {
+	if x, ok := x.GetOp().(*BatchOperation_QueuePeek); ok {
+		return x.QueuePeek
+	}
+	return nil
+}
+
+type isBatchOperation_Op interface {
+	isBatchOperation_Op()
+}
+
+type BatchOperation_KvSet struct {
+	KvSet *SetKVRequest `protobuf:"bytes,1,opt,name=kv_set,json=kvSet,proto3,oneof"`
+}
+
+type BatchOperation_KvGet struct {
+	KvGet *GetKVRequest `protobuf:"bytes,2,opt,name=kv_get,json=kvGet,proto3,oneof"`
+}
+
+type BatchOperation_KvDel struct {
+	KvDel *DelKVRequest `protobuf:"bytes,3,opt,name=kv_del,json=kvDel,proto3,oneof"`
+}
+
+type BatchOperation_KvIncr struct {
+	KvIncr *IncrKVRequest `protobuf:"bytes,4,opt,name=kv_incr,json=kvIncr,proto3,oneof"`
+}
+
+type BatchOperation_KvDecr struct {
+	KvDecr *DecrKVRequest `protobuf:"bytes,5,opt,name=kv_decr,json=kvDecr,proto3,oneof"`
+}
+
+type BatchOperation_MapNew struct {
+	MapNew *NewMapRequest `protobuf:"bytes,10,opt,name=map_new,json=mapNew,proto3,oneof"`
+}
+
+type BatchOperation_MapDel struct {
+	MapDel *DelMapRequest `protobuf:"bytes,11,opt,name=map_del,json=mapDel,proto3,oneof"`
+}
+
+type BatchOperation_MapGetFields struct {
+	MapGetFields *GetAllMapFieldsRequest `protobuf:"bytes,12,opt,name=map_get_fields,json=mapGetFields,proto3,oneof"`
+}
+
+type BatchOperation_MapLen struct {
+	MapLen *LenMapRequest `protobuf:"bytes,13,opt,name=map_len,json=mapLen,proto3,oneof"`
+}
+
+type BatchOperation_MapFieldSet struct {
+	MapFieldSet *SetMapFieldRequest `protobuf:"bytes,14,opt,name=map_field_set,json=mapFieldSet,proto3,oneof"`
+}
+
+type BatchOperation_MapFieldGet struct {
+	MapFieldGet *GetMapFieldRequest `protobuf:"bytes,15,opt,name=map_field_get,json=mapFieldGet,proto3,oneof"`
+}
+
+type BatchOperation_MapFieldDel struct {
+	MapFieldDel *DelMapFieldRequest `protobuf:"bytes,16,opt,name=map_field_del,json=mapFieldDel,proto3,oneof"`
+}
+
+type BatchOperation_MapFieldIncr struct {
+	MapFieldIncr *IncrMapFieldRequest `protobuf:"bytes,17,opt,name=map_field_incr,json=mapFieldIncr,proto3,oneof"`
+}
+
+type BatchOperation_MapFieldDecr struct {
+	MapFieldDecr *DecrMapFieldRequest `protobuf:"bytes,18,opt,name=map_field_decr,json=mapFieldDecr,proto3,oneof"`
+}
+
+type BatchOperation_QueueNew struct {
+	QueueNew *NewQueueRequest `protobuf:"bytes,20,opt,name=queue_new,json=queueNew,proto3,oneof"`
+}
+
+type BatchOperation_QueueDel struct {
+	QueueDel *DelQueueRequest `protobuf:"bytes,21,opt,name=queue_del,json=queueDel,proto3,oneof"`
+}
+
+type BatchOperation_QueueLen struct {
+	QueueLen *LenQueueRequest `protobuf:"bytes,22,opt,name=queue_len,json=queueLen,proto3,oneof"`
+}
+
+type BatchOperation_QueuePush struct {
+	QueuePush *PushQueueRequest `protobuf:"bytes,23,opt,name=queue_push,json=queuePush,proto3,oneof"`
+}
+
+type BatchOperation_QueuePop struct {
+	QueuePop *PopQueueRequest `protobuf:"bytes,24,opt,name=queue_pop,json=queuePop,proto3,oneof"`
+}
+
+type BatchOperation_QueuePeek struct {
+	QueuePeek *PeekQueueRequest `protobuf:"bytes,25,opt,name=queue_peek,json=queuePeek,proto3,oneof"`
+}
+
+func (*BatchOperation_KvSet) isBatchOperation_Op() {}
+
+func (*BatchOperation_KvGet) isBatchOperation_Op() {}
+
+func (*BatchOperation_KvDel) isBatchOperation_Op() {}
+
+func (*BatchOperation_KvIncr) isBatchOperation_Op() {}
+
+func (*BatchOperation_KvDecr) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapNew) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapDel) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapGetFields) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapLen) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapFieldSet) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapFieldGet) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapFieldDel) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapFieldIncr) isBatchOperation_Op() {}
+
+func (*BatchOperation_MapFieldDecr) isBatchOperation_Op() {}
+
+func (*BatchOperation_QueueNew) isBatchOperation_Op() {}
+
+func (*BatchOperation_QueueDel) isBatchOperation_Op() {}
+
+func (*BatchOperation_QueueLen) isBatchOperation_Op() {}
+
+func (*BatchOperation_QueuePush) isBatchOperation_Op() {}
+
+func (*BatchOperation_QueuePop) isBatchOperation_Op() {}
+
+func (*BatchOperation_QueuePeek) isBatchOperation_Op() {}
+
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ops []*BatchOperation `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *BatchRequest) GetOps() []*BatchOperation {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+type BatchError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the gRPC status code for the failed operation.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BatchError) Reset() {
+	*x = BatchError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchError) ProtoMessage() {}
+
+func (x *BatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchError.ProtoReflect.Descriptor instead.
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *BatchError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*BatchResult_KvSet
+	//	*BatchResult_KvGet
+	//	*BatchResult_KvDel
+	//	*BatchResult_KvIncr
+	//	*BatchResult_KvDecr
+	//	*BatchResult_MapNew
+	//	*BatchResult_MapDel
+	//	*BatchResult_MapGetFields
+	//	*BatchResult_MapLen
+	//	*BatchResult_MapFieldSet
+	//	*BatchResult_MapFieldGet
+	//	*BatchResult_MapFieldDel
+	//	*BatchResult_MapFieldIncr
+	//	*BatchResult_MapFieldDecr
+	//	*BatchResult_QueueNew
+	//	*BatchResult_QueueDel
+	//	*BatchResult_QueueLen
+	//	*BatchResult_QueuePush
+	//	*BatchResult_QueuePop
+	//	*BatchResult_QueuePeek
+	//	*BatchResult_Error
+	Result isBatchResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{196}
+}
+
+func (m *BatchResult) GetResult() isBatchResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *BatchResult) GetKvSet() *SetKVResponse {
+	if x, ok := x.GetResult().(*BatchResult_KvSet); ok {
+		return x.KvSet
+	}
+	return nil
+}
+
+func (x *BatchResult) GetKvGet() *GetKVResponse {
+	if x, ok := x.GetResult().(*BatchResult_KvGet); ok {
+		return x.KvGet
+	}
+	return nil
+}
+
+func (x *BatchResult) GetKvDel() *DelKVResponse {
+	if x, ok := x.GetResult().(*BatchResult_KvDel); ok {
+		return x.KvDel
+	}
+	return nil
+}
+
+func (x *BatchResult) GetKvIncr() *IncrKVResponse {
+	if x, ok := x.GetResult().(*BatchResult_KvIncr); ok {
+		return x.KvIncr
+	}
+	return nil
+}
+
+func (x *BatchResult) GetKvDecr() *DecrKVResponse {
+	if x, ok := x.GetResult().(*BatchResult_KvDecr); ok {
+		return x.KvDecr
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapNew() *NewMapResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapNew); ok {
+		return x.MapNew
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapDel() *DelMapResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapDel); ok {
+		return x.MapDel
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapGetFields() *GetAllMapFieldsResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapGetFields); ok {
+		return x.MapGetFields
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapLen() *LenMapResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapLen); ok {
+		return x.MapLen
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapFieldSet() *SetMapFieldResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapFieldSet); ok {
+		return x.MapFieldSet
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapFieldGet() *GetMapFieldResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapFieldGet); ok {
+		return x.MapFieldGet
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapFieldDel() *DelMapFieldResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapFieldDel); ok {
+		return x.MapFieldDel
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapFieldIncr() *IncrMapFieldResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapFieldIncr); ok {
+		return x.MapFieldIncr
+	}
+	return nil
+}
+
+func (x *BatchResult) GetMapFieldDecr() *DecrMapFieldResponse {
+	if x, ok := x.GetResult().(*BatchResult_MapFieldDecr); ok {
+		return x.MapFieldDecr
+	}
+	return nil
+}
+
+func (x *BatchResult) GetQueueNew() *NewQueueResponse {
+	if x, ok := x.GetResult().(*BatchResult_QueueNew); ok {
+		return x.QueueNew
+	}
+	return nil
+}
+
+func (x *BatchResult) GetQueueDel() *DelQueueResponse {
+	if x, ok := x.GetResult().(*BatchResult_QueueDel); ok {
+		return x.QueueDel
+	}
+	return nil
+}
+
+func (x *BatchResult) GetQueueLen() *LenQueueResponse {
+	if x, ok := x.GetResult().(*BatchResult_QueueLen); ok {
+		return x.QueueLen
+	}
+	return nil
+}
+
+func (x *BatchResult) GetQueuePush() *PushQueueResponse {
+	if x, ok := x.GetResult().(*BatchResult_QueuePush); ok {
+		return x.QueuePush
+	}
+	return nil
+}
+
+func (x *BatchResult) GetQueuePop() *PopQueueResponse {
+	if x, ok := x.GetResult().(*BatchResult_QueuePop); ok {
+		return x.QueuePop
+	}
+	return nil
+}
+
+func (x *BatchResult) GetQueuePeek() *PeekQueueResponse {
+	if x, ok := x.GetResult().(*BatchResult_QueuePeek); ok {
+		return x.QueuePeek
+	}
+	return nil
+}
+
+func (x *BatchResult) GetError() *BatchError {
+	if x, ok := x.GetResult().(*BatchResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isBatchResult_Result interface {
+	isBatchResult_Result()
+}
+
+type BatchResult_KvSet struct {
+	KvSet *SetKVResponse `protobuf:"bytes,1,opt,name=kv_set,json=kvSet,proto3,oneof"`
+}
+
+type BatchResult_KvGet struct {
+	KvGet *GetKVResponse `protobuf:"bytes,2,opt,name=kv_get,json=kvGet,proto3,oneof"`
+}
+
+type BatchResult_KvDel struct {
+	KvDel *DelKVResponse `protobuf:"bytes,3,opt,name=kv_del,json=kvDel,proto3,oneof"`
+}
+
+type BatchResult_KvIncr struct {
+	KvIncr *IncrKVResponse `protobuf:"bytes,4,opt,name=kv_incr,json=kvIncr,proto3,oneof"`
+}
+
+type BatchResult_KvDecr struct {
+	KvDecr *DecrKVResponse `protobuf:"bytes,5,opt,name=kv_decr,json=kvDecr,proto3,oneof"`
+}
+
+type BatchResult_MapNew struct {
+	MapNew *NewMapResponse `protobuf:"bytes,10,opt,name=map_new,json=mapNew,proto3,oneof"`
+}
+
+type BatchResult_MapDel struct {
+	MapDel *DelMapResponse `protobuf:"bytes,11,opt,name=map_del,json=mapDel,proto3,oneof"`
+}
+
+type BatchResult_MapGetFields struct {
+	MapGetFields *GetAllMapFieldsResponse `protobuf:"bytes,12,opt,name=map_get_fields,json=mapGetFields,proto3,oneof"`
+}
+
+type BatchResult_MapLen struct {
+	MapLen *LenMapResponse `protobuf:"bytes,13,opt,name=map_len,json=mapLen,proto3,oneof"`
+}
+
+type BatchResult_MapFieldSet struct {
+	MapFieldSet *SetMapFieldResponse `protobuf:"bytes,14,opt,name=map_field_set,json=mapFieldSet,proto3,oneof"`
+}
+
+type BatchResult_MapFieldGet struct {
+	MapFieldGet *GetMapFieldResponse `protobuf:"bytes,15,opt,name=map_field_get,json=mapFieldGet,proto3,oneof"`
+}
+
+type BatchResult_MapFieldDel struct {
+	MapFieldDel *DelMapFieldResponse `protobuf:"bytes,16,opt,name=map_field_del,json=mapFieldDel,proto3,oneof"`
+}
+
+type BatchResult_MapFieldIncr struct {
+	MapFieldIncr *IncrMapFieldResponse `protobuf:"bytes,17,opt,name=map_field_incr,json=mapFieldIncr,proto3,oneof"`
+}
+
+type BatchResult_MapFieldDecr struct {
+	MapFieldDecr *DecrMapFieldResponse `protobuf:"bytes,18,opt,name=map_field_decr,json=mapFieldDecr,proto3,oneof"`
+}
+
+type BatchResult_QueueNew struct {
+	QueueNew *NewQueueResponse `protobuf:"bytes,20,opt,name=queue_new,json=queueNew,proto3,oneof"`
+}
+
+type BatchResult_QueueDel struct {
+	QueueDel *DelQueueResponse `protobuf:"bytes,21,opt,name=queue_del,json=queueDel,proto3,oneof"`
+}
+
+type BatchResult_QueueLen struct {
+	QueueLen *LenQueueResponse `protobuf:"bytes,22,opt,name=queue_len,json=queueLen,proto3,oneof"`
+}
+
+type BatchResult_QueuePush struct {
+	QueuePush *PushQueueResponse `protobuf:"bytes,23,opt,name=queue_push,json=queuePush,proto3,oneof"`
+}
+
+type BatchResult_QueuePop struct {
+	QueuePop *PopQueueResponse `protobuf:"bytes,24,opt,name=queue_pop,json=queuePop,proto3,oneof"`
+}
+
+type BatchResult_QueuePeek struct {
+	QueuePeek *PeekQueueResponse `protobuf:"bytes,25,opt,name=queue_peek,json=queuePeek,proto3,oneof"`
+}
+
+type BatchResult_Error struct {
+	Error *BatchError `protobuf:"bytes,100,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchResult_KvSet) isBatchResult_Result() {}
+
+func (*BatchResult_KvGet) isBatchResult_Result() {}
+
+func (*BatchResult_KvDel) isBatchResult_Result() {}
+
+func (*BatchResult_KvIncr) isBatchResult_Result() {}
+
+func (*BatchResult_KvDecr) isBatchResult_Result() {}
+
+func (*BatchResult_MapNew) isBatchResult_Result() {}
+
+func (*BatchResult_MapDel) isBatchResult_Result() {}
+
+func (*BatchResult_MapGetFields) isBatchResult_Result() {}
+
+func (*BatchResult_MapLen) isBatchResult_Result() {}
+
+func (*BatchResult_MapFieldSet) isBatchResult_Result() {}
+
+func (*BatchResult_MapFieldGet) isBatchResult_Result() {}
+
+func (*BatchResult_MapFieldDel) isBatchResult_Result() {}
+
+func (*BatchResult_MapFieldIncr) isBatchResult_Result() {}
+
+func (*BatchResult_MapFieldDecr) isBatchResult_Result() {}
+
+func (*BatchResult_QueueNew) isBatchResult_Result() {}
+
+func (*BatchResult_QueueDel) isBatchResult_Result() {}
+
+func (*BatchResult_QueueLen) isBatchResult_Result() {}
+
+func (*BatchResult_QueuePush) isBatchResult_Result() {}
+
+func (*BatchResult_QueuePop) isBatchResult_Result() {}
+
+func (*BatchResult_QueuePeek) isBatchResult_Result() {}
+
+func (*BatchResult_Error) isBatchResult_Result() {}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results in the same order as the requested operations.
+	Results []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *BatchResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TxnFieldGuard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TxnFieldGuard) Reset() {
+	*x = TxnFieldGuard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnFieldGuard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnFieldGuard) ProtoMessage() {}
+
+func (x *TxnFieldGuard) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnFieldGuard.ProtoReflect.Descriptor instead.
+func (*TxnFieldGuard) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *TxnFieldGuard) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TxnFieldGuard) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type TxnGuard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *LocationType `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// Types that are assignable to Condition:
+	//	*TxnGuard_Exists
+	//	*TxnGuard_Version
+	//	*TxnGuard_Field
+	Condition isTxnGuard_Condition `protobuf_oneof:"condition"`
+}
+
+func (x *TxnGuard) Reset() {
+	*x = TxnGuard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnGuard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnGuard) ProtoMessage() {}
+
+func (x *TxnGuard) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnGuard.ProtoReflect.Descriptor instead.
+func (*TxnGuard) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *TxnGuard) GetLocation() *LocationType {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (m *TxnGuard) GetCondition() isTxnGuard_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *TxnGuard) GetExists() bool {
+	if x, ok := x.GetCondition().(*TxnGuard_Exists); ok {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *TxnGuard) GetVersion() uint64 {
+	if x, ok := x.GetCondition().(*TxnGuard_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TxnGuard) GetField() *TxnFieldGuard {
+	if x, ok := x.GetCondition().(*TxnGuard_Field); ok {
+		return x.Field
+	}
+	return nil
+}
+
+type isTxnGuard_Condition interface {
+	isTxnGuard_Condition()
+}
+
+type TxnGuard_Exists struct {
+	// exists requires the key to exist when true,
+	// and not to exist when false.
+	Exists bool `protobuf:"varint,2,opt,name=exists,proto3,oneof"`
+}
+
+type TxnGuard_Version struct {
+	// version requires the KV entry to be at the version.
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3,oneof"`
+}
+
+type TxnGuard_Field struct {
+	// field requires the map field to hold the value.
+	Field *TxnFieldGuard `protobuf:"bytes,4,opt,name=field,proto3,oneof"`
+}
+
+func (*TxnGuard_Exists) isTxnGuard_Condition() {}
+
+func (*TxnGuard_Version) isTxnGuard_Condition() {}
+
+func (*TxnGuard_Field) isTxnGuard_Condition() {}
+
+type TxnOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Op:
+	//	*TxnOperation_KvSet
+	//	*TxnOperation_KvDel
+	//	*TxnOperation_KvIncr
+	//	*TxnOperation_KvDecr
+	//	*TxnOperation_MapNew
+	//	*TxnOperation_MapDel
+	//	*TxnOperation_MapFieldSet
+	//	*TxnOperation_MapFieldDel
+	//	*TxnOperation_MapFieldIncr
+	//	*TxnOperation_MapFieldDecr
+	//	*TxnOperation_QueueNew
+	//	*TxnOperation_QueueDel
+	//	*TxnOperation_QueuePush
+	//	*TxnOperation_QueuePop
+	Op isTxnOperation_Op `protobuf_oneof:"op"`
+}
+
+func (x *TxnOperation) Reset() {
+	*x = TxnOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnOperation) ProtoMessage() {}
+
+func (x *TxnOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnOperation.ProtoReflect.Descriptor instead.
+func (*TxnOperation) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{200}
+}
+
+func (m *TxnOperation) GetOp() isTxnOperation_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetKvSet() *SetKVRequest {
+	if x, ok := x.GetOp().(*TxnOperation_KvSet); ok {
+		return x.KvSet
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetKvDel() *DelKVRequest {
+	if x, ok := x.GetOp().(*TxnOperation_KvDel); ok {
+		return x.KvDel
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetKvIncr() *IncrKVRequest {
+	if x, ok := x.GetOp().(*TxnOperation_KvIncr); ok {
+		return x.KvIncr
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetKvDecr() *DecrKVRequest {
+	if x, ok := x.GetOp().(*TxnOperation_KvDecr); ok {
+		return x.KvDecr
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetMapNew() *NewMapRequest {
+	if x, ok := x.GetOp().(*TxnOperation_MapNew); ok {
+		return x.MapNew
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetMapDel() *DelMapRequest {
+	if x, ok := x.GetOp().(*TxnOperation_MapDel); ok {
+		return x.MapDel
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetMapFieldSet() *SetMapFieldRequest {
+	if x, ok := x.GetOp().(*TxnOperation_MapFieldSet); ok {
+		return x.MapFieldSet
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetMapFieldDel() *DelMapFieldRequest {
+	if x, ok := x.GetOp().(*TxnOperation_MapFieldDel); ok {
+		return x.MapFieldDel
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetMapFieldIncr() *IncrMapFieldRequest {
+	if x, ok := x.GetOp().(*TxnOperation_MapFieldIncr); ok {
+		return x.MapFieldIncr
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetMapFieldDecr() *DecrMapFieldRequest {
+	if x, ok := x.GetOp().(*TxnOperation_MapFieldDecr); ok {
+		return x.MapFieldDecr
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetQueueNew() *NewQueueRequest {
+	if x, ok := x.GetOp().(*TxnOperation_QueueNew); ok {
+		return x.QueueNew
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetQueueDel() *DelQueueRequest {
+	if x, ok := x.GetOp().(*TxnOperation_QueueDel); ok {
+		return x.QueueDel
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetQueuePush() *PushQueueRequest {
+	if x, ok := x.GetOp().(*TxnOperation_QueuePush); ok {
+		return x.QueuePush
+	}
+	return nil
+}
+
+func (x *TxnOperation) GetQueuePop() *PopQueueRequest {
+	if x, ok := x.GetOp().(*TxnOperation_QueuePop); ok {
+		return x.QueuePop
+	}
+	return nil
+}
+
+type isTxnOperation_Op interface {
+	isTxnOperation_Op()
+}
+
+type TxnOperation_KvSet struct {
+	KvSet *SetKVRequest `protobuf:"bytes,1,opt,name=kv_set,json=kvSet,proto3,oneof"`
+}
+
+type TxnOperation_KvDel struct {
+	KvDel *DelKVRequest `protobuf:"bytes,2,opt,name=kv_del,json=kvDel,proto3,oneof"`
+}
+
+type TxnOperation_KvIncr struct {
+	KvIncr *IncrKVRequest `protobuf:"bytes,3,opt,name=kv_incr,json=kvIncr,proto3,oneof"`
+}
+
+type TxnOperation_KvDecr struct {
+	KvDecr *DecrKVRequest `protobuf:"bytes,4,opt,name=kv_decr,json=kvDecr,proto3,oneof"`
+}
+
+type TxnOperation_MapNew struct {
+	MapNew *NewMapRequest `protobuf:"bytes,10,opt,name=map_new,json=mapNew,proto3,oneof"`
+}
+
+type TxnOperation_MapDel struct {
+	MapDel *DelMapRequest `protobuf:"bytes,11,opt,name=map_del,json=mapDel,proto3,oneof"`
+}
+
+type TxnOperation_MapFieldSet struct {
+	MapFieldSet *SetMapFieldRequest `protobuf:"bytes,12,opt,name=map_field_set,json=mapFieldSet,proto3,oneof"`
+}
+
+type TxnOperation_MapFieldDel struct {
+	MapFieldDel *DelMapFieldRequest `protobuf:"bytes,13,opt,name=map_field_del,json=mapFieldDel,proto3,oneof"`
+}
+
+type TxnOperation_MapFieldIncr struct {
+	MapFieldIncr *IncrMapFieldRequest `protobuf:"bytes,14,opt,name=map_field_incr,json=mapFieldIncr,proto3,oneof"`
+}
+
+type TxnOperation_MapFieldDecr struct {
+	MapFieldDecr *DecrMapFieldRequest `protobuf:"bytes,15,opt,name=map_field_decr,json=mapFieldDecr,proto3,oneof"`
+}
+
+type TxnOperation_QueueNew struct {
+	QueueNew *NewQueueRequest `protobuf:"bytes,20,opt,name=queue_new,json=queueNew,proto3,oneof"`
+}
+
+type TxnOperation_QueueDel struct {
+	QueueDel *DelQueueRequest `protobuf:"bytes,21,opt,name=queue_del,json=queueDel,proto3,oneof"`
+}
+
+type TxnOperation_QueuePush struct {
+	QueuePush *PushQueueRequest `protobuf:"bytes,22,opt,name=queue_push,json=queuePush,proto3,oneof"`
+}
+
+type TxnOperation_QueuePop struct {
+	QueuePop *PopQueueRequest `protobuf:"bytes,23,opt,name=queue_pop,json=queuePop,proto3,oneof"`
+}
+
+func (*TxnOperation_KvSet) isTxnOperation_Op() {}
+
+func (*TxnOperation_KvDel) isTxnOperation_Op() {}
+
+func (*TxnOperation_KvIncr) isTxnOperation_Op() {}
+
+func (*TxnOperation_KvDecr) isTxnOperation_Op() {}
+
+func (*TxnOperation_MapNew) isTxnOperation_Op() {}
+
+func (*TxnOperation_MapDel) isTxnOperation_Op() {}
+
+func (*TxnOperation_MapFieldSet) isTxnOperation_Op() {}
+
+func (*TxnOperation_MapFieldDel) isTxnOperation_Op() {}
+
+func (*TxnOperation_MapFieldIncr) isTxnOperation_Op() {}
+
+func (*TxnOperation_MapFieldDecr) isTxnOperation_Op() {}
+
+func (*TxnOperation_QueueNew) isTxnOperation_Op() {}
+
+func (*TxnOperation_QueueDel) isTxnOperation_Op() {}
+
+func (*TxnOperation_QueuePush) isTxnOperation_Op() {}
+
+func (*TxnOperation_QueuePop) isTxnOperation_Op() {}
+
+type TxnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// guards that must hold for the operations to be applied.
+	Guards []*TxnGuard     `protobuf:"bytes,1,rep,name=guards,proto3" json:"guards,omitempty"`
+	Ops    []*TxnOperation `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+}
+
+func (x *TxnRequest) Reset() {
+	*x = TxnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnRequest) ProtoMessage() {}
+
+func (x *TxnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnRequest.ProtoReflect.Descriptor instead.
+func (*TxnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *TxnRequest) GetGuards() []*TxnGuard {
+	if x != nil {
+		return x.Guards
+	}
+	return nil
+}
+
+func (x *TxnRequest) GetOps() []*TxnOperation {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+type TxnResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*TxnResult_KvSet
+	//	*TxnResult_KvDel
+	//	*TxnResult_KvIncr
+	//	*TxnResult_KvDecr
+	//	*TxnResult_MapNew
+	//	*TxnResult_MapDel
+	//	*TxnResult_MapFieldSet
+	//	*TxnResult_MapFieldDel
+	//	*TxnResult_MapFieldIncr
+	//	*TxnResult_MapFieldDecr
+	//	*TxnResult_QueueNew
+	//	*TxnResult_QueueDel
+	//	*TxnResult_QueuePush
+	//	*TxnResult_QueuePop
+	Result isTxnResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *TxnResult) Reset() {
+	*x = TxnResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnResult) ProtoMessage() {}
+
+func (x *TxnResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnResult.ProtoReflect.Descriptor instead.
+func (*TxnResult) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{202}
+}
+
+func (m *TxnResult) GetResult() isTxnResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *TxnResult) GetKvSet() *SetKVResponse {
+	if x, ok := x.GetResult().(*TxnResult_KvSet); ok {
+		return x.KvSet
+	}
+	return nil
+}
+
+func (x *TxnResult) GetKvDel() *DelKVResponse {
+	if x, ok := x.GetResult().(*TxnResult_KvDel); ok {
+		return x.KvDel
+	}
+	return nil
+}
+
+func (x *TxnResult) GetKvIncr() *IncrKVResponse {
+	if x, ok := x.GetResult().(*TxnResult_KvIncr); ok {
+		return x.KvIncr
+	}
+	return nil
+}
+
+func (x *TxnResult) GetKvDecr() *DecrKVResponse {
+	if x, ok := x.GetResult().(*TxnResult_KvDecr); ok {
+		return x.KvDecr
+	}
+	return nil
+}
+
+func (x *TxnResult) GetMapNew() *NewMapResponse {
+	if x, ok := x.GetResult().(*TxnResult_MapNew); ok {
+		return x.MapNew
+	}
+	return nil
+}
+
+func (x *TxnResult) GetMapDel() *DelMapResponse {
+	if x, ok := x.GetResult().(*TxnResult_MapDel); ok {
+		return x.MapDel
+	}
+	return nil
+}
+
+func (x *TxnResult) GetMapFieldSet() *SetMapFieldResponse {
+	if x, ok := x.GetResult().(*TxnResult_MapFieldSet); ok {
+		return x.MapFieldSet
+	}
+	return nil
+}
+
+func (x *TxnResult) GetMapFieldDel() *DelMapFieldResponse {
+	if x, ok := x.GetResult().(*TxnResult_MapFieldDel); ok {
+		return x.MapFieldDel
+	}
+	return nil
+}
+
+func (x *TxnResult) GetMapFieldIncr() *IncrMapFieldResponse {
+	if x, ok := x.GetResult().(*TxnResult_MapFieldIncr); ok {
+		return x.MapFieldIncr
+	}
+	return nil
+}
+
+func (x *TxnResult) GetMapFieldDecr() *DecrMapFieldResponse {
+	if x, ok := x.GetResult().(*TxnResult_MapFieldDecr); ok {
+		return x.MapFieldDecr
+	}
+	return nil
+}
+
+func (x *TxnResult) GetQueueNew() *NewQueueResponse {
+	if x, ok := x.GetResult().(*TxnResult_QueueNew); ok {
+		return x.QueueNew
+	}
+	return nil
+}
+
+func (x *TxnResult) GetQueueDel() *DelQueueResponse {
+	if x, ok := x.GetResult().(*TxnResult_QueueDel); ok {
+		return x.QueueDel
+	}
+	return nil
+}
+
+func (x *TxnResult) GetQueuePush() *PushQueueResponse {
+	if x, ok := x.GetResult().(*TxnResult_QueuePush); ok {
+		return x.QueuePush
+	}
+	return nil
+}
+
+func (x *TxnResult) GetQueuePop() *PopQueueResponse {
+	if x, ok := x.GetResult().(*TxnResult_QueuePop); ok {
+		return x.QueuePop
+	}
+	return nil
+}
+
+type isTxnResult_Result interface {
+	isTxnResult_Result()
+}
+
+type TxnResult_KvSet struct {
+	KvSet *SetKVResponse `protobuf:"bytes,1,opt,name=kv_set,json=kvSet,proto3,oneof"`
+}
+
+type TxnResult_KvDel struct {
+	KvDel *DelKVResponse `protobuf:"bytes,2,opt,name=kv_del,json=kvDel,proto3,oneof"`
+}
+
+type TxnResult_KvIncr struct {
+	KvIncr *IncrKVResponse `protobuf:"bytes,3,opt,name=kv_incr,json=kvIncr,proto3,oneof"`
+}
+
+type TxnResult_KvDecr struct {
+	KvDecr *DecrKVResponse `protobuf:"bytes,4,opt,name=kv_decr,json=kvDecr,proto3,oneof"`
+}
+
+type TxnResult_MapNew struct {
+	MapNew *NewMapResponse `protobuf:"bytes,10,opt,name=map_new,json=mapNew,proto3,oneof"`
+}
+
+type TxnResult_MapDel struct {
+	MapDel *DelMapResponse `protobuf:"bytes,11,opt,name=map_del,json=mapDel,proto3,oneof"`
+}
+
+type TxnResult_MapFieldSet struct {
+	MapFieldSet *SetMapFieldResponse `protobuf:"bytes,12,opt,name=map_field_set,json=mapFieldSet,proto3,oneof"`
+}
+
+type TxnResult_MapFieldDel struct {
+	MapFieldDel *DelMapFieldResponse `protobuf:"bytes,13,opt,name=map_field_del,json=mapFieldDel,proto3,oneof"`
+}
+
+type TxnResult_MapFieldIncr struct {
+	MapFieldIncr *IncrMapFieldResponse `protobuf:"bytes,14,opt,name=map_field_incr,json=mapFieldIncr,proto3,oneof"`
+}
+
+type TxnResult_MapFieldDecr struct {
+	MapFieldDecr *DecrMapFieldResponse `protobuf:"bytes,15,opt,name=map_field_decr,json=mapFieldDecr,proto3,oneof"`
+}
+
+type TxnResult_QueueNew struct {
+	QueueNew *NewQueueResponse `protobuf:"bytes,20,opt,name=queue_new,json=queueNew,proto3,oneof"`
+}
+
+type TxnResult_QueueDel struct {
+	QueueDel *DelQueueResponse `protobuf:"bytes,21,opt,name=queue_del,json=queueDel,proto3,oneof"`
+}
+
+type TxnResult_QueuePush struct {
+	QueuePush *PushQueueResponse `protobuf:"bytes,22,opt,name=queue_push,json=queuePush,proto3,oneof"`
+}
+
+type TxnResult_QueuePop struct {
+	QueuePop *PopQueueResponse `protobuf:"bytes,23,opt,name=queue_pop,json=queuePop,proto3,oneof"`
+}
+
+func (*TxnResult_KvSet) isTxnResult_Result() {}
+
+func (*TxnResult_KvDel) isTxnResult_Result() {}
+
+func (*TxnResult_KvIncr) isTxnResult_Result() {}
+
+func (*TxnResult_KvDecr) isTxnResult_Result() {}
+
+func (*TxnResult_MapNew) isTxnResult_Result() {}
+
+func (*TxnResult_MapDel) isTxnResult_Result() {}
+
+func (*TxnResult_MapFieldSet) isTxnResult_Result() {}
+
+func (*TxnResult_MapFieldDel) isTxnResult_Result() {}
+
+func (*TxnResult_MapFieldIncr) isTxnResult_Result() {}
+
+func (*TxnResult_MapFieldDecr) isTxnResult_Result() {}
+
+func (*TxnResult_QueueNew) isTxnResult_Result() {}
+
+func (*TxnResult_QueueDel) isTxnResult_Result() {}
+
+func (*TxnResult_QueuePush) isTxnResult_Result() {}
+
+func (*TxnResult_QueuePop) isTxnResult_Result() {}
+
+type TxnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results in the same order as the requested operations.
+	Results []*TxnResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *TxnResponse) Reset() {
+	*x = TxnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_protob_eventstore_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnResponse) ProtoMessage() {}
+
+func (x *TxnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protob_eventstore_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnResponse.ProtoReflect.Descriptor instead.
+func (*TxnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protob_eventstore_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *TxnResponse) GetResults() []*TxnResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_pkg_protob_eventstore_proto protoreflect.FileDescriptor
+
+var file_pkg_protob_eventstore_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x22, 0x68, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x49, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x68, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x55, 0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x22, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55,
+	0x0a, 0x0d, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x63, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x64, 0x65, 0x63, 0x72, 0x22, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5a, 0x0a,
+	0x12, 0x49, 0x6e, 0x63, 0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x49, 0x6e, 0x63,
+	0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b,
+	0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a,
+	0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x4b,
+	0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x18,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x4b, 0x56,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x49, 0x66,
+	0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x31, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x4b, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x10, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x78, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x15, 0x0a, 0x13,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0xa5, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65,
+	0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65,
+	0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x52, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x5a, 0x0a,
+	0x0e, 0x52, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a,
+	0x0e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x41,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x54, 0x54, 0x4c,
+	0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x0d, 0x54,
+	0x54, 0x4c, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x55,
+	0x0a, 0x0f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4b,
+	0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x13, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x4d, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x36, 0x0a, 0x0e, 0x4d, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4b,
+	0x56, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x43, 0x0a, 0x07,
+	0x4b, 0x56, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x63, 0x0a, 0x0d, 0x4d, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4b, 0x56, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x4d, 0x53, 0x65, 0x74, 0x4b, 0x56,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x0d, 0x4d, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x24, 0x0a, 0x0e, 0x4d, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x53, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x10, 0x0a,
+	0x0e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x41, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x99, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a, 0x0d,
+	0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x22, 0x0a, 0x0e, 0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6c, 0x65, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65,
+	0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x79, 0x0a, 0x14, 0x4d, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x17, 0x0a, 0x15, 0x4d, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x14, 0x4d, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x50, 0x0a, 0x0c, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x43, 0x0a, 0x15,
+	0x4d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x71, 0x0a, 0x13, 0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x69, 0x6e, 0x63, 0x72, 0x22, 0x2c, 0x0a, 0x14, 0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x71, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x63, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x64, 0x65, 0x63, 0x72, 0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x49, 0x6e, 0x63, 0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x22, 0x31, 0x0a, 0x19, 0x49,
+	0x6e, 0x63, 0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5c,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x15, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41,
+	0x0a, 0x0d, 0x54, 0x54, 0x4c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x22, 0x0a, 0x0e, 0x54, 0x54, 0x4c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x56, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x13, 0x0a,
+	0x11, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa1, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e,
+	0x12, 0x31, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66,
+	0x6c, 0x6f, 0x77, 0x22, 0x12, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x65, 0x6e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24,
+	0x0a, 0x10, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6c, 0x65, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x15, 0x50, 0x75, 0x73, 0x68,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x75, 0x73,
+	0x68, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x2a, 0x0a, 0x12, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x43, 0x0a, 0x0f,
+	0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x28, 0x0a, 0x10, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x50,
+	0x6f, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x6f, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x74, 0x6f, 0x70, 0x22, 0x2c, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x6e, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f,
+	0x70, 0x22, 0x13, 0x0a, 0x11, 0x54, 0x72, 0x69, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x11,
+	0x50, 0x65, 0x65, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x65, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x30,
+	0x0a, 0x18, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xaf, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x22, 0x5c, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x53, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x4e, 0x61, 0x63,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x13, 0x0a, 0x11, 0x4e, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x0f, 0x54, 0x54, 0x4c, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x10, 0x54, 0x54, 0x4c,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22,
+	0x58, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x47, 0x0a, 0x13, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x53, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a,
+	0x0d, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x22, 0x5e, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x22, 0x5e, 0x0a, 0x12, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x32, 0x0a, 0x13, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x11, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x12,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x42, 0x0a, 0x0e,
+	0x43, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x25, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x0d, 0x50, 0x6f, 0x70, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x0e, 0x50, 0x6f,
+	0x70, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x0d, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x0e, 0x54, 0x54, 0x4c, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x56, 0x0a, 0x10, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x14, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x16,
+	0x0a, 0x14, 0x4e, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x53, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x53, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x22, 0x74,
+	0x0a, 0x14, 0x49, 0x6e, 0x63, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x69, 0x6e, 0x63, 0x72, 0x22, 0x2d, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x72, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x1c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x4f, 0x0a, 0x1d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x22, 0x79, 0x0a, 0x1b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x6b,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x4e, 0x0a, 0x1c,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x7a, 0x0a, 0x22,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x3f, 0x0a, 0x23, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x14, 0x43, 0x61, 0x72,
+	0x64, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x22, 0x47, 0x0a, 0x13, 0x54, 0x54, 0x4c, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x14, 0x54, 0x54, 0x4c,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x22, 0x5c, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x22, 0x19, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x17,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x22, 0x13, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5d, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x2e, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x73, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x43, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x13, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2e, 0x0a, 0x14, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x44, 0x0a, 0x10, 0x54, 0x54, 0x4c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25,
+	0x0a, 0x11, 0x54, 0x54, 0x4c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x59, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x22, 0x16, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x0a, 0x0c, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53,
+	0x63, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x50,
+	0x0a, 0x11, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x3b, 0x0a, 0x10, 0x44, 0x72, 0x6f, 0x70, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x27, 0x0a,
+	0x11, 0x44, 0x72, 0x6f, 0x70, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x8b, 0x09, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x6b, 0x76, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x6b, 0x76, 0x53, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6b, 0x76, 0x5f, 0x67,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x6b, 0x76, 0x47, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6b, 0x76, 0x5f, 0x64, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x6b, 0x76, 0x44, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x69, 0x6e, 0x63,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6b, 0x76, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x64,
+	0x65, 0x63, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x6b, 0x76, 0x44, 0x65, 0x63, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x61,
+	0x70, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x4e, 0x65, 0x77, 0x12, 0x30, 0x0a, 0x07,
+	0x6d, 0x61, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x44, 0x65, 0x6c, 0x12, 0x46,
+	0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d,
+	0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x6d, 0x61,
+	0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0d,
+	0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x12, 0x43,
+	0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x63, 0x72,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49,
+	0x6e, 0x63, 0x72, 0x12, 0x43, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x64, 0x65, 0x63, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x63, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x65, 0x77,
+	0x12, 0x36, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x09, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x50, 0x6f, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x65, 0x65,
+	0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x65, 0x65, 0x6b, 0x42, 0x04,
+	0x0a, 0x02, 0x6f, 0x70, 0x22, 0x38, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x22, 0x3a,
+	0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcc, 0x09, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6b, 0x76,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x76, 0x53, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6b, 0x76,
+	0x5f, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x76, 0x47, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6b, 0x76,
+	0x5f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x76, 0x44, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x07, 0x6b, 0x76,
+	0x5f, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x76, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x31, 0x0a,
+	0x07, 0x6b, 0x76, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x76, 0x44, 0x65, 0x63, 0x72,
+	0x12, 0x31, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70,
+	0x4e, 0x65, 0x77, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06,
+	0x6d, 0x61, 0x70, 0x44, 0x65, 0x6c, 0x12, 0x47, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x67, 0x65,
+	0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x31, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x4c,
+	0x65, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b,
+	0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x0e, 0x6d,
+	0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63,
+	0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x63,
+	0x72, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64,
+	0x65, 0x63, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x44, 0x65, 0x63, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x6e, 0x65, 0x77, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x65, 0x77,
+	0x12, 0x37, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c,
+	0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x75, 0x73, 0x68,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x37,
+	0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x70, 0x65, 0x65, 0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50,
+	0x65, 0x65, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x0d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0d, 0x54, 0x78, 0x6e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x6e, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x54, 0x78, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x48, 0x00, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x06, 0x0a, 0x0c, 0x54, 0x78, 0x6e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x6b, 0x76, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x6b, 0x76, 0x53, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6b, 0x76, 0x5f, 0x64, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x6b, 0x76, 0x44, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x69, 0x6e, 0x63,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6b, 0x76, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x64,
+	0x65, 0x63, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x6b, 0x76, 0x44, 0x65, 0x63, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x61,
+	0x70, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x4e, 0x65, 0x77, 0x12, 0x30, 0x0a, 0x07,
+	0x6d, 0x61, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x44, 0x65, 0x6c, 0x12, 0x40,
+	0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74,
+	0x12, 0x40, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44,
+	0x65, 0x6c, 0x12, 0x43, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x69, 0x6e, 0x63, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x43, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c,
+	0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x63, 0x72, 0x12, 0x36, 0x0a, 0x09,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x4e, 0x65, 0x77, 0x12, 0x36, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65,
+	0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x70, 0x6f, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x42,
+	0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22, 0x5e, 0x0a, 0x0a, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x78, 0x6e,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x06, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a,
+	0x03, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x54, 0x78, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x6f, 0x70, 0x73, 0x22, 0xba, 0x06, 0x0a, 0x09, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6b, 0x76, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x76,
+	0x53, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6b, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x76,
+	0x44, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e,
+	0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06,
+	0x6b, 0x76, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x64, 0x65, 0x63,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x6b, 0x76, 0x44, 0x65, 0x63, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x61, 0x70,
+	0x5f, 0x6e, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x4e, 0x65, 0x77, 0x12, 0x31, 0x0a, 0x07,
+	0x6d, 0x61, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x44, 0x65, 0x6c, 0x12,
+	0x41, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6d,
+	0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x44, 0x0a, 0x0e, 0x6d,
+	0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63,
+	0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x63,
+	0x72, 0x12, 0x37, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65,
+	0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x65, 0x77, 0x12, 0x37, 0x0a, 0x09, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x44, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x75, 0x73,
+	0x68, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12,
+	0x37, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x70, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x3a, 0x0a, 0x0b, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x78, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a, 0x33,
+	0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x0c, 0x0a,
+	0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x10, 0x02, 0x2a, 0x3b, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x76, 0x65, 0x72,
+	0x66, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x76, 0x65, 0x72,
+	0x66, 0x6c, 0x6f, 0x77, 0x44, 0x72, 0x6f, 0x70, 0x4f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x10, 0x01,
+	0x2a, 0x91, 0x02, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x10,
+	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x10,
+	0x04, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x10, 0x06, 0x12,
+	0x18, 0x0a, 0x14, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x50, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52,
+	0x49, 0x4d, 0x10, 0x08, 0x2a, 0x61, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x54, 0x79, 0x70, 0x65, 0x4b, 0x56, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54,
+	0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65, 0x53,
+	0x65, 0x74, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x10, 0x05, 0x32, 0xae, 0x08, 0x0a, 0x02, 0x4b, 0x56, 0x12, 0x34,
+	0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63,
+	0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x04, 0x44, 0x65, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44,
+	0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x72, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63,
+	0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64,
+	0x53, 0x77, 0x61, 0x70, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e,
+	0x64, 0x53, 0x77, 0x61, 0x70, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x49,
+	0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c,
+	0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4b,
+	0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x04, 0x4d, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d,
+	0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4d, 0x53, 0x65, 0x74, 0x12, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d,
+	0x53, 0x65, 0x74, 0x4b, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x04, 0x4d, 0x44, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4d, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x44, 0x65, 0x6c, 0x4b, 0x56, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b,
+	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xec, 0x09, 0x0a, 0x03, 0x4d, 0x61, 0x70,
+	0x12, 0x36, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x03, 0x4c, 0x65, 0x6e, 0x12,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x4c, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x70,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x53,
+	0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x53, 0x65, 0x74,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x47, 0x65, 0x74,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x65, 0x63, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x44, 0x65, 0x63, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x63,
+	0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x63, 0x72,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49,
+	0x6e, 0x63, 0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x49, 0x6e, 0x63, 0x72, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x65, 0x74, 0x12,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x03, 0x54, 0x54,
+	0x4c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x54, 0x54, 0x4c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf5, 0x09, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x12, 0x3a, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x4e, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x03, 0x4c, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x65,
+	0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x04,
+	0x50, 0x75, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x05, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x03, 0x50, 0x6f, 0x70, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f,
+	0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x04, 0x50, 0x65, 0x65,
+	0x6b, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x50, 0x6f, 0x70, 0x42, 0x61, 0x63,
+	0x6b, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x42, 0x61,
+	0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x04, 0x54, 0x72, 0x69, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x6d,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x12, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x03, 0x41,
+	0x63, 0x6b, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x63, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x04, 0x4e, 0x61, 0x63, 0x6b, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x4e, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x54, 0x54, 0x4c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xa9, 0x05, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x12, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e,
+	0x65, 0x77, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x12, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x04, 0x43,
+	0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x03, 0x50, 0x6f, 0x70, 0x12, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x70, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x6f,
+	0x70, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36,
+	0x0a, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54,
+	0x54, 0x4c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf2, 0x06, 0x0a, 0x09,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x03, 0x4e, 0x65, 0x77,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x03, 0x44, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x53, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x53,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79,
+	0x52, 0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x53, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x54, 0x54, 0x4c, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x32, 0xe9, 0x04, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3c, 0x0a, 0x03, 0x4e,
+	0x65, 0x77, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x03, 0x44, 0x65, 0x6c,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x03, 0x54, 0x54, 0x4c, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54,
+	0x4c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x54, 0x4c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x06, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf3, 0x03, 0x0a,
+	0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x05, 0x52, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x52, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x52, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0x3e, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x05, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x32, 0x85, 0x01, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x04,
+	0x53, 0x63, 0x61, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63,
+	0x61, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x04, 0x44,
+	0x72, 0x6f, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x72, 0x6f,
+	0x70, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x3f, 0x0a, 0x05, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x37, 0x0a, 0x03, 0x54,
+	0x78, 0x6e, 0x12, 0x30, 0x0a, 0x03, 0x54, 0x78, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_protob_eventstore_proto_rawDescOnce sync.Once
+	file_pkg_protob_eventstore_proto_rawDescData = file_pkg_protob_eventstore_proto_rawDesc
+)
+
+func file_pkg_protob_eventstore_proto_rawDescGZIP() []byte {
+	file_pkg_protob_eventstore_proto_rawDescOnce.Do(func() {
+		file_pkg_protob_eventstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_protob_eventstore_proto_rawDescData)
+	})
+	return file_pkg_protob_eventstore_proto_rawDescData
+}
+
+var file_pkg_protob_eventstore_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pkg_protob_eventstore_proto_msgTypes = make([]protoimpl.MessageInfo, 205)
+var file_pkg_protob_eventstore_proto_goTypes = []interface{}{
+	(ScopeChoice)(0),                            // 0: protob.ScopeChoice
+	(QueueOverflow)(0),                          // 1: protob.QueueOverflow
+	(WatchEventType)(0),                         // 2: protob.WatchEventType
+	(KeyType)(0),                                // 3: protob.KeyType
+	(*ScopeType)(nil),                           // 4: protob.ScopeType
+	(*LocationType)(nil),                        // 5: protob.LocationType
+	(*SetKVRequest)(nil),                        // 6: protob.SetKVRequest
+	(*SetKVResponse)(nil),                       // 7: protob.SetKVResponse
+	(*IncrKVRequest)(nil),                       // 8: protob.IncrKVRequest
+	(*IncrKVResponse)(nil),                      // 9: protob.IncrKVResponse
+	(*DecrKVRequest)(nil),                       // 10: protob.DecrKVRequest
+	(*DecrKVResponse)(nil),                      // 11: protob.DecrKVResponse
+	(*IncrFloatKVRequest)(nil),                  // 12: protob.IncrFloatKVRequest
+	(*IncrFloatKVResponse)(nil),                 // 13: protob.IncrFloatKVResponse
+	(*GetKVRequest)(nil),                        // 14: protob.GetKVRequest
+	(*GetKVResponse)(nil),                       // 15: protob.GetKVResponse
+	(*DelKVRequest)(nil),                        // 16: protob.DelKVRequest
+	(*DelKVResponse)(nil),                       // 17: protob.DelKVResponse
+	(*CompareAndSwapKVRequest)(nil),             // 18: protob.CompareAndSwapKVRequest
+	(*CompareAndSwapKVResponse)(nil),            // 19: protob.CompareAndSwapKVResponse
+	(*SetIfNotExistsKVRequest)(nil),             // 20: protob.SetIfNotExistsKVRequest
+	(*SetIfNotExistsKVResponse)(nil),            // 21: protob.SetIfNotExistsKVResponse
+	(*SetIfExistsKVRequest)(nil),                // 22: protob.SetIfExistsKVRequest
+	(*SetIfExistsKVResponse)(nil),               // 23: protob.SetIfExistsKVResponse
+	(*LockRequest)(nil),                         // 24: protob.LockRequest
+	(*LockResponse)(nil),                        // 25: protob.LockResponse
+	(*UnlockRequest)(nil),                       // 26: protob.UnlockRequest
+	(*UnlockResponse)(nil),                      // 27: protob.UnlockResponse
+	(*RefreshLockRequest)(nil),                  // 28: protob.RefreshLockRequest
+	(*RefreshLockResponse)(nil),                 // 29: protob.RefreshLockResponse
+	(*LockInfoRequest)(nil),                     // 30: protob.LockInfoRequest
+	(*LockInfoResponse)(nil),                    // 31: protob.LockInfoResponse
+	(*RLockRequest)(nil),                        // 32: protob.RLockRequest
+	(*RLockResponse)(nil),                       // 33: protob.RLockResponse
+	(*RUnlockRequest)(nil),                      // 34: protob.RUnlockRequest
+	(*RUnlockResponse)(nil),                     // 35: protob.RUnlockResponse
+	(*AcquireRequest)(nil),                      // 36: protob.AcquireRequest
+	(*AcquireResponse)(nil),                     // 37: protob.AcquireResponse
+	(*ReleaseRequest)(nil),                      // 38: protob.ReleaseRequest
+	(*ReleaseResponse)(nil),                     // 39: protob.ReleaseResponse
+	(*TTLKVRequest)(nil),                        // 40: protob.TTLKVRequest
+	(*TTLKVResponse)(nil),                       // 41: protob.TTLKVResponse
+	(*ExpireKVRequest)(nil),                     // 42: protob.ExpireKVRequest
+	(*ExpireKVResponse)(nil),                    // 43: protob.ExpireKVResponse
+	(*PersistKVRequest)(nil),                    // 44: protob.PersistKVRequest
+	(*PersistKVResponse)(nil),                   // 45: protob.PersistKVResponse
+	(*MGetKVRequest)(nil),                       // 46: protob.MGetKVRequest
+	(*KVItem)(nil),                              // 47: protob.KVItem
+	(*MGetKVResponse)(nil),                      // 48: protob.MGetKVResponse
+	(*KVEntry)(nil),                             // 49: protob.KVEntry
+	(*MSetKVRequest)(nil),                       // 50: protob.MSetKVRequest
+	(*MSetKVResponse)(nil),                      // 51: protob.MSetKVResponse
+	(*MDelKVRequest)(nil),                       // 52: protob.MDelKVRequest
+	(*MDelKVResponse)(nil),                      // 53: protob.MDelKVResponse
+	(*NewMapRequest)(nil),                       // 54: protob.NewMapRequest
+	(*NewMapResponse)(nil),                      // 55: protob.NewMapResponse
+	(*DelMapRequest)(nil),                       // 56: protob.DelMapRequest
+	(*DelMapResponse)(nil),                      // 57: protob.DelMapResponse
+	(*GetAllMapFieldsRequest)(nil),              // 58: protob.GetAllMapFieldsRequest
+	(*GetAllMapFieldsResponse)(nil),             // 59: protob.GetAllMapFieldsResponse
+	(*LenMapRequest)(nil),                       // 60: protob.LenMapRequest
+	(*LenMapResponse)(nil),                      // 61: protob.LenMapResponse
+	(*SetMapFieldRequest)(nil),                  // 62: protob.SetMapFieldRequest
+	(*SetMapFieldResponse)(nil),                 // 63: protob.SetMapFieldResponse
+	(*SetIfNotExistsMapFieldRequest)(nil),       // 64: protob.SetIfNotExistsMapFieldRequest
+	(*SetIfNotExistsMapFieldResponse)(nil),      // 65: protob.SetIfNotExistsMapFieldResponse
+	(*MapFieldEntry)(nil),                       // 66: protob.MapFieldEntry
+	(*MSetMapFieldsRequest)(nil),                // 67: protob.MSetMapFieldsRequest
+	(*MSetMapFieldsResponse)(nil),               // 68: protob.MSetMapFieldsResponse
+	(*MGetMapFieldsRequest)(nil),                // 69: protob.MGetMapFieldsRequest
+	(*MapFieldItem)(nil),                        // 70: protob.MapFieldItem
+	(*MGetMapFieldsResponse)(nil),               // 71: protob.MGetMapFieldsResponse
+	(*IncrMapFieldRequest)(nil),                 // 72: protob.IncrMapFieldRequest
+	(*IncrMapFieldResponse)(nil),                // 73: protob.IncrMapFieldResponse
+	(*DecrMapFieldRequest)(nil),                 // 74: protob.DecrMapFieldRequest
+	(*DecrMapFieldResponse)(nil),                // 75: protob.DecrMapFieldResponse
+	(*IncrFloatMapFieldRequest)(nil),            // 76: protob.IncrFloatMapFieldRequest
+	(*IncrFloatMapFieldResponse)(nil),           // 77: protob.IncrFloatMapFieldResponse
+	(*DelMapFieldRequest)(nil),                  // 78: protob.DelMapFieldRequest
+	(*DelMapFieldResponse)(nil),                 // 79: protob.DelMapFieldResponse
+	(*GetMapFieldRequest)(nil),                  // 80: protob.GetMapFieldRequest
+	(*GetMapFieldResponse)(nil),                 // 81: protob.GetMapFieldResponse
+	(*TTLMapRequest)(nil),                       // 82: protob.TTLMapRequest
+	(*TTLMapResponse)(nil),                      // 83: protob.TTLMapResponse
+	(*ExpireMapRequest)(nil),                    // 84: protob.ExpireMapRequest
+	(*ExpireMapResponse)(nil),                   // 85: protob.ExpireMapResponse
+	(*PersistMapRequest)(nil),                   // 86: protob.PersistMapRequest
+	(*PersistMapResponse)(nil),                  // 87: protob.PersistMapResponse
+	(*NewQueueRequest)(nil),                     // 88: protob.NewQueueRequest
+	(*NewQueueResponse)(nil),                    // 89: protob.NewQueueResponse
+	(*DelQueueRequest)(nil),                     // 90: protob.DelQueueRequest
+	(*DelQueueResponse)(nil),                    // 91: protob.DelQueueResponse
+	(*GetAllQueuesRequest)(nil),                 // 92: protob.GetAllQueuesRequest
+	(*GetAllQueuesResponse)(nil),                // 93: protob.GetAllQueuesResponse
+	(*LenQueueRequest)(nil),                     // 94: protob.LenQueueRequest
+	(*LenQueueResponse)(nil),                    // 95: protob.LenQueueResponse
+	(*PushQueueRequest)(nil),                    // 96: protob.PushQueueRequest
+	(*PushQueueResponse)(nil),                   // 97: protob.PushQueueResponse
+	(*PushFrontQueueRequest)(nil),               // 98: protob.PushFrontQueueRequest
+	(*PushFrontQueueResponse)(nil),              // 99: protob.PushFrontQueueResponse
+	(*IndexQueueRequest)(nil),                   // 100: protob.IndexQueueRequest
+	(*IndexQueueResponse)(nil),                  // 101: protob.IndexQueueResponse
+	(*PopQueueRequest)(nil),                     // 102: protob.PopQueueRequest
+	(*PopQueueResponse)(nil),                    // 103: protob.PopQueueResponse
+	(*PopBackQueueRequest)(nil),                 // 104: protob.PopBackQueueRequest
+	(*PopBackQueueResponse)(nil),                // 105: protob.PopBackQueueResponse
+	(*RangeQueueRequest)(nil),                   // 106: protob.RangeQueueRequest
+	(*RangeQueueResponse)(nil),                  // 107: protob.RangeQueueResponse
+	(*TrimQueueRequest)(nil),                    // 108: protob.TrimQueueRequest
+	(*TrimQueueResponse)(nil),                   // 109: protob.TrimQueueResponse
+	(*PeekQueueRequest)(nil),                    // 110: protob.PeekQueueRequest
+	(*PeekQueueResponse)(nil),                   // 111: protob.PeekQueueResponse
+	(*BlockingPopQueueRequest)(nil),             // 112: protob.BlockingPopQueueRequest
+	(*BlockingPopQueueResponse)(nil),            // 113: protob.BlockingPopQueueResponse
+	(*ReserveQueueRequest)(nil),                 // 114: protob.ReserveQueueRequest
+	(*ReserveQueueResponse)(nil),                // 115: protob.ReserveQueueResponse
+	(*AckQueueRequest)(nil),                     // 116: protob.AckQueueRequest
+	(*AckQueueResponse)(nil),                    // 117: protob.AckQueueResponse
+	(*NackQueueRequest)(nil),                    // 118: protob.NackQueueRequest
+	(*NackQueueResponse)(nil),                   // 119: protob.NackQueueResponse
+	(*TTLQueueRequest)(nil),                     // 120: protob.TTLQueueRequest
+	(*TTLQueueResponse)(nil),                    // 121: protob.TTLQueueResponse
+	(*ExpireQueueRequest)(nil),                  // 122: protob.ExpireQueueRequest
+	(*ExpireQueueResponse)(nil),                 // 123: protob.ExpireQueueResponse
+	(*PersistQueueRequest)(nil),                 // 124: protob.PersistQueueRequest
+	(*PersistQueueResponse)(nil),                // 125: protob.PersistQueueResponse
+	(*NewSetRequest)(nil),                       // 126: protob.NewSetRequest
+	(*NewSetResponse)(nil),                      // 127: protob.NewSetResponse
+	(*DelSetRequest)(nil),                       // 128: protob.DelSetRequest
+	(*DelSetResponse)(nil),                      // 129: protob.DelSetResponse
+	(*AddSetRequest)(nil),                       // 130: protob.AddSetRequest
+	(*AddSetResponse)(nil),                      // 131: protob.AddSetResponse
+	(*RemoveSetRequest)(nil),                    // 132: protob.RemoveSetRequest
+	(*RemoveSetResponse)(nil),                   // 133: protob.RemoveSetResponse
+	(*IsMemberSetRequest)(nil),                  // 134: protob.IsMemberSetRequest
+	(*IsMemberSetResponse)(nil),                 // 135: protob.IsMemberSetResponse
+	(*MembersSetRequest)(nil),                   // 136: protob.MembersSetRequest
+	(*MembersSetResponse)(nil),                  // 137: protob.MembersSetResponse
+	(*CardSetRequest)(nil),                      // 138: protob.CardSetRequest
+	(*CardSetResponse)(nil),                     // 139: protob.CardSetResponse
+	(*PopSetRequest)(nil),                       // 140: protob.PopSetRequest
+	(*PopSetResponse)(nil),                      // 141: protob.PopSetResponse
+	(*TTLSetRequest)(nil),                       // 142: protob.TTLSetRequest
+	(*TTLSetResponse)(nil),                      // 143: protob.TTLSetResponse
+	(*ExpireSetRequest)(nil),                    // 144: protob.ExpireSetRequest
+	(*ExpireSetResponse)(nil),                   // 145: protob.ExpireSetResponse
+	(*PersistSetRequest)(nil),                   // 146: protob.PersistSetRequest
+	(*PersistSetResponse)(nil),                  // 147: protob.PersistSetResponse
+	(*ScoredMember)(nil),                        // 148: protob.ScoredMember
+	(*NewSortedSetRequest)(nil),                 // 149: protob.NewSortedSetRequest
+	(*NewSortedSetResponse)(nil),                // 150: protob.NewSortedSetResponse
+	(*DelSortedSetRequest)(nil),                 // 151: protob.DelSortedSetRequest
+	(*DelSortedSetResponse)(nil),                // 152: protob.DelSortedSetResponse
+	(*AddSortedSetRequest)(nil),                 // 153: protob.AddSortedSetRequest
+	(*AddSortedSetResponse)(nil),                // 154: protob.AddSortedSetResponse
+	(*IncrSortedSetRequest)(nil),                // 155: protob.IncrSortedSetRequest
+	(*IncrSortedSetResponse)(nil),               // 156: protob.IncrSortedSetResponse
+	(*RangeByScoreSortedSetRequest)(nil),        // 157: protob.RangeByScoreSortedSetRequest
+	(*RangeByScoreSortedSetResponse)(nil),       // 158: protob.RangeByScoreSortedSetResponse
+	(*RangeByRankSortedSetRequest)(nil),         // 159: protob.RangeByRankSortedSetRequest
+	(*RangeByRankSortedSetResponse)(nil),        // 160: protob.RangeByRankSortedSetResponse
+	(*RemoveRangeByScoreSortedSetRequest)(nil),  // 161: protob.RemoveRangeByScoreSortedSetRequest
+	(*RemoveRangeByScoreSortedSetResponse)(nil), // 162: protob.RemoveRangeByScoreSortedSetResponse
+	(*CardSortedSetRequest)(nil),                // 163: protob.CardSortedSetRequest
+	(*CardSortedSetResponse)(nil),               // 164: protob.CardSortedSetResponse
+	(*TTLSortedSetRequest)(nil),                 // 165: protob.TTLSortedSetRequest
+	(*TTLSortedSetResponse)(nil),                // 166: protob.TTLSortedSetResponse
+	(*ExpireSortedSetRequest)(nil),              // 167: protob.ExpireSortedSetRequest
+	(*ExpireSortedSetResponse)(nil),             // 168: protob.ExpireSortedSetResponse
+	(*PersistSortedSetRequest)(nil),             // 169: protob.PersistSortedSetRequest
+	(*PersistSortedSetResponse)(nil),            // 170: protob.PersistSortedSetResponse
+	(*StreamEntry)(nil),                         // 171: protob.StreamEntry
+	(*NewStreamRequest)(nil),                    // 172: protob.NewStreamRequest
+	(*NewStreamResponse)(nil),                   // 173: protob.NewStreamResponse
+	(*DelStreamRequest)(nil),                    // 174: protob.DelStreamRequest
+	(*DelStreamResponse)(nil),                   // 175: protob.DelStreamResponse
+	(*AppendStreamRequest)(nil),                 // 176: protob.AppendStreamRequest
+	(*AppendStreamResponse)(nil),                // 177: protob.AppendStreamResponse
+	(*ReadStreamRequest)(nil),                   // 178: protob.ReadStreamRequest
+	(*ReadStreamResponse)(nil),                  // 179: protob.ReadStreamResponse
+	(*CommitStreamRequest)(nil),                 // 180: protob.CommitStreamRequest
+	(*CommitStreamResponse)(nil),                // 181: protob.CommitStreamResponse
+	(*OffsetStreamRequest)(nil),                 // 182: protob.OffsetStreamRequest
+	(*OffsetStreamResponse)(nil),                // 183: protob.OffsetStreamResponse
+	(*TTLStreamRequest)(nil),                    // 184: protob.TTLStreamRequest
+	(*TTLStreamResponse)(nil),                   // 185: protob.TTLStreamResponse
+	(*ExpireStreamRequest)(nil),                 // 186: protob.ExpireStreamRequest
+	(*ExpireStreamResponse)(nil),                // 187: protob.ExpireStreamResponse
+	(*PersistStreamRequest)(nil),                // 188: protob.PersistStreamRequest
+	(*PersistStreamResponse)(nil),               // 189: protob.PersistStreamResponse
+	(*WatchRequest)(nil),                        // 190: protob.WatchRequest
+	(*WatchEvent)(nil),                          // 191: protob.WatchEvent
+	(*KeyInfo)(nil),                             // 192: protob.KeyInfo
+	(*ScanScopeRequest)(nil),                    // 193: protob.ScanScopeRequest
+	(*ScanScopeResponse)(nil),                   // 194: protob.ScanScopeResponse
+	(*DropScopeRequest)(nil),                    // 195: protob.DropScopeRequest
+	(*DropScopeResponse)(nil),                   // 196: protob.DropScopeResponse
+	(*BatchOperation)(nil),                      // 197: protob.BatchOperation
+	(*BatchRequest)(nil),                        // 198: protob.BatchRequest
+	(*BatchError)(nil),                          // 199: protob.BatchError
+	(*BatchResult)(nil),                         // 200: protob.BatchResult
+	(*BatchResponse)(nil),                       // 201: protob.BatchResponse
+	(*TxnFieldGuard)(nil),                       // 202: protob.TxnFieldGuard
+	(*TxnGuard)(nil),                            // 203: protob.TxnGuard
+	(*TxnOperation)(nil),                        // 204: protob.TxnOperation
+	(*TxnRequest)(nil),                          // 205: protob.TxnRequest
+	(*TxnResult)(nil),                           // 206: protob.TxnResult
+	(*TxnResponse)(nil),                         // 207: protob.TxnResponse
+	nil,                                         // 208: protob.GetAllMapFieldsResponse.ValuesEntry
+}
+var file_pkg_protob_eventstore_proto_depIdxs = []int32{
+	0,   // 0: protob.ScopeType.type:type_name -> protob.ScopeChoice
+	4,   // 1: protob.LocationType.scope:type_name -> protob.ScopeType
+	5,   // 2: protob.SetKVRequest.location:type_name -> protob.LocationType
+	5,   // 3: protob.IncrKVRequest.location:type_name -> protob.LocationType
+	5,   // 4: protob.DecrKVRequest.location:type_name -> protob.LocationType
+	5,   // 5: protob.IncrFloatKVRequest.location:type_name -> protob.LocationType
+	5,   // 6: protob.GetKVRequest.location:type_name -> protob.LocationType
+	5,   // 7: protob.DelKVRequest.location:type_name -> protob.LocationType
+	5,   // 8: protob.CompareAndSwapKVRequest.location:type_name -> protob.LocationType
+	5,   // 9: protob.SetIfNotExistsKVRequest.location:type_name -> protob.LocationType
+	5,   // 10: protob.SetIfExistsKVRequest.location:type_name -> protob.LocationType
+	5,   // 11: protob.LockRequest.location:type_name -> protob.LocationType
+	5,   // 12: protob.UnlockRequest.location:type_name -> protob.LocationType
+	5,   // 13: protob.RefreshLockRequest.location:type_name -> protob.LocationType
+	5,   // 14: protob.LockInfoRequest.location:type_name -> protob.LocationType
+	5,   // 15: protob.RLockRequest.location:type_name -> protob.LocationType
+	5,   // 16: protob.RUnlockRequest.location:type_name -> protob.LocationType
+	5,   // 17: protob.AcquireRequest.location:type_name -> protob.LocationType
+	5,   // 18: protob.ReleaseRequest.location:type_name -> protob.LocationType
+	5,   // 19: protob.TTLKVRequest.location:type_name -> protob.LocationType
+	5,   // 20: protob.ExpireKVRequest.location:type_name -> protob.LocationType
+	5,   // 21: protob.PersistKVRequest.location:type_name -> protob.LocationType
+	4,   // 22: protob.MGetKVRequest.scope:type_name -> protob.ScopeType
+	47,  // 23: protob.MGetKVResponse.items:type_name -> protob.KVItem
+	4,   // 24: protob.MSetKVRequest.scope:type_name -> protob.ScopeType
+	49,  // 25: protob.MSetKVRequest.entries:type_name -> protob.KVEntry
+	4,   // 26: protob.MDelKVRequest.scope:type_name -> protob.ScopeType
+	5,   // 27: protob.NewMapRequest.location:type_name -> protob.LocationType
+	5,   // 28: protob.DelMapRequest.location:type_name -> protob.LocationType
+	5,   // 29: protob.GetAllMapFieldsRequest.location:type_name -> protob.LocationType
+	208, // 30: protob.GetAllMapFieldsResponse.values:type_name -> protob.GetAllMapFieldsResponse.ValuesEntry
+	5,   // 31: protob.LenMapRequest.location:type_name -> protob.LocationType
+	5,   // 32: protob.SetMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 33: protob.SetIfNotExistsMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 34: protob.MSetMapFieldsRequest.location:type_name -> protob.LocationType
+	66,  // 35: protob.MSetMapFieldsRequest.entries:type_name -> protob.MapFieldEntry
+	5,   // 36: protob.MGetMapFieldsRequest.location:type_name -> protob.LocationType
+	70,  // 37: protob.MGetMapFieldsResponse.items:type_name -> protob.MapFieldItem
+	5,   // 38: protob.IncrMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 39: protob.DecrMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 40: protob.IncrFloatMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 41: protob.DelMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 42: protob.GetMapFieldRequest.location:type_name -> protob.LocationType
+	5,   // 43: protob.TTLMapRequest.location:type_name -> protob.LocationType
+	5,   // 44: protob.ExpireMapRequest.location:type_name -> protob.LocationType
+	5,   // 45: protob.PersistMapRequest.location:type_name -> protob.LocationType
+	5,   // 46: protob.NewQueueRequest.location:type_name -> protob.LocationType
+	1,   // 47: protob.NewQueueRequest.overflow:type_name -> protob.QueueOverflow
+	5,   // 48: protob.DelQueueRequest.location:type_name -> protob.LocationType
+	5,   // 49: protob.GetAllQueuesRequest.location:type_name -> protob.LocationType
+	5,   // 50: protob.LenQueueRequest.location:type_name -> protob.LocationType
+	5,   // 51: protob.PushQueueRequest.location:type_name -> protob.LocationType
+	5,   // 52: protob.PushFrontQueueRequest.location:type_name -> protob.LocationType
+	5,   // 53: protob.IndexQueueRequest.location:type_name -> protob.LocationType
+	5,   // 54: protob.PopQueueRequest.location:type_name -> protob.LocationType
+	5,   // 55: protob.PopBackQueueRequest.location:type_name -> protob.LocationType
+	5,   // 56: protob.RangeQueueRequest.location:type_name -> protob.LocationType
+	5,   // 57: protob.TrimQueueRequest.location:type_name -> protob.LocationType
+	5,   // 58: protob.PeekQueueRequest.location:type_name -> protob.LocationType
+	5,   // 59: protob.BlockingPopQueueRequest.location:type_name -> protob.LocationType
+	5,   // 60: protob.ReserveQueueRequest.location:type_name -> protob.LocationType
+	5,   // 61: protob.AckQueueRequest.location:type_name -> protob.LocationType
+	5,   // 62: protob.NackQueueRequest.location:type_name -> protob.LocationType
+	5,   // 63: protob.TTLQueueRequest.location:type_name -> protob.LocationType
+	5,   // 64: protob.ExpireQueueRequest.location:type_name -> protob.LocationType
+	5,   // 65: protob.PersistQueueRequest.location:type_name -> protob.LocationType
+	5,   // 66: protob.NewSetRequest.location:type_name -> protob.LocationType
+	5,   // 67: protob.DelSetRequest.location:type_name -> protob.LocationType
+	5,   // 68: protob.AddSetRequest.location:type_name -> protob.LocationType
+	5,   // 69: protob.RemoveSetRequest.location:type_name -> protob.LocationType
+	5,   // 70: protob.IsMemberSetRequest.location:type_name -> protob.LocationType
+	5,   // 71: protob.MembersSetRequest.location:type_name -> protob.LocationType
+	5,   // 72: protob.CardSetRequest.location:type_name -> protob.LocationType
+	5,   // 73: protob.PopSetRequest.location:type_name -> protob.LocationType
+	5,   // 74: protob.TTLSetRequest.location:type_name -> protob.LocationType
+	5,   // 75: protob.ExpireSetRequest.location:type_name -> protob.LocationType
+	5,   // 76: protob.PersistSetRequest.location:type_name -> protob.LocationType
+	5,   // 77: protob.NewSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 78: protob.DelSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 79: protob.AddSortedSetRequest.location:type_name -> protob.LocationType
+	148, // 80: protob.AddSortedSetRequest.members:type_name -> protob.ScoredMember
+	5,   // 81: protob.IncrSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 82: protob.RangeByScoreSortedSetRequest.location:type_name -> protob.LocationType
+	148, // 83: protob.RangeByScoreSortedSetResponse.members:type_name -> protob.ScoredMember
+	5,   // 84: protob.RangeByRankSortedSetRequest.location:type_name -> protob.LocationType
+	148, // 85: protob.RangeByRankSortedSetResponse.members:type_name -> protob.ScoredMember
+	5,   // 86: protob.RemoveRangeByScoreSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 87: protob.CardSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 88: protob.TTLSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 89: protob.ExpireSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 90: protob.PersistSortedSetRequest.location:type_name -> protob.LocationType
+	5,   // 91: protob.NewStreamRequest.location:type_name -> protob.LocationType
+	5,   // 92: protob.DelStreamRequest.location:type_name -> protob.LocationType
+	5,   // 93: protob.AppendStreamRequest.location:type_name -> protob.LocationType
+	5,   // 94: protob.ReadStreamRequest.location:type_name -> protob.LocationType
+	171, // 95: protob.ReadStreamResponse.entries:type_name -> protob.StreamEntry
+	5,   // 96: protob.CommitStreamRequest.location:type_name -> protob.LocationType
+	5,   // 97: protob.OffsetStreamRequest.location:type_name -> protob.LocationType
+	5,   // 98: protob.TTLStreamRequest.location:type_name -> protob.LocationType
+	5,   // 99: protob.ExpireStreamRequest.location:type_name -> protob.LocationType
+	5,   // 100: protob.PersistStreamRequest.location:type_name -> protob.LocationType
+	5,   // 101: protob.WatchRequest.location:type_name -> protob.LocationType
+	2,   // 102: protob.WatchEvent.type:type_name -> protob.WatchEventType
+	5,   // 103: protob.WatchEvent.location:type_name -> protob.LocationType
+	3,   // 104: protob.KeyInfo.type:type_name -> protob.KeyType
+	4,   // 105: protob.ScanScopeRequest.scope:type_name -> protob.ScopeType
+	192, // 106: protob.ScanScopeResponse.keys:type_name -> protob.KeyInfo
+	4,   // 107: protob.DropScopeRequest.scope:type_name -> protob.ScopeType
+	6,   // 108: protob.BatchOperation.kv_set:type_name -> protob.SetKVRequest
+	14,  // 109: protob.BatchOperation.kv_get:type_name -> protob.GetKVRequest
+	16,  // 110: protob.BatchOperation.kv_del:type_name -> protob.DelKVRequest
+	8,   // 111: protob.BatchOperation.kv_incr:type_name -> protob.IncrKVRequest
+	10,  // 112: protob.BatchOperation.kv_decr:type_name -> protob.DecrKVRequest
+	54,  // 113: protob.BatchOperation.map_new:type_name -> protob.NewMapRequest
+	56,  // 114: protob.BatchOperation.map_del:type_name -> protob.DelMapRequest
+	58,  // 115: protob.BatchOperation.map_get_fields:type_name -> protob.GetAllMapFieldsRequest
+	60,  // 116: protob.BatchOperation.map_len:type_name -> protob.LenMapRequest
+	62,  // 117: protob.BatchOperation.map_field_set:type_name -> protob.SetMapFieldRequest
+	80,  // 118: protob.BatchOperation.map_field_get:type_name -> protob.GetMapFieldRequest
+	78,  // 119: protob.BatchOperation.map_field_del:type_name -> protob.DelMapFieldRequest
+	72,  // 120: protob.BatchOperation.map_field_incr:type_name -> protob.IncrMapFieldRequest
+	74,  // 121: protob.BatchOperation.map_field_decr:type_name -> protob.DecrMapFieldRequest
+	88,  // 122: protob.BatchOperation.queue_new:type_name -> protob.NewQueueRequest
+	90,  // 123: protob.BatchOperation.queue_del:type_name -> protob.DelQueueRequest
+	94,  // 124: protob.BatchOperation.queue_len:type_name -> protob.LenQueueRequest
+	96,  // 125: protob.BatchOperation.queue_push:type_name -> protob.PushQueueRequest
+	102, // 126: protob.BatchOperation.queue_pop:type_name -> protob.PopQueueRequest
+	110, // 127: protob.BatchOperation.queue_peek:type_name -> protob.PeekQueueRequest
+	197, // 128: protob.BatchRequest.ops:type_name -> protob.BatchOperation
+	7,   // 129: protob.BatchResult.kv_set:type_name -> protob.SetKVResponse
+	15,  // 130: protob.BatchResult.kv_get:type_name -> protob.GetKVResponse
+	17,  // 131: protob.BatchResult.kv_del:type_name -> protob.DelKVResponse
+	9,   // 132: protob.BatchResult.kv_incr:type_name -> protob.IncrKVResponse
+	11,  // 133: protob.BatchResult.kv_decr:type_name -> protob.DecrKVResponse
+	55,  // 134: protob.BatchResult.map_new:type_name -> protob.NewMapResponse
+	57,  // 135: protob.BatchResult.map_del:type_name -> protob.DelMapResponse
+	59,  // 136: protob.BatchResult.map_get_fields:type_name -> protob.GetAllMapFieldsResponse
+	61,  // 137: protob.BatchResult.map_len:type_name -> protob.LenMapResponse
+	63,  // 138: protob.BatchResult.map_field_set:type_name -> protob.SetMapFieldResponse
+	81,  // 139: protob.BatchResult.map_field_get:type_name -> protob.GetMapFieldResponse
+	79,  // 140: protob.BatchResult.map_field_del:type_name -> protob.DelMapFieldResponse
+	73,  // 141: protob.BatchResult.map_field_incr:type_name -> protob.IncrMapFieldResponse
+	75,  // 142: protob.BatchResult.map_field_decr:type_name -> protob.DecrMapFieldResponse
+	89,  // 143: protob.BatchResult.queue_new:type_name -> protob.NewQueueResponse
+	91,  // 144: protob.BatchResult.queue_del:type_name -> protob.DelQueueResponse
+	95,  // 145: protob.BatchResult.queue_len:type_name -> protob.LenQueueResponse
+	97,  // 146: protob.BatchResult.queue_push:type_name -> protob.PushQueueResponse
+	103, // 147: protob.BatchResult.queue_pop:type_name -> protob.PopQueueResponse
+	111, // 148: protob.BatchResult.queue_peek:type_name -> protob.PeekQueueResponse
+	199, // 149: protob.BatchResult.error:type_name -> protob.BatchError
+	200, // 150: protob.BatchResponse.results:type_name -> protob.BatchResult
+	5,   // 151: protob.TxnGuard.location:type_name -> protob.LocationType
+	202, // 152: protob.TxnGuard.field:type_name -> protob.TxnFieldGuard
+	6,   // 153: protob.TxnOperation.kv_set:type_name -> protob.SetKVRequest
+	16,  // 154: protob.TxnOperation.kv_del:type_name -> protob.DelKVRequest
+	8,   // 155: protob.TxnOperation.kv_incr:type_name -> protob.IncrKVRequest
+	10,  // 156: protob.TxnOperation.kv_decr:type_name -> protob.DecrKVRequest
+	54,  // 157: protob.TxnOperation.map_new:type_name -> protob.NewMapRequest
+	56,  // 158: protob.TxnOperation.map_del:type_name -> protob.DelMapRequest
+	62,  // 159: protob.TxnOperation.map_field_set:type_name -> protob.SetMapFieldRequest
+	78,  // 160: protob.TxnOperation.map_field_del:type_name -> protob.DelMapFieldRequest
+	72,  // 161: protob.TxnOperation.map_field_incr:type_name -> protob.IncrMapFieldRequest
+	74,  // 162: protob.TxnOperation.map_field_decr:type_name -> protob.DecrMapFieldRequest
+	88,  // 163: protob.TxnOperation.queue_new:type_name -> protob.NewQueueRequest
+	90,  // 164: protob.TxnOperation.queue_del:type_name -> protob.DelQueueRequest
+	96,  // 165: protob.TxnOperation.queue_push:type_name -> protob.PushQueueRequest
+	102, // 166: protob.TxnOperation.queue_pop:type_name -> protob.PopQueueRequest
+	203, // 167: protob.TxnRequest.guards:type_name -> protob.TxnGuard
+	204, // 168: protob.TxnRequest.ops:type_name -> protob.TxnOperation
+	7,   // 169: protob.TxnResult.kv_set:type_name -> protob.SetKVResponse
+	17,  // 170: protob.TxnResult.kv_del:type_name -> protob.DelKVResponse
+	9,   // 171: protob.TxnResult.kv_incr:type_name -> protob.IncrKVResponse
+	11,  // 172: protob.TxnResult.kv_decr:type_name -> protob.DecrKVResponse
+	55,  // 173: protob.TxnResult.map_new:type_name -> protob.NewMapResponse
+	57,  // 174: protob.TxnResult.map_del:type_name -> protob.DelMapResponse
+	63,  // 175: protob.TxnResult.map_field_set:type_name -> protob.SetMapFieldResponse
+	79,  // 176: protob.TxnResult.map_field_del:type_name -> protob.DelMapFieldResponse
+	73,  // 177: protob.TxnResult.map_field_incr:type_name -> protob.IncrMapFieldResponse
+	75,  // 178: protob.TxnResult.map_field_decr:type_name -> protob.DecrMapFieldResponse
+	89,  // 179: protob.TxnResult.queue_new:type_name -> protob.NewQueueResponse
+	91,  // 180: protob.TxnResult.queue_del:type_name -> protob.DelQueueResponse
+	97,  // 181: protob.TxnResult.queue_push:type_name -> protob.PushQueueResponse
+	103, // 182: protob.TxnResult.queue_pop:type_name -> protob.PopQueueResponse
+	206, // 183: protob.TxnResponse.results:type_name -> protob.TxnResult
+	6,   // 184: protob.KV.Set:input_type -> protob.SetKVRequest
+	8,   // 185: protob.KV.Incr:input_type -> protob.IncrKVRequest
+	10,  // 186: protob.KV.Decr:input_type -> protob.DecrKVRequest
+	12,  // 187: protob.KV.IncrFloat:input_type -> protob.IncrFloatKVRequest
+	16,  // 188: protob.KV.Del:input_type -> protob.DelKVRequest
+	14,  // 189: protob.KV.Get:input_type -> protob.GetKVRequest
+	18,  // 190: protob.KV.CompareAndSwap:input_type -> protob.CompareAndSwapKVRequest
+	20,  // 191: protob.KV.SetIfNotExists:input_type -> protob.SetIfNotExistsKVRequest
+	22,  // 192: protob.KV.SetIfExists:input_type -> protob.SetIfExistsKVRequest
+	40,  // 193: protob.KV.TTL:input_type -> protob.TTLKVRequest
+	42,  // 194: protob.KV.Expire:input_type -> protob.ExpireKVRequest
+	44,  // 195: protob.KV.Persist:input_type -> protob.PersistKVRequest
+	46,  // 196: protob.KV.MGet:input_type -> protob.MGetKVRequest
+	50,  // 197: protob.KV.MSet:input_type -> protob.MSetKVRequest
+	52,  // 198: protob.KV.MDel:input_type -> protob.MDelKVRequest
+	24,  // 199: protob.KV.Lock:input_type -> protob.LockRequest
+	26,  // 200: protob.KV.Unlock:input_type -> protob.UnlockRequest
+	54,  // 201: protob.Map.New:input_type -> protob.NewMapRequest
+	58,  // 202: protob.Map.GetFields:input_type -> protob.GetAllMapFieldsRequest
+	60,  // 203: protob.Map.Len:input_type -> protob.LenMapRequest
+	56,  // 204: protob.Map.Del:input_type -> protob.DelMapRequest
+	62,  // 205: protob.Map.FieldSet:input_type -> protob.SetMapFieldRequest
+	64,  // 206: protob.Map.FieldSetIfNotExists:input_type -> protob.SetIfNotExistsMapFieldRequest
+	67,  // 207: protob.Map.FieldMSet:input_type -> protob.MSetMapFieldsRequest
+	69,  // 208: protob.Map.FieldMGet:input_type -> protob.MGetMapFieldsRequest
+	72,  // 209: protob.Map.FieldIncr:input_type -> protob.IncrMapFieldRequest
+	74,  // 210: protob.Map.FieldDecr:input_type -> protob.DecrMapFieldRequest
+	76,  // 211: protob.Map.FieldIncrFloat:input_type -> protob.IncrFloatMapFieldRequest
+	78,  // 212: protob.Map.FieldDel:input_type -> protob.DelMapFieldRequest
+	80,  // 213: protob.Map.FieldGet:input_type -> protob.GetMapFieldRequest
+	82,  // 214: protob.Map.TTL:input_type -> protob.TTLMapRequest
+	84,  // 215: protob.Map.Expire:input_type -> protob.ExpireMapRequest
+	86,  // 216: protob.Map.Persist:input_type -> protob.PersistMapRequest
+	24,  // 217: protob.Map.Lock:input_type -> protob.LockRequest
+	26,  // 218: protob.Map.Unlock:input_type -> protob.UnlockRequest
+	88,  // 219: protob.Queue.New:input_type -> protob.NewQueueRequest
+	92,  // 220: protob.Queue.GetAll:input_type -> protob.GetAllQueuesRequest
+	94,  // 221: protob.Queue.Len:input_type -> protob.LenQueueRequest
+	90,  // 222: protob.Queue.Del:input_type -> protob.DelQueueRequest
+	96,  // 223: protob.Queue.Push:input_type -> protob.PushQueueRequest
+	100, // 224: protob.Queue.Index:input_type -> protob.IndexQueueRequest
+	102, // 225: protob.Queue.Pop:input_type -> protob.PopQueueRequest
+	110, // 226: protob.Queue.Peek:input_type -> protob.PeekQueueRequest
+	98,  // 227: protob.Queue.PushFront:input_type -> protob.PushFrontQueueRequest
+	104, // 228: protob.Queue.PopBack:input_type -> protob.PopBackQueueRequest
+	106, // 229: protob.Queue.Range:input_type -> protob.RangeQueueRequest
+	108, // 230: protob.Queue.Trim:input_type -> protob.TrimQueueRequest
+	112, // 231: protob.Queue.BlockingPop:input_type -> protob.BlockingPopQueueRequest
+	114, // 232: protob.Queue.Reserve:input_type -> protob.ReserveQueueRequest
+	116, // 233: protob.Queue.Ack:input_type -> protob.AckQueueRequest
+	118, // 234: protob.Queue.Nack:input_type -> protob.NackQueueRequest
+	120, // 235: protob.Queue.TTL:input_type -> protob.TTLQueueRequest
+	122, // 236: protob.Queue.Expire:input_type -> protob.ExpireQueueRequest
+	124, // 237: protob.Queue.Persist:input_type -> protob.PersistQueueRequest
+	126, // 238: protob.Set.New:input_type -> protob.NewSetRequest
+	128, // 239: protob.Set.Del:input_type -> protob.DelSetRequest
+	130, // 240: protob.Set.Add:input_type -> protob.AddSetRequest
+	132, // 241: protob.Set.Remove:input_type -> protob.RemoveSetRequest
+	134, // 242: protob.Set.IsMember:input_type -> protob.IsMemberSetRequest
+	136, // 243: protob.Set.Members:input_type -> protob.MembersSetRequest
+	138, // 244: protob.Set.Card:input_type -> protob.CardSetRequest
+	140, // 245: protob.Set.Pop:input_type -> protob.PopSetRequest
+	142, // 246: protob.Set.TTL:input_type -> protob.TTLSetRequest
+	144, // 247: protob.Set.Expire:input_type -> protob.ExpireSetRequest
+	146, // 248: protob.Set.Persist:input_type -> protob.PersistSetRequest
+	149, // 249: protob.SortedSet.New:input_type -> protob.NewSortedSetRequest
+	151, // 250: protob.SortedSet.Del:input_type -> protob.DelSortedSetRequest
+	153, // 251: protob.SortedSet.Add:input_type -> protob.AddSortedSetRequest
+	155, // 252: protob.SortedSet.Incr:input_type -> protob.IncrSortedSetRequest
+	157, // 253: protob.SortedSet.RangeByScore:input_type -> protob.RangeByScoreSortedSetRequest
+	159, // 254: protob.SortedSet.RangeByRank:input_type -> protob.RangeByRankSortedSetRequest
+	161, // 255: protob.SortedSet.RemoveRangeByScore:input_type -> protob.RemoveRangeByScoreSortedSetRequest
+	163, // 256: protob.SortedSet.Card:input_type -> protob.CardSortedSetRequest
+	165, // 257: protob.SortedSet.TTL:input_type -> protob.TTLSortedSetRequest
+	167, // 258: protob.SortedSet.Expire:input_type -> protob.ExpireSortedSetRequest
+	169, // 259: protob.SortedSet.Persist:input_type -> protob.PersistSortedSetRequest
+	172, // 260: protob.Stream.New:input_type -> protob.NewStreamRequest
+	174, // 261: protob.Stream.Del:input_type -> protob.DelStreamRequest
+	176, // 262: protob.Stream.Append:input_type -> protob.AppendStreamRequest
+	178, // 263: protob.Stream.Read:input_type -> protob.ReadStreamRequest
+	180, // 264: protob.Stream.Commit:input_type -> protob.CommitStreamRequest
+	182, // 265: protob.Stream.Offset:input_type -> protob.OffsetStreamRequest
+	184, // 266: protob.Stream.TTL:input_type -> protob.TTLStreamRequest
+	186, // 267: protob.Stream.Expire:input_type -> protob.ExpireStreamRequest
+	188, // 268: protob.Stream.Persist:input_type -> protob.PersistStreamRequest
+	24,  // 269: protob.Sync.Lock:input_type -> protob.LockRequest
+	26,  // 270: protob.Sync.Unlock:input_type -> protob.UnlockRequest
+	28,  // 271: protob.Sync.RefreshLock:input_type -> protob.RefreshLockRequest
+	30,  // 272: protob.Sync.LockInfo:input_type -> protob.LockInfoRequest
+	32,  // 273: protob.Sync.RLock:input_type -> protob.RLockRequest
+	34,  // 274: protob.Sync.RUnlock:input_type -> protob.RUnlockRequest
+	36,  // 275: protob.Sync.Acquire:input_type -> protob.AcquireRequest
+	38,  // 276: protob.Sync.Release:input_type -> protob.ReleaseRequest
+	190, // 277: protob.Watch.Watch:input_type -> protob.WatchRequest
+	193, // 278: protob.Scope.Scan:input_type -> protob.ScanScopeRequest
+	195, // 279: protob.Scope.Drop:input_type -> protob.DropScopeRequest
+	198, // 280: protob.Batch.Batch:input_type -> protob.BatchRequest
+	205, // 281: protob.Txn.Txn:input_type -> protob.TxnRequest
+	7,   // 282: protob.KV.Set:output_type -> protob.SetKVResponse
+	9,   // 283: protob.KV.Incr:output_type -> protob.IncrKVResponse
+	11,  // 284: protob.KV.Decr:output_type -> protob.DecrKVResponse
+	13,  // 285: protob.KV.IncrFloat:output_type -> protob.IncrFloatKVResponse
+	17,  // 286: protob.KV.Del:output_type -> protob.DelKVResponse
+	15,  // 287: protob.KV.Get:output_type -> protob.GetKVResponse
+	19,  // 288: protob.KV.CompareAndSwap:output_type -> protob.CompareAndSwapKVResponse
+	21,  // 289: protob.KV.SetIfNotExists:output_type -> protob.SetIfNotExistsKVResponse
+	23,  // 290: protob.KV.SetIfExists:output_type -> protob.SetIfExistsKVResponse
+	41,  // 291: protob.KV.TTL:output_type -> protob.TTLKVResponse
+	43,  // 292: protob.KV.Expire:output_type -> protob.ExpireKVResponse
+	45,  // 293: protob.KV.Persist:output_type -> protob.PersistKVResponse
+	48,  // 294: protob.KV.MGet:output_type -> protob.MGetKVResponse
+	51,  // 295: protob.KV.MSet:output_type -> protob.MSetKVResponse
+	53,  // 296: protob.KV.MDel:output_type -> protob.MDelKVResponse
+	25,  // 297: protob.KV.Lock:output_type -> protob.LockResponse
+	27,  // 298: protob.KV.Unlock:output_type -> protob.UnlockResponse
+	55,  // 299: protob.Map.New:output_type -> protob.NewMapResponse
+	59,  // 300: protob.Map.GetFields:output_type -> protob.GetAllMapFieldsResponse
+	61,  // 301: protob.Map.Len:output_type -> protob.LenMapResponse
+	57,  // 302: protob.Map.Del:output_type -> protob.DelMapResponse
+	63,  // 303: protob.Map.FieldSet:output_type -> protob.SetMapFieldResponse
+	65,  // 304: protob.Map.FieldSetIfNotExists:output_type -> protob.SetIfNotExistsMapFieldResponse
+	68,  // 305: protob.Map.FieldMSet:output_type -> protob.MSetMapFieldsResponse
+	71,  // 306: protob.Map.FieldMGet:output_type -> protob.MGetMapFieldsResponse
+	73,  // 307: protob.Map.FieldIncr:output_type -> protob.IncrMapFieldResponse
+	75,  // 308: protob.Map.FieldDecr:output_type -> protob.DecrMapFieldResponse
+	77,  // 309: protob.Map.FieldIncrFloat:output_type -> protob.IncrFloatMapFieldResponse
+	79,  // 310: protob.Map.FieldDel:output_type -> protob.DelMapFieldResponse
+	81,  // 311: protob.Map.FieldGet:output_type -> protob.GetMapFieldResponse
+	83,  // 312: protob.Map.TTL:output_type -> protob.TTLMapResponse
+	85,  // 313: protob.Map.Expire:output_type -> protob.ExpireMapResponse
+	87,  // 314: protob.Map.Persist:output_type -> protob.PersistMapResponse
+	25,  // 315: protob.Map.Lock:output_type -> protob.LockResponse
+	27,  // 316: protob.Map.Unlock:output_type -> protob.UnlockResponse
+	89,  // 317: protob.Queue.New:output_type -> protob.NewQueueResponse
+	93,  // 318: protob.Queue.GetAll:output_type -> protob.GetAllQueuesResponse
+	95,  // 319: protob.Queue.Len:output_type -> protob.LenQueueResponse
+	91,  // 320: protob.Queue.Del:output_type -> protob.DelQueueResponse
+	97,  // 321: protob.Queue.Push:output_type -> protob.PushQueueResponse
+	101, // 322: protob.Queue.Index:output_type -> protob.IndexQueueResponse
+	103, // 323: protob.Queue.Pop:output_type -> protob.PopQueueResponse
+	111, // 324: protob.Queue.Peek:output_type -> protob.PeekQueueResponse
+	99,  // 325: protob.Queue.PushFront:output_type -> protob.PushFrontQueueResponse
+	105, // 326: protob.Queue.PopBack:output_type -> protob.PopBackQueueResponse
+	107, // 327: protob.Queue.Range:output_type -> protob.RangeQueueResponse
+	109, // 328: protob.Queue.Trim:output_type -> protob.TrimQueueResponse
+	113, // 329: protob.Queue.BlockingPop:output_type -> protob.BlockingPopQueueResponse
+	115, // 330: protob.Queue.Reserve:output_type -> protob.ReserveQueueResponse
+	117, // 331: protob.Queue.Ack:output_type -> protob.AckQueueResponse
+	119, // 332: protob.Queue.Nack:output_type -> protob.NackQueueResponse
+	121, // 333: protob.Queue.TTL:output_type -> protob.TTLQueueResponse
+	123, // 334: protob.Queue.Expire:output_type -> protob.ExpireQueueResponse
+	125, // 335: protob.Queue.Persist:output_type -> protob.PersistQueueResponse
+	127, // 336: protob.Set.New:output_type -> protob.NewSetResponse
+	129, // 337: protob.Set.Del:output_type -> protob.DelSetResponse
+	131, // 338: protob.Set.Add:output_type -> protob.AddSetResponse
+	133, // 339: protob.Set.Remove:output_type -> protob.RemoveSetResponse
+	135, // 340: protob.Set.IsMember:output_type -> protob.IsMemberSetResponse
+	137, // 341: protob.Set.Members:output_type -> protob.MembersSetResponse
+	139, // 342: protob.Set.Card:output_type -> protob.CardSetResponse
+	141, // 343: protob.Set.Pop:output_type -> protob.PopSetResponse
+	143, // 344: protob.Set.TTL:output_type -> protob.TTLSetResponse
+	145, // 345: protob.Set.Expire:output_type -> protob.ExpireSetResponse
+	147, // 346: protob.Set.Persist:output_type -> protob.PersistSetResponse
+	150, // 347: protob.SortedSet.New:output_type -> protob.NewSortedSetResponse
+	152, // 348: protob.SortedSet.Del:output_type -> protob.DelSortedSetResponse
+	154, // 349: protob.SortedSet.Add:output_type -> protob.AddSortedSetResponse
+	156, // 350: protob.SortedSet.Incr:output_type -> protob.IncrSortedSetResponse
+	158, // 351: protob.SortedSet.RangeByScore:output_type -> protob.RangeByScoreSortedSetResponse
+	160, // 352: protob.SortedSet.RangeByRank:output_type -> protob.RangeByRankSortedSetResponse
+	162, // 353: protob.SortedSet.RemoveRangeByScore:output_type -> protob.RemoveRangeByScoreSortedSetResponse
+	164, // 354: protob.SortedSet.Card:output_type -> protob.CardSortedSetResponse
+	166, // 355: protob.SortedSet.TTL:output_type -> protob.TTLSortedSetResponse
+	168, // 356: protob.SortedSet.Expire:output_type -> protob.ExpireSortedSetResponse
+	170, // 357: protob.SortedSet.Persist:output_type -> protob.PersistSortedSetResponse
+	173, // 358: protob.Stream.New:output_type -> protob.NewStreamResponse
+	175, // 359: protob.Stream.Del:output_type -> protob.DelStreamResponse
+	177, // 360: protob.Stream.Append:output_type -> protob.AppendStreamResponse
+	179, // 361: protob.Stream.Read:output_type -> protob.ReadStreamResponse
+	181, // 362: protob.Stream.Commit:output_type -> protob.CommitStreamResponse
+	183, // 363: protob.Stream.Offset:output_type -> protob.OffsetStreamResponse
+	185, // 364: protob.Stream.TTL:output_type -> protob.TTLStreamResponse
+	187, // 365: protob.Stream.Expire:output_type -> protob.ExpireStreamResponse
+	189, // 366: protob.Stream.Persist:output_type -> protob.PersistStreamResponse
+	25,  // 367: protob.Sync.Lock:output_type -> protob.LockResponse
+	27,  // 368: protob.Sync.Unlock:output_type -> protob.UnlockResponse
+	29,  // 369: protob.Sync.RefreshLock:output_type -> protob.RefreshLockResponse
+	31,  // 370: protob.Sync.LockInfo:output_type -> protob.LockInfoResponse
+	33,  // 371: protob.Sync.RLock:output_type -> protob.RLockResponse
+	35,  // 372: protob.Sync.RUnlock:output_type -> protob.RUnlockResponse
+	37,  // 373: protob.Sync.Acquire:output_type -> protob.AcquireResponse
+	39,  // 374: protob.Sync.Release:output_type -> protob.ReleaseResponse
+	191, // 375: protob.Watch.Watch:output_type -> protob.WatchEvent
+	194, // 376: protob.Scope.Scan:output_type -> protob.ScanScopeResponse
+	196, // 377: protob.Scope.Drop:output_type -> protob.DropScopeResponse
+	201, // 378: protob.Batch.Batch:output_type -> protob.BatchResponse
+	207, // 379: protob.Txn.Txn:output_type -> protob.TxnResponse
+	282, // [282:380] is the sub-list for method output_type
+	184, // [184:282] is the sub-list for method input_type
+	184, // [184:184] is the sub-list for extension type_name
+	184, // [184:184] is the sub-list for extension extendee
+	0,   // [0:184] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protob_eventstore_proto_init() }
+func file_pkg_protob_eventstore_proto_init() {
+	if File_pkg_protob_eventstore_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_protob_eventstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScopeType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecrKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecrKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrFloatKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrFloatKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareAndSwapKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareAndSwapKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIfNotExistsKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIfNotExistsKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIfExistsKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIfExistsKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshLockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RLockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RUnlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RUnlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MGetKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MGetKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSetKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSetKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MDelKVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MDelKVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllMapFieldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllMapFieldsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LenMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LenMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIfNotExistsMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIfNotExistsMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapFieldEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSetMapFieldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSetMapFieldsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MGetMapFieldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapFieldItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MGetMapFieldsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecrMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecrMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrFloatMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrFloatMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMapFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMapFieldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllQueuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllQueuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LenQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LenQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushFrontQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushFrontQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopBackQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopBackQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrimQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrimQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeekQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeekQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockingPopQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockingPopQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NackQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NackQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMemberSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMemberSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MembersSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MembersSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2967,8 +15607,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocationType); i {
+		file_pkg_protob_eventstore_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2979,8 +15619,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetKVRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2991,8 +15631,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetKVResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3003,8 +15643,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrKVRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3015,8 +15655,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrKVResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoredMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3027,8 +15667,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecrKVRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3039,8 +15679,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecrKVResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3051,8 +15691,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKVRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3063,8 +15703,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKVResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3075,8 +15715,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelKVRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3087,8 +15727,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelKVResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3099,8 +15739,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3111,8 +15751,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3123,8 +15763,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnlockRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeByScoreSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3135,8 +15775,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnlockResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeByScoreSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3147,8 +15787,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewMapRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeByRankSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3159,8 +15799,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewMapResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeByRankSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3171,8 +15811,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelMapRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRangeByScoreSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3183,8 +15823,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelMapResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRangeByScoreSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3195,8 +15835,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllMapFieldsRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3207,8 +15847,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllMapFieldsResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3219,8 +15859,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LenMapRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3231,8 +15871,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LenMapResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3243,8 +15883,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMapFieldRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3255,8 +15895,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMapFieldResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3267,8 +15907,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrMapFieldRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistSortedSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3279,8 +15919,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrMapFieldResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistSortedSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3291,8 +15931,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecrMapFieldRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3303,8 +15943,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecrMapFieldResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3315,8 +15955,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelMapFieldRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3327,8 +15967,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelMapFieldResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3339,8 +15979,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMapFieldRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3351,8 +15991,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMapFieldResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3363,8 +16003,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3375,8 +16015,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3387,8 +16027,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3399,8 +16039,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3411,8 +16051,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllQueuesRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3423,8 +16063,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllQueuesResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OffsetStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3435,8 +16075,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LenQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OffsetStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3447,8 +16087,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LenQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3459,8 +16099,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3471,8 +16111,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3483,8 +16123,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3495,8 +16135,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3507,8 +16147,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PopQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3519,8 +16159,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PopQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3531,8 +16171,8 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeekQueueRequest); i {
+		file_pkg_protob_eventstore_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3543,8 +16183,188 @@ func file_pkg_protob_eventstore_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_protob_eventstore_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeekQueueResponse); i {
+		file_pkg_protob_eventstore_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanScopeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanScopeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropScopeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropScopeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnFieldGuard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnGuard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_protob_eventstore_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3556,15 +16376,97 @@ func file_pkg_protob_eventstore_proto_init() {
 			}
 		}
 	}
+	file_pkg_protob_eventstore_proto_msgTypes[193].OneofWrappers = []interface{}{
+		(*BatchOperation_KvSet)(nil),
+		(*BatchOperation_KvGet)(nil),
+		(*BatchOperation_KvDel)(nil),
+		(*BatchOperation_KvIncr)(nil),
+		(*BatchOperation_KvDecr)(nil),
+		(*BatchOperation_MapNew)(nil),
+		(*BatchOperation_MapDel)(nil),
+		(*BatchOperation_MapGetFields)(nil),
+		(*BatchOperation_MapLen)(nil),
+		(*BatchOperation_MapFieldSet)(nil),
+		(*BatchOperation_MapFieldGet)(nil),
+		(*BatchOperation_MapFieldDel)(nil),
+		(*BatchOperation_MapFieldIncr)(nil),
+		(*BatchOperation_MapFieldDecr)(nil),
+		(*BatchOperation_QueueNew)(nil),
+		(*BatchOperation_QueueDel)(nil),
+		(*BatchOperation_QueueLen)(nil),
+		(*BatchOperation_QueuePush)(nil),
+		(*BatchOperation_QueuePop)(nil),
+		(*BatchOperation_QueuePeek)(nil),
+	}
+	file_pkg_protob_eventstore_proto_msgTypes[196].OneofWrappers = []interface{}{
+		(*BatchResult_KvSet)(nil),
+		(*BatchResult_KvGet)(nil),
+		(*BatchResult_KvDel)(nil),
+		(*BatchResult_KvIncr)(nil),
+		(*BatchResult_KvDecr)(nil),
+		(*BatchResult_MapNew)(nil),
+		(*BatchResult_MapDel)(nil),
+		(*BatchResult_MapGetFields)(nil),
+		(*BatchResult_MapLen)(nil),
+		(*BatchResult_MapFieldSet)(nil),
+		(*BatchResult_MapFieldGet)(nil),
+		(*BatchResult_MapFieldDel)(nil),
+		(*BatchResult_MapFieldIncr)(nil),
+		(*BatchResult_MapFieldDecr)(nil),
+		(*BatchResult_QueueNew)(nil),
+		(*BatchResult_QueueDel)(nil),
+		(*BatchResult_QueueLen)(nil),
+		(*BatchResult_QueuePush)(nil),
+		(*BatchResult_QueuePop)(nil),
+		(*BatchResult_QueuePeek)(nil),
+		(*BatchResult_Error)(nil),
+	}
+	file_pkg_protob_eventstore_proto_msgTypes[199].OneofWrappers = []interface{}{
+		(*TxnGuard_Exists)(nil),
+		(*TxnGuard_Version)(nil),
+		(*TxnGuard_Field)(nil),
+	}
+	file_pkg_protob_eventstore_proto_msgTypes[200].OneofWrappers = []interface{}{
+		(*TxnOperation_KvSet)(nil),
+		(*TxnOperation_KvDel)(nil),
+		(*TxnOperation_KvIncr)(nil),
+		(*TxnOperation_KvDecr)(nil),
+		(*TxnOperation_MapNew)(nil),
+		(*TxnOperation_MapDel)(nil),
+		(*TxnOperation_MapFieldSet)(nil),
+		(*TxnOperation_MapFieldDel)(nil),
+		(*TxnOperation_MapFieldIncr)(nil),
+		(*TxnOperation_MapFieldDecr)(nil),
+		(*TxnOperation_QueueNew)(nil),
+		(*TxnOperation_QueueDel)(nil),
+		(*TxnOperation_QueuePush)(nil),
+		(*TxnOperation_QueuePop)(nil),
+	}
+	file_pkg_protob_eventstore_proto_msgTypes[202].OneofWrappers = []interface{}{
+		(*TxnResult_KvSet)(nil),
+		(*TxnResult_KvDel)(nil),
+		(*TxnResult_KvIncr)(nil),
+		(*TxnResult_KvDecr)(nil),
+		(*TxnResult_MapNew)(nil),
+		(*TxnResult_MapDel)(nil),
+		(*TxnResult_MapFieldSet)(nil),
+		(*TxnResult_MapFieldDel)(nil),
+		(*TxnResult_MapFieldIncr)(nil),
+		(*TxnResult_MapFieldDecr)(nil),
+		(*TxnResult_QueueNew)(nil),
+		(*TxnResult_QueueDel)(nil),
+		(*TxnResult_QueuePush)(nil),
+		(*TxnResult_QueuePop)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_protob_eventstore_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   51,
+			NumEnums:      4,
+			NumMessages:   205,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   11,
 		},
 		GoTypes:           file_pkg_protob_eventstore_proto_goTypes,
 		DependencyIndexes: file_pkg_protob_eventstore_proto_depIdxs,
@@ -24,10 +24,30 @@ type KVClient interface {
 	Incr(ctx context.Context, in *IncrKVRequest, opts ...grpc.CallOption) (*IncrKVResponse, error)
 	// Decr decrements an integer value from storage
 	Decr(ctx context.Context, in *DecrKVRequest, opts ...grpc.CallOption) (*DecrKVResponse, error)
+	// IncrFloat increments a floating point value from storage
+	IncrFloat(ctx context.Context, in *IncrFloatKVRequest, opts ...grpc.CallOption) (*IncrFloatKVResponse, error)
 	// Del item from storage
 	Del(ctx context.Context, in *DelKVRequest, opts ...grpc.CallOption) (*DelKVResponse, error)
 	// Get item from storage
 	Get(ctx context.Context, in *GetKVRequest, opts ...grpc.CallOption) (*GetKVResponse, error)
+	// CompareAndSwap sets the item if its version matches
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapKVRequest, opts ...grpc.CallOption) (*CompareAndSwapKVResponse, error)
+	// SetIfNotExists sets the item if the key does not exist
+	SetIfNotExists(ctx context.Context, in *SetIfNotExistsKVRequest, opts ...grpc.CallOption) (*SetIfNotExistsKVResponse, error)
+	// SetIfExists sets the item if the key exists
+	SetIfExists(ctx context.Context, in *SetIfExistsKVRequest, opts ...grpc.CallOption) (*SetIfExistsKVResponse, error)
+	// TTL returns the remaining time to live of the item
+	TTL(ctx context.Context, in *TTLKVRequest, opts ...grpc.CallOption) (*TTLKVResponse, error)
+	// Expire sets a new time to live for the item
+	Expire(ctx context.Context, in *ExpireKVRequest, opts ...grpc.CallOption) (*ExpireKVResponse, error)
+	// Persist removes the time to live of the item
+	Persist(ctx context.Context, in *PersistKVRequest, opts ...grpc.CallOption) (*PersistKVResponse, error)
+	// MGet retrieves multiple values
+	MGet(ctx context.Context, in *MGetKVRequest, opts ...grpc.CallOption) (*MGetKVResponse, error)
+	// MSet stores multiple values
+	MSet(ctx context.Context, in *MSetKVRequest, opts ...grpc.CallOption) (*MSetKVResponse, error)
+	// MDel removes multiple values
+	MDel(ctx context.Context, in *MDelKVRequest, opts ...grpc.CallOption) (*MDelKVResponse, error)
 	// Lock the key for exclusive access
 	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
 	// Unlock the key
@@ -69,6 +89,15 @@ func (c *kVClient) Decr(ctx context.Context, in *DecrKVRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *kVClient) IncrFloat(ctx context.Context, in *IncrFloatKVRequest, opts ...grpc.CallOption) (*IncrFloatKVResponse, error) {
+	out := new(IncrFloatKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/IncrFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kVClient) Del(ctx context.Context, in *DelKVRequest, opts ...grpc.CallOption) (*DelKVResponse, error) {
 	out := new(DelKVResponse)
 	err := c.cc.Invoke(ctx, "/protob.KV/Del", in, out, opts...)
@@ -87,6 +116,87 @@ func (c *kVClient) Get(ctx context.Context, in *GetKVRequest, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *kVClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapKVRequest, opts ...grpc.CallOption) (*CompareAndSwapKVResponse, error) {
+	out := new(CompareAndSwapKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/CompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) SetIfNotExists(ctx context.Context, in *SetIfNotExistsKVRequest, opts ...grpc.CallOption) (*SetIfNotExistsKVResponse, error) {
+	out := new(SetIfNotExistsKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/SetIfNotExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) SetIfExists(ctx context.Context, in *SetIfExistsKVRequest, opts ...grpc.CallOption) (*SetIfExistsKVResponse, error) {
+	out := new(SetIfExistsKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/SetIfExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) TTL(ctx context.Context, in *TTLKVRequest, opts ...grpc.CallOption) (*TTLKVResponse, error) {
+	out := new(TTLKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/TTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) Expire(ctx context.Context, in *ExpireKVRequest, opts ...grpc.CallOption) (*ExpireKVResponse, error) {
+	out := new(ExpireKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) Persist(ctx context.Context, in *PersistKVRequest, opts ...grpc.CallOption) (*PersistKVResponse, error) {
+	out := new(PersistKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) MGet(ctx context.Context, in *MGetKVRequest, opts ...grpc.CallOption) (*MGetKVResponse, error) {
+	out := new(MGetKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/MGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) MSet(ctx context.Context, in *MSetKVRequest, opts ...grpc.CallOption) (*MSetKVResponse, error) {
+	out := new(MSetKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/MSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVClient) MDel(ctx context.Context, in *MDelKVRequest, opts ...grpc.CallOption) (*MDelKVResponse, error) {
+	out := new(MDelKVResponse)
+	err := c.cc.Invoke(ctx, "/protob.KV/MDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kVClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
 	out := new(LockResponse)
 	err := c.cc.Invoke(ctx, "/protob.KV/Lock", in, out, opts...)
@@ -115,10 +225,30 @@ type KVServer interface {
 	Incr(context.Context, *IncrKVRequest) (*IncrKVResponse, error)
 	// Decr decrements an integer value from storage
 	Decr(context.Context, *DecrKVRequest) (*DecrKVResponse, error)
+	// IncrFloat increments a floating point value from storage
+	IncrFloat(context.Context, *IncrFloatKVRequest) (*IncrFloatKVResponse, error)
 	// Del item from storage
 	Del(context.Context, *DelKVRequest) (*DelKVResponse, error)
 	// Get item from storage
 	Get(context.Context, *GetKVRequest) (*GetKVResponse, error)
+	// CompareAndSwap sets the item if its version matches
+	CompareAndSwap(context.Context, *CompareAndSwapKVRequest) (*CompareAndSwapKVResponse, error)
+	// SetIfNotExists sets the item if the key does not exist
+	SetIfNotExists(context.Context, *SetIfNotExistsKVRequest) (*SetIfNotExistsKVResponse, error)
+	// SetIfExists sets the item if the key exists
+	SetIfExists(context.Context, *SetIfExistsKVRequest) (*SetIfExistsKVResponse, error)
+	// TTL returns the remaining time to live of the item
+	TTL(context.Context, *TTLKVRequest) (*TTLKVResponse, error)
+	// Expire sets a new time to live for the item
+	Expire(context.Context, *ExpireKVRequest) (*ExpireKVResponse, error)
+	// Persist removes the time to live of the item
+	Persist(context.Context, *PersistKVRequest) (*PersistKVResponse, error)
+	// MGet retrieves multiple values
+	MGet(context.Context, *MGetKVRequest) (*MGetKVResponse, error)
+	// MSet stores multiple values
+	MSet(context.Context, *MSetKVRequest) (*MSetKVResponse, error)
+	// MDel removes multiple values
+	MDel(context.Context, *MDelKVRequest) (*MDelKVResponse, error)
 	// Lock the key for exclusive access
 	Lock(context.Context, *LockRequest) (*LockResponse, error)
 	// Unlock the key
@@ -139,12 +269,42 @@ func (UnimplementedKVServer) Incr(context.Context, *IncrKVRequest) (*IncrKVRespo
 func (UnimplementedKVServer) Decr(context.Context, *DecrKVRequest) (*DecrKVResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Decr not implemented")
 }
+func (UnimplementedKVServer) IncrFloat(context.Context, *IncrFloatKVRequest) (*IncrFloatKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrFloat not implemented")
+}
 func (UnimplementedKVServer) Del(context.Context, *DelKVRequest) (*DelKVResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
 }
 func (UnimplementedKVServer) Get(context.Context, *GetKVRequest) (*GetKVResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedKVServer) CompareAndSwap(context.Context, *CompareAndSwapKVRequest) (*CompareAndSwapKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSwap not implemented")
+}
+func (UnimplementedKVServer) SetIfNotExists(context.Context, *SetIfNotExistsKVRequest) (*SetIfNotExistsKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIfNotExists not implemented")
+}
+func (UnimplementedKVServer) SetIfExists(context.Context, *SetIfExistsKVRequest) (*SetIfExistsKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIfExists not implemented")
+}
+func (UnimplementedKVServer) TTL(context.Context, *TTLKVRequest) (*TTLKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedKVServer) Expire(context.Context, *ExpireKVRequest) (*ExpireKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedKVServer) Persist(context.Context, *PersistKVRequest) (*PersistKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedKVServer) MGet(context.Context, *MGetKVRequest) (*MGetKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MGet not implemented")
+}
+func (UnimplementedKVServer) MSet(context.Context, *MSetKVRequest) (*MSetKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSet not implemented")
+}
+func (UnimplementedKVServer) MDel(context.Context, *MDelKVRequest) (*MDelKVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MDel not implemented")
+}
 func (UnimplementedKVServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
 }
@@ -218,6 +378,24 @@ func _KV_Decr_Handler(srv interface{}, ctx context.Context, dec func(interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KV_IncrFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrFloatKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).IncrFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/IncrFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).IncrFloat(ctx, req.(*IncrFloatKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KV_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelKVRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +432,168 @@ func _KV_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{})
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KV_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/CompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).CompareAndSwap(ctx, req.(*CompareAndSwapKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_SetIfNotExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIfNotExistsKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).SetIfNotExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/SetIfNotExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).SetIfNotExists(ctx, req.(*SetIfNotExistsKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_SetIfExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIfExistsKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).SetIfExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/SetIfExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).SetIfExists(ctx, req.(*SetIfExistsKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).TTL(ctx, req.(*TTLKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).Expire(ctx, req.(*ExpireKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).Persist(ctx, req.(*PersistKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_MGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).MGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/MGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).MGet(ctx, req.(*MGetKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_MSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).MSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/MSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).MSet(ctx, req.(*MSetKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KV_MDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDelKVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).MDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.KV/MDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).MDel(ctx, req.(*MDelKVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KV_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LockRequest)
 	if err := dec(in); err != nil {
@@ -309,6 +649,10 @@ var KV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Decr",
 			Handler:    _KV_Decr_Handler,
 		},
+		{
+			MethodName: "IncrFloat",
+			Handler:    _KV_IncrFloat_Handler,
+		},
 		{
 			MethodName: "Del",
 			Handler:    _KV_Del_Handler,
@@ -317,6 +661,42 @@ var KV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _KV_Get_Handler,
 		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _KV_CompareAndSwap_Handler,
+		},
+		{
+			MethodName: "SetIfNotExists",
+			Handler:    _KV_SetIfNotExists_Handler,
+		},
+		{
+			MethodName: "SetIfExists",
+			Handler:    _KV_SetIfExists_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _KV_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _KV_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _KV_Persist_Handler,
+		},
+		{
+			MethodName: "MGet",
+			Handler:    _KV_MGet_Handler,
+		},
+		{
+			MethodName: "MSet",
+			Handler:    _KV_MSet_Handler,
+		},
+		{
+			MethodName: "MDel",
+			Handler:    _KV_MDel_Handler,
+		},
 		{
 			MethodName: "Lock",
 			Handler:    _KV_Lock_Handler,
@@ -344,14 +724,28 @@ type MapClient interface {
 	Del(ctx context.Context, in *DelMapRequest, opts ...grpc.CallOption) (*DelMapResponse, error)
 	// FieldSet KV at map
 	FieldSet(ctx context.Context, in *SetMapFieldRequest, opts ...grpc.CallOption) (*SetMapFieldResponse, error)
+	// FieldSetIfNotExists sets the field if it does not exist
+	FieldSetIfNotExists(ctx context.Context, in *SetIfNotExistsMapFieldRequest, opts ...grpc.CallOption) (*SetIfNotExistsMapFieldResponse, error)
+	// FieldMSet sets multiple fields at once
+	FieldMSet(ctx context.Context, in *MSetMapFieldsRequest, opts ...grpc.CallOption) (*MSetMapFieldsResponse, error)
+	// FieldMGet retrieves multiple field values
+	FieldMGet(ctx context.Context, in *MGetMapFieldsRequest, opts ...grpc.CallOption) (*MGetMapFieldsResponse, error)
 	// FieldIncr increments integer value at map
 	FieldIncr(ctx context.Context, in *IncrMapFieldRequest, opts ...grpc.CallOption) (*IncrMapFieldResponse, error)
 	// FieldDecr decrements integer value at map
 	FieldDecr(ctx context.Context, in *DecrMapFieldRequest, opts ...grpc.CallOption) (*DecrMapFieldResponse, error)
+	// FieldIncrFloat increments floating point value at map
+	FieldIncrFloat(ctx context.Context, in *IncrFloatMapFieldRequest, opts ...grpc.CallOption) (*IncrFloatMapFieldResponse, error)
 	// FieldDel key at map
 	FieldDel(ctx context.Context, in *DelMapFieldRequest, opts ...grpc.CallOption) (*DelMapFieldResponse, error)
 	// FieldGet value at key
 	FieldGet(ctx context.Context, in *GetMapFieldRequest, opts ...grpc.CallOption) (*GetMapFieldResponse, error)
+	// TTL returns the remaining time to live of the map
+	TTL(ctx context.Context, in *TTLMapRequest, opts ...grpc.CallOption) (*TTLMapResponse, error)
+	// Expire sets a new time to live for the map
+	Expire(ctx context.Context, in *ExpireMapRequest, opts ...grpc.CallOption) (*ExpireMapResponse, error)
+	// Persist removes the time to live of the map
+	Persist(ctx context.Context, in *PersistMapRequest, opts ...grpc.CallOption) (*PersistMapResponse, error)
 	// Lock the map for exclusive access
 	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
 	// Unlock the map
@@ -411,6 +805,33 @@ func (c *mapClient) FieldSet(ctx context.Context, in *SetMapFieldRequest, opts .
 	return out, nil
 }
 
+func (c *mapClient) FieldSetIfNotExists(ctx context.Context, in *SetIfNotExistsMapFieldRequest, opts ...grpc.CallOption) (*SetIfNotExistsMapFieldResponse, error) {
+	out := new(SetIfNotExistsMapFieldResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/FieldSetIfNotExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapClient) FieldMSet(ctx context.Context, in *MSetMapFieldsRequest, opts ...grpc.CallOption) (*MSetMapFieldsResponse, error) {
+	out := new(MSetMapFieldsResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/FieldMSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapClient) FieldMGet(ctx context.Context, in *MGetMapFieldsRequest, opts ...grpc.CallOption) (*MGetMapFieldsResponse, error) {
+	out := new(MGetMapFieldsResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/FieldMGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mapClient) FieldIncr(ctx context.Context, in *IncrMapFieldRequest, opts ...grpc.CallOption) (*IncrMapFieldResponse, error) {
 	out := new(IncrMapFieldResponse)
 	err := c.cc.Invoke(ctx, "/protob.Map/FieldIncr", in, out, opts...)
@@ -429,6 +850,15 @@ func (c *mapClient) FieldDecr(ctx context.Context, in *DecrMapFieldRequest, opts
 	return out, nil
 }
 
+func (c *mapClient) FieldIncrFloat(ctx context.Context, in *IncrFloatMapFieldRequest, opts ...grpc.CallOption) (*IncrFloatMapFieldResponse, error) {
+	out := new(IncrFloatMapFieldResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/FieldIncrFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mapClient) FieldDel(ctx context.Context, in *DelMapFieldRequest, opts ...grpc.CallOption) (*DelMapFieldResponse, error) {
 	out := new(DelMapFieldResponse)
 	err := c.cc.Invoke(ctx, "/protob.Map/FieldDel", in, out, opts...)
@@ -447,30 +877,57 @@ func (c *mapClient) FieldGet(ctx context.Context, in *GetMapFieldRequest, opts .
 	return out, nil
 }
 
-func (c *mapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
-	out := new(LockResponse)
-	err := c.cc.Invoke(ctx, "/protob.Map/Lock", in, out, opts...)
+func (c *mapClient) TTL(ctx context.Context, in *TTLMapRequest, opts ...grpc.CallOption) (*TTLMapResponse, error) {
+	out := new(TTLMapResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/TTL", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
-	out := new(UnlockResponse)
-	err := c.cc.Invoke(ctx, "/protob.Map/Unlock", in, out, opts...)
+func (c *mapClient) Expire(ctx context.Context, in *ExpireMapRequest, opts ...grpc.CallOption) (*ExpireMapResponse, error) {
+	out := new(ExpireMapResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/Expire", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MapServer is the server API for Map service.
-// All implementations must embed UnimplementedMapServer
-// for forward compatibility
-type MapServer interface {
-	// New map
-	New(context.Context, *NewMapRequest) (*NewMapResponse, error)
+func (c *mapClient) Persist(ctx context.Context, in *PersistMapRequest, opts ...grpc.CallOption) (*PersistMapResponse, error) {
+	out := new(PersistMapResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Map/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MapServer is the server API for Map service.
+// All implementations must embed UnimplementedMapServer
+// for forward compatibility
+type MapServer interface {
+	// New map
+	New(context.Context, *NewMapRequest) (*NewMapResponse, error)
 	// GetFields all KVs in map
 	GetFields(context.Context, *GetAllMapFieldsRequest) (*GetAllMapFieldsResponse, error)
 	// Len for the map
@@ -479,14 +936,28 @@ type MapServer interface {
 	Del(context.Context, *DelMapRequest) (*DelMapResponse, error)
 	// FieldSet KV at map
 	FieldSet(context.Context, *SetMapFieldRequest) (*SetMapFieldResponse, error)
+	// FieldSetIfNotExists sets the field if it does not exist
+	FieldSetIfNotExists(context.Context, *SetIfNotExistsMapFieldRequest) (*SetIfNotExistsMapFieldResponse, error)
+	// FieldMSet sets multiple fields at once
+	FieldMSet(context.Context, *MSetMapFieldsRequest) (*MSetMapFieldsResponse, error)
+	// FieldMGet retrieves multiple field values
+	FieldMGet(context.Context, *MGetMapFieldsRequest) (*MGetMapFieldsResponse, error)
 	// FieldIncr increments integer value at map
 	FieldIncr(context.Context, *IncrMapFieldRequest) (*IncrMapFieldResponse, error)
 	// FieldDecr decrements integer value at map
 	FieldDecr(context.Context, *DecrMapFieldRequest) (*DecrMapFieldResponse, error)
+	// FieldIncrFloat increments floating point value at map
+	FieldIncrFloat(context.Context, *IncrFloatMapFieldRequest) (*IncrFloatMapFieldResponse, error)
 	// FieldDel key at map
 	FieldDel(context.Context, *DelMapFieldRequest) (*DelMapFieldResponse, error)
 	// FieldGet value at key
 	FieldGet(context.Context, *GetMapFieldRequest) (*GetMapFieldResponse, error)
+	// TTL returns the remaining time to live of the map
+	TTL(context.Context, *TTLMapRequest) (*TTLMapResponse, error)
+	// Expire sets a new time to live for the map
+	Expire(context.Context, *ExpireMapRequest) (*ExpireMapResponse, error)
+	// Persist removes the time to live of the map
+	Persist(context.Context, *PersistMapRequest) (*PersistMapResponse, error)
 	// Lock the map for exclusive access
 	Lock(context.Context, *LockRequest) (*LockResponse, error)
 	// Unlock the map
@@ -513,18 +984,39 @@ func (UnimplementedMapServer) Del(context.Context, *DelMapRequest) (*DelMapRespo
 func (UnimplementedMapServer) FieldSet(context.Context, *SetMapFieldRequest) (*SetMapFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FieldSet not implemented")
 }
+func (UnimplementedMapServer) FieldSetIfNotExists(context.Context, *SetIfNotExistsMapFieldRequest) (*SetIfNotExistsMapFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FieldSetIfNotExists not implemented")
+}
+func (UnimplementedMapServer) FieldMSet(context.Context, *MSetMapFieldsRequest) (*MSetMapFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FieldMSet not implemented")
+}
+func (UnimplementedMapServer) FieldMGet(context.Context, *MGetMapFieldsRequest) (*MGetMapFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FieldMGet not implemented")
+}
 func (UnimplementedMapServer) FieldIncr(context.Context, *IncrMapFieldRequest) (*IncrMapFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FieldIncr not implemented")
 }
 func (UnimplementedMapServer) FieldDecr(context.Context, *DecrMapFieldRequest) (*DecrMapFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FieldDecr not implemented")
 }
+func (UnimplementedMapServer) FieldIncrFloat(context.Context, *IncrFloatMapFieldRequest) (*IncrFloatMapFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FieldIncrFloat not implemented")
+}
 func (UnimplementedMapServer) FieldDel(context.Context, *DelMapFieldRequest) (*DelMapFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FieldDel not implemented")
 }
 func (UnimplementedMapServer) FieldGet(context.Context, *GetMapFieldRequest) (*GetMapFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FieldGet not implemented")
 }
+func (UnimplementedMapServer) TTL(context.Context, *TTLMapRequest) (*TTLMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedMapServer) Expire(context.Context, *ExpireMapRequest) (*ExpireMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedMapServer) Persist(context.Context, *PersistMapRequest) (*PersistMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
 func (UnimplementedMapServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
 }
@@ -634,6 +1126,60 @@ func _Map_FieldSet_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Map_FieldSetIfNotExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIfNotExistsMapFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).FieldSetIfNotExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/FieldSetIfNotExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).FieldSetIfNotExists(ctx, req.(*SetIfNotExistsMapFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Map_FieldMSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetMapFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).FieldMSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/FieldMSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).FieldMSet(ctx, req.(*MSetMapFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Map_FieldMGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetMapFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).FieldMGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/FieldMGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).FieldMGet(ctx, req.(*MGetMapFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Map_FieldIncr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IncrMapFieldRequest)
 	if err := dec(in); err != nil {
@@ -670,6 +1216,24 @@ func _Map_FieldDecr_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Map_FieldIncrFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrFloatMapFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).FieldIncrFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/FieldIncrFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).FieldIncrFloat(ctx, req.(*IncrFloatMapFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Map_FieldDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelMapFieldRequest)
 	if err := dec(in); err != nil {
@@ -706,6 +1270,60 @@ func _Map_FieldGet_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Map_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).TTL(ctx, req.(*TTLMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Map_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Expire(ctx, req.(*ExpireMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Map_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Map/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Persist(ctx, req.(*PersistMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Map_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LockRequest)
 	if err := dec(in); err != nil {
@@ -769,6 +1387,18 @@ var Map_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FieldSet",
 			Handler:    _Map_FieldSet_Handler,
 		},
+		{
+			MethodName: "FieldSetIfNotExists",
+			Handler:    _Map_FieldSetIfNotExists_Handler,
+		},
+		{
+			MethodName: "FieldMSet",
+			Handler:    _Map_FieldMSet_Handler,
+		},
+		{
+			MethodName: "FieldMGet",
+			Handler:    _Map_FieldMGet_Handler,
+		},
 		{
 			MethodName: "FieldIncr",
 			Handler:    _Map_FieldIncr_Handler,
@@ -777,6 +1407,10 @@ var Map_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FieldDecr",
 			Handler:    _Map_FieldDecr_Handler,
 		},
+		{
+			MethodName: "FieldIncrFloat",
+			Handler:    _Map_FieldIncrFloat_Handler,
+		},
 		{
 			MethodName: "FieldDel",
 			Handler:    _Map_FieldDel_Handler,
@@ -785,6 +1419,18 @@ var Map_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FieldGet",
 			Handler:    _Map_FieldGet_Handler,
 		},
+		{
+			MethodName: "TTL",
+			Handler:    _Map_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _Map_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Map_Persist_Handler,
+		},
 		{
 			MethodName: "Lock",
 			Handler:    _Map_Lock_Handler,
@@ -818,6 +1464,30 @@ type QueueClient interface {
 	Pop(ctx context.Context, in *PopQueueRequest, opts ...grpc.CallOption) (*PopQueueResponse, error)
 	// Peek retrieves an element from the queue
 	Peek(ctx context.Context, in *PeekQueueRequest, opts ...grpc.CallOption) (*PeekQueueResponse, error)
+	// PushFront inserts an element at the head of the queue
+	PushFront(ctx context.Context, in *PushFrontQueueRequest, opts ...grpc.CallOption) (*PushFrontQueueResponse, error)
+	// PopBack retrieves and removes the element at the tail of the queue
+	PopBack(ctx context.Context, in *PopBackQueueRequest, opts ...grpc.CallOption) (*PopBackQueueResponse, error)
+	// Range retrieves the elements between two indexes
+	Range(ctx context.Context, in *RangeQueueRequest, opts ...grpc.CallOption) (*RangeQueueResponse, error)
+	// Trim removes the elements out of two indexes
+	Trim(ctx context.Context, in *TrimQueueRequest, opts ...grpc.CallOption) (*TrimQueueResponse, error)
+	// BlockingPop removes an element from the queue,
+	// waiting for one to be pushed when it is empty
+	BlockingPop(ctx context.Context, in *BlockingPopQueueRequest, opts ...grpc.CallOption) (*BlockingPopQueueResponse, error)
+	// Reserve hides an element from other consumers
+	// until it is acknowledged
+	Reserve(ctx context.Context, in *ReserveQueueRequest, opts ...grpc.CallOption) (*ReserveQueueResponse, error)
+	// Ack removes a reserved element
+	Ack(ctx context.Context, in *AckQueueRequest, opts ...grpc.CallOption) (*AckQueueResponse, error)
+	// Nack makes a reserved element available again
+	Nack(ctx context.Context, in *NackQueueRequest, opts ...grpc.CallOption) (*NackQueueResponse, error)
+	// TTL returns the remaining time to live of the queue
+	TTL(ctx context.Context, in *TTLQueueRequest, opts ...grpc.CallOption) (*TTLQueueResponse, error)
+	// Expire sets a new time to live for the queue
+	Expire(ctx context.Context, in *ExpireQueueRequest, opts ...grpc.CallOption) (*ExpireQueueResponse, error)
+	// Persist removes the time to live of the queue
+	Persist(ctx context.Context, in *PersistQueueRequest, opts ...grpc.CallOption) (*PersistQueueResponse, error)
 }
 
 type queueClient struct {
@@ -900,6 +1570,105 @@ func (c *queueClient) Peek(ctx context.Context, in *PeekQueueRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queueClient) PushFront(ctx context.Context, in *PushFrontQueueRequest, opts ...grpc.CallOption) (*PushFrontQueueResponse, error) {
+	out := new(PushFrontQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/PushFront", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) PopBack(ctx context.Context, in *PopBackQueueRequest, opts ...grpc.CallOption) (*PopBackQueueResponse, error) {
+	out := new(PopBackQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/PopBack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Range(ctx context.Context, in *RangeQueueRequest, opts ...grpc.CallOption) (*RangeQueueResponse, error) {
+	out := new(RangeQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Trim(ctx context.Context, in *TrimQueueRequest, opts ...grpc.CallOption) (*TrimQueueResponse, error) {
+	out := new(TrimQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Trim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) BlockingPop(ctx context.Context, in *BlockingPopQueueRequest, opts ...grpc.CallOption) (*BlockingPopQueueResponse, error) {
+	out := new(BlockingPopQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/BlockingPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Reserve(ctx context.Context, in *ReserveQueueRequest, opts ...grpc.CallOption) (*ReserveQueueResponse, error) {
+	out := new(ReserveQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Reserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Ack(ctx context.Context, in *AckQueueRequest, opts ...grpc.CallOption) (*AckQueueResponse, error) {
+	out := new(AckQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Ack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Nack(ctx context.Context, in *NackQueueRequest, opts ...grpc.CallOption) (*NackQueueResponse, error) {
+	out := new(NackQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Nack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) TTL(ctx context.Context, in *TTLQueueRequest, opts ...grpc.CallOption) (*TTLQueueResponse, error) {
+	out := new(TTLQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/TTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Expire(ctx context.Context, in *ExpireQueueRequest, opts ...grpc.CallOption) (*ExpireQueueResponse, error) {
+	out := new(ExpireQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Persist(ctx context.Context, in *PersistQueueRequest, opts ...grpc.CallOption) (*PersistQueueResponse, error) {
+	out := new(PersistQueueResponse)
+	err := c.cc.Invoke(ctx, "/protob.Queue/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServer is the server API for Queue service.
 // All implementations must embed UnimplementedQueueServer
 // for forward compatibility
@@ -920,6 +1689,30 @@ type QueueServer interface {
 	Pop(context.Context, *PopQueueRequest) (*PopQueueResponse, error)
 	// Peek retrieves an element from the queue
 	Peek(context.Context, *PeekQueueRequest) (*PeekQueueResponse, error)
+	// PushFront inserts an element at the head of the queue
+	PushFront(context.Context, *PushFrontQueueRequest) (*PushFrontQueueResponse, error)
+	// PopBack retrieves and removes the element at the tail of the queue
+	PopBack(context.Context, *PopBackQueueRequest) (*PopBackQueueResponse, error)
+	// Range retrieves the elements between two indexes
+	Range(context.Context, *RangeQueueRequest) (*RangeQueueResponse, error)
+	// Trim removes the elements out of two indexes
+	Trim(context.Context, *TrimQueueRequest) (*TrimQueueResponse, error)
+	// BlockingPop removes an element from the queue,
+	// waiting for one to be pushed when it is empty
+	BlockingPop(context.Context, *BlockingPopQueueRequest) (*BlockingPopQueueResponse, error)
+	// Reserve hides an element from other consumers
+	// until it is acknowledged
+	Reserve(context.Context, *ReserveQueueRequest) (*ReserveQueueResponse, error)
+	// Ack removes a reserved element
+	Ack(context.Context, *AckQueueRequest) (*AckQueueResponse, error)
+	// Nack makes a reserved element available again
+	Nack(context.Context, *NackQueueRequest) (*NackQueueResponse, error)
+	// TTL returns the remaining time to live of the queue
+	TTL(context.Context, *TTLQueueRequest) (*TTLQueueResponse, error)
+	// Expire sets a new time to live for the queue
+	Expire(context.Context, *ExpireQueueRequest) (*ExpireQueueResponse, error)
+	// Persist removes the time to live of the queue
+	Persist(context.Context, *PersistQueueRequest) (*PersistQueueResponse, error)
 	mustEmbedUnimplementedQueueServer()
 }
 
@@ -951,6 +1744,39 @@ func (UnimplementedQueueServer) Pop(context.Context, *PopQueueRequest) (*PopQueu
 func (UnimplementedQueueServer) Peek(context.Context, *PeekQueueRequest) (*PeekQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Peek not implemented")
 }
+func (UnimplementedQueueServer) PushFront(context.Context, *PushFrontQueueRequest) (*PushFrontQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushFront not implemented")
+}
+func (UnimplementedQueueServer) PopBack(context.Context, *PopBackQueueRequest) (*PopBackQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PopBack not implemented")
+}
+func (UnimplementedQueueServer) Range(context.Context, *RangeQueueRequest) (*RangeQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+}
+func (UnimplementedQueueServer) Trim(context.Context, *TrimQueueRequest) (*TrimQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+}
+func (UnimplementedQueueServer) BlockingPop(context.Context, *BlockingPopQueueRequest) (*BlockingPopQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingPop not implemented")
+}
+func (UnimplementedQueueServer) Reserve(context.Context, *ReserveQueueRequest) (*ReserveQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
+func (UnimplementedQueueServer) Ack(context.Context, *AckQueueRequest) (*AckQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
+}
+func (UnimplementedQueueServer) Nack(context.Context, *NackQueueRequest) (*NackQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nack not implemented")
+}
+func (UnimplementedQueueServer) TTL(context.Context, *TTLQueueRequest) (*TTLQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedQueueServer) Expire(context.Context, *ExpireQueueRequest) (*ExpireQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedQueueServer) Persist(context.Context, *PersistQueueRequest) (*PersistQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
 func (UnimplementedQueueServer) mustEmbedUnimplementedQueueServer() {}
 
 // UnsafeQueueServer may be embedded to opt out of forward compatibility for this service.
@@ -1108,170 +1934,2387 @@ func _Queue_Peek_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
-// Queue_ServiceDesc is the grpc.ServiceDesc for Queue service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Queue_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "protob.Queue",
-	HandlerType: (*QueueServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "New",
-			Handler:    _Queue_New_Handler,
-		},
-		{
-			MethodName: "GetAll",
-			Handler:    _Queue_GetAll_Handler,
-		},
-		{
-			MethodName: "Len",
-			Handler:    _Queue_Len_Handler,
-		},
-		{
-			MethodName: "Del",
-			Handler:    _Queue_Del_Handler,
-		},
-		{
-			MethodName: "Push",
-			Handler:    _Queue_Push_Handler,
-		},
-		{
-			MethodName: "Index",
-			Handler:    _Queue_Index_Handler,
-		},
-		{
-			MethodName: "Pop",
-			Handler:    _Queue_Pop_Handler,
-		},
-		{
-			MethodName: "Peek",
+func _Queue_PushFront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushFrontQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).PushFront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/PushFront",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).PushFront(ctx, req.(*PushFrontQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_PopBack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopBackQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).PopBack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/PopBack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).PopBack(ctx, req.(*PopBackQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Range(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Range(ctx, req.(*RangeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Trim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Trim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Trim(ctx, req.(*TrimQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_BlockingPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPopQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).BlockingPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/BlockingPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).BlockingPop(ctx, req.(*BlockingPopQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Reserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Reserve(ctx, req.(*ReserveQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Ack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Ack(ctx, req.(*AckQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Nack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NackQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Nack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Nack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Nack(ctx, req.(*NackQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).TTL(ctx, req.(*TTLQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Expire(ctx, req.(*ExpireQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Queue/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Persist(ctx, req.(*PersistQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Queue_ServiceDesc is the grpc.ServiceDesc for Queue service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Queue_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Queue",
+	HandlerType: (*QueueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _Queue_New_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _Queue_GetAll_Handler,
+		},
+		{
+			MethodName: "Len",
+			Handler:    _Queue_Len_Handler,
+		},
+		{
+			MethodName: "Del",
+			Handler:    _Queue_Del_Handler,
+		},
+		{
+			MethodName: "Push",
+			Handler:    _Queue_Push_Handler,
+		},
+		{
+			MethodName: "Index",
+			Handler:    _Queue_Index_Handler,
+		},
+		{
+			MethodName: "Pop",
+			Handler:    _Queue_Pop_Handler,
+		},
+		{
+			MethodName: "Peek",
 			Handler:    _Queue_Peek_Handler,
 		},
+		{
+			MethodName: "PushFront",
+			Handler:    _Queue_PushFront_Handler,
+		},
+		{
+			MethodName: "PopBack",
+			Handler:    _Queue_PopBack_Handler,
+		},
+		{
+			MethodName: "Range",
+			Handler:    _Queue_Range_Handler,
+		},
+		{
+			MethodName: "Trim",
+			Handler:    _Queue_Trim_Handler,
+		},
+		{
+			MethodName: "BlockingPop",
+			Handler:    _Queue_BlockingPop_Handler,
+		},
+		{
+			MethodName: "Reserve",
+			Handler:    _Queue_Reserve_Handler,
+		},
+		{
+			MethodName: "Ack",
+			Handler:    _Queue_Ack_Handler,
+		},
+		{
+			MethodName: "Nack",
+			Handler:    _Queue_Nack_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _Queue_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _Queue_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Queue_Persist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// SetClient is the client API for Set service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SetClient interface {
+	// New set
+	New(ctx context.Context, in *NewSetRequest, opts ...grpc.CallOption) (*NewSetResponse, error)
+	// Del set
+	Del(ctx context.Context, in *DelSetRequest, opts ...grpc.CallOption) (*DelSetResponse, error)
+	// Add members to the set
+	Add(ctx context.Context, in *AddSetRequest, opts ...grpc.CallOption) (*AddSetResponse, error)
+	// Remove members from the set
+	Remove(ctx context.Context, in *RemoveSetRequest, opts ...grpc.CallOption) (*RemoveSetResponse, error)
+	// IsMember checks whether a member is in the set
+	IsMember(ctx context.Context, in *IsMemberSetRequest, opts ...grpc.CallOption) (*IsMemberSetResponse, error)
+	// Members retrieves all members of the set
+	Members(ctx context.Context, in *MembersSetRequest, opts ...grpc.CallOption) (*MembersSetResponse, error)
+	// Card returns the number of members of the set
+	Card(ctx context.Context, in *CardSetRequest, opts ...grpc.CallOption) (*CardSetResponse, error)
+	// Pop retrieves and removes a random member of the set
+	Pop(ctx context.Context, in *PopSetRequest, opts ...grpc.CallOption) (*PopSetResponse, error)
+	// TTL returns the remaining time to live of the set
+	TTL(ctx context.Context, in *TTLSetRequest, opts ...grpc.CallOption) (*TTLSetResponse, error)
+	// Expire sets a new time to live for the set
+	Expire(ctx context.Context, in *ExpireSetRequest, opts ...grpc.CallOption) (*ExpireSetResponse, error)
+	// Persist removes the time to live of the set
+	Persist(ctx context.Context, in *PersistSetRequest, opts ...grpc.CallOption) (*PersistSetResponse, error)
+}
+
+type setClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSetClient(cc grpc.ClientConnInterface) SetClient {
+	return &setClient{cc}
+}
+
+func (c *setClient) New(ctx context.Context, in *NewSetRequest, opts ...grpc.CallOption) (*NewSetResponse, error) {
+	out := new(NewSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/New", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Del(ctx context.Context, in *DelSetRequest, opts ...grpc.CallOption) (*DelSetResponse, error) {
+	out := new(DelSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Del", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Add(ctx context.Context, in *AddSetRequest, opts ...grpc.CallOption) (*AddSetResponse, error) {
+	out := new(AddSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Remove(ctx context.Context, in *RemoveSetRequest, opts ...grpc.CallOption) (*RemoveSetResponse, error) {
+	out := new(RemoveSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) IsMember(ctx context.Context, in *IsMemberSetRequest, opts ...grpc.CallOption) (*IsMemberSetResponse, error) {
+	out := new(IsMemberSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/IsMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Members(ctx context.Context, in *MembersSetRequest, opts ...grpc.CallOption) (*MembersSetResponse, error) {
+	out := new(MembersSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Members", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Card(ctx context.Context, in *CardSetRequest, opts ...grpc.CallOption) (*CardSetResponse, error) {
+	out := new(CardSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Card", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Pop(ctx context.Context, in *PopSetRequest, opts ...grpc.CallOption) (*PopSetResponse, error) {
+	out := new(PopSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Pop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) TTL(ctx context.Context, in *TTLSetRequest, opts ...grpc.CallOption) (*TTLSetResponse, error) {
+	out := new(TTLSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/TTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Expire(ctx context.Context, in *ExpireSetRequest, opts ...grpc.CallOption) (*ExpireSetResponse, error) {
+	out := new(ExpireSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setClient) Persist(ctx context.Context, in *PersistSetRequest, opts ...grpc.CallOption) (*PersistSetResponse, error) {
+	out := new(PersistSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.Set/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetServer is the server API for Set service.
+// All implementations must embed UnimplementedSetServer
+// for forward compatibility
+type SetServer interface {
+	// New set
+	New(context.Context, *NewSetRequest) (*NewSetResponse, error)
+	// Del set
+	Del(context.Context, *DelSetRequest) (*DelSetResponse, error)
+	// Add members to the set
+	Add(context.Context, *AddSetRequest) (*AddSetResponse, error)
+	// Remove members from the set
+	Remove(context.Context, *RemoveSetRequest) (*RemoveSetResponse, error)
+	// IsMember checks whether a member is in the set
+	IsMember(context.Context, *IsMemberSetRequest) (*IsMemberSetResponse, error)
+	// Members retrieves all members of the set
+	Members(context.Context, *MembersSetRequest) (*MembersSetResponse, error)
+	// Card returns the number of members of the set
+	Card(context.Context, *CardSetRequest) (*CardSetResponse, error)
+	// Pop retrieves and removes a random member of the set
+	Pop(context.Context, *PopSetRequest) (*PopSetResponse, error)
+	// TTL returns the remaining time to live of the set
+	TTL(context.Context, *TTLSetRequest) (*TTLSetResponse, error)
+	// Expire sets a new time to live for the set
+	Expire(context.Context, *ExpireSetRequest) (*ExpireSetResponse, error)
+	// Persist removes the time to live of the set
+	Persist(context.Context, *PersistSetRequest) (*PersistSetResponse, error)
+	mustEmbedUnimplementedSetServer()
+}
+
+// UnimplementedSetServer must be embedded to have forward compatible implementations.
+type UnimplementedSetServer struct {
+}
+
+func (UnimplementedSetServer) New(context.Context, *NewSetRequest) (*NewSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method New not implemented")
+}
+func (UnimplementedSetServer) Del(context.Context, *DelSetRequest) (*DelSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
+}
+func (UnimplementedSetServer) Add(context.Context, *AddSetRequest) (*AddSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedSetServer) Remove(context.Context, *RemoveSetRequest) (*RemoveSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (UnimplementedSetServer) IsMember(context.Context, *IsMemberSetRequest) (*IsMemberSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMember not implemented")
+}
+func (UnimplementedSetServer) Members(context.Context, *MembersSetRequest) (*MembersSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Members not implemented")
+}
+func (UnimplementedSetServer) Card(context.Context, *CardSetRequest) (*CardSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Card not implemented")
+}
+func (UnimplementedSetServer) Pop(context.Context, *PopSetRequest) (*PopSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pop not implemented")
+}
+func (UnimplementedSetServer) TTL(context.Context, *TTLSetRequest) (*TTLSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedSetServer) Expire(context.Context, *ExpireSetRequest) (*ExpireSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedSetServer) Persist(context.Context, *PersistSetRequest) (*PersistSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedSetServer) mustEmbedUnimplementedSetServer() {}
+
+// UnsafeSetServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SetServer will
+// result in compilation errors.
+type UnsafeSetServer interface {
+	mustEmbedUnimplementedSetServer()
+}
+
+func RegisterSetServer(s grpc.ServiceRegistrar, srv SetServer) {
+	s.RegisterService(&Set_ServiceDesc, srv)
+}
+
+func _Set_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).New(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/New",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).New(ctx, req.(*NewSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Del(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Del",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Del(ctx, req.(*DelSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Add(ctx, req.(*AddSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Remove(ctx, req.(*RemoveSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_IsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMemberSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).IsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/IsMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).IsMember(ctx, req.(*IsMemberSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Members_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MembersSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Members(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Members",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Members(ctx, req.(*MembersSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Card_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Card(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Card",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Card(ctx, req.(*CardSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Pop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Pop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Pop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Pop(ctx, req.(*PopSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).TTL(ctx, req.(*TTLSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Expire(ctx, req.(*ExpireSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Set_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Set/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetServer).Persist(ctx, req.(*PersistSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Set_ServiceDesc is the grpc.ServiceDesc for Set service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Set_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Set",
+	HandlerType: (*SetServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _Set_New_Handler,
+		},
+		{
+			MethodName: "Del",
+			Handler:    _Set_Del_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _Set_Add_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _Set_Remove_Handler,
+		},
+		{
+			MethodName: "IsMember",
+			Handler:    _Set_IsMember_Handler,
+		},
+		{
+			MethodName: "Members",
+			Handler:    _Set_Members_Handler,
+		},
+		{
+			MethodName: "Card",
+			Handler:    _Set_Card_Handler,
+		},
+		{
+			MethodName: "Pop",
+			Handler:    _Set_Pop_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _Set_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _Set_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Set_Persist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// SortedSetClient is the client API for SortedSet service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SortedSetClient interface {
+	// New sorted set
+	New(ctx context.Context, in *NewSortedSetRequest, opts ...grpc.CallOption) (*NewSortedSetResponse, error)
+	// Del sorted set
+	Del(ctx context.Context, in *DelSortedSetRequest, opts ...grpc.CallOption) (*DelSortedSetResponse, error)
+	// Add members with their scores
+	Add(ctx context.Context, in *AddSortedSetRequest, opts ...grpc.CallOption) (*AddSortedSetResponse, error)
+	// Incr increments the score of a member
+	Incr(ctx context.Context, in *IncrSortedSetRequest, opts ...grpc.CallOption) (*IncrSortedSetResponse, error)
+	// RangeByScore retrieves the members between two scores
+	RangeByScore(ctx context.Context, in *RangeByScoreSortedSetRequest, opts ...grpc.CallOption) (*RangeByScoreSortedSetResponse, error)
+	// RangeByRank retrieves the members between two ranks
+	RangeByRank(ctx context.Context, in *RangeByRankSortedSetRequest, opts ...grpc.CallOption) (*RangeByRankSortedSetResponse, error)
+	// RemoveRangeByScore removes the members between two scores
+	RemoveRangeByScore(ctx context.Context, in *RemoveRangeByScoreSortedSetRequest, opts ...grpc.CallOption) (*RemoveRangeByScoreSortedSetResponse, error)
+	// Card returns the number of members of the sorted set
+	Card(ctx context.Context, in *CardSortedSetRequest, opts ...grpc.CallOption) (*CardSortedSetResponse, error)
+	// TTL returns the remaining time to live of the sorted set
+	TTL(ctx context.Context, in *TTLSortedSetRequest, opts ...grpc.CallOption) (*TTLSortedSetResponse, error)
+	// Expire sets a new time to live for the sorted set
+	Expire(ctx context.Context, in *ExpireSortedSetRequest, opts ...grpc.CallOption) (*ExpireSortedSetResponse, error)
+	// Persist removes the time to live of the sorted set
+	Persist(ctx context.Context, in *PersistSortedSetRequest, opts ...grpc.CallOption) (*PersistSortedSetResponse, error)
+}
+
+type sortedSetClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSortedSetClient(cc grpc.ClientConnInterface) SortedSetClient {
+	return &sortedSetClient{cc}
+}
+
+func (c *sortedSetClient) New(ctx context.Context, in *NewSortedSetRequest, opts ...grpc.CallOption) (*NewSortedSetResponse, error) {
+	out := new(NewSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/New", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) Del(ctx context.Context, in *DelSortedSetRequest, opts ...grpc.CallOption) (*DelSortedSetResponse, error) {
+	out := new(DelSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/Del", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) Add(ctx context.Context, in *AddSortedSetRequest, opts ...grpc.CallOption) (*AddSortedSetResponse, error) {
+	out := new(AddSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) Incr(ctx context.Context, in *IncrSortedSetRequest, opts ...grpc.CallOption) (*IncrSortedSetResponse, error) {
+	out := new(IncrSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/Incr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) RangeByScore(ctx context.Context, in *RangeByScoreSortedSetRequest, opts ...grpc.CallOption) (*RangeByScoreSortedSetResponse, error) {
+	out := new(RangeByScoreSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/RangeByScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) RangeByRank(ctx context.Context, in *RangeByRankSortedSetRequest, opts ...grpc.CallOption) (*RangeByRankSortedSetResponse, error) {
+	out := new(RangeByRankSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/RangeByRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) RemoveRangeByScore(ctx context.Context, in *RemoveRangeByScoreSortedSetRequest, opts ...grpc.CallOption) (*RemoveRangeByScoreSortedSetResponse, error) {
+	out := new(RemoveRangeByScoreSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/RemoveRangeByScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) Card(ctx context.Context, in *CardSortedSetRequest, opts ...grpc.CallOption) (*CardSortedSetResponse, error) {
+	out := new(CardSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/Card", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) TTL(ctx context.Context, in *TTLSortedSetRequest, opts ...grpc.CallOption) (*TTLSortedSetResponse, error) {
+	out := new(TTLSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/TTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) Expire(ctx context.Context, in *ExpireSortedSetRequest, opts ...grpc.CallOption) (*ExpireSortedSetResponse, error) {
+	out := new(ExpireSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortedSetClient) Persist(ctx context.Context, in *PersistSortedSetRequest, opts ...grpc.CallOption) (*PersistSortedSetResponse, error) {
+	out := new(PersistSortedSetResponse)
+	err := c.cc.Invoke(ctx, "/protob.SortedSet/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SortedSetServer is the server API for SortedSet service.
+// All implementations must embed UnimplementedSortedSetServer
+// for forward compatibility
+type SortedSetServer interface {
+	// New sorted set
+	New(context.Context, *NewSortedSetRequest) (*NewSortedSetResponse, error)
+	// Del sorted set
+	Del(context.Context, *DelSortedSetRequest) (*DelSortedSetResponse, error)
+	// Add members with their scores
+	Add(context.Context, *AddSortedSetRequest) (*AddSortedSetResponse, error)
+	// Incr increments the score of a member
+	Incr(context.Context, *IncrSortedSetRequest) (*IncrSortedSetResponse, error)
+	// RangeByScore retrieves the members between two scores
+	RangeByScore(context.Context, *RangeByScoreSortedSetRequest) (*RangeByScoreSortedSetResponse, error)
+	// RangeByRank retrieves the members between two ranks
+	RangeByRank(context.Context, *RangeByRankSortedSetRequest) (*RangeByRankSortedSetResponse, error)
+	// RemoveRangeByScore removes the members between two scores
+	RemoveRangeByScore(context.Context, *RemoveRangeByScoreSortedSetRequest) (*RemoveRangeByScoreSortedSetResponse, error)
+	// Card returns the number of members of the sorted set
+	Card(context.Context, *CardSortedSetRequest) (*CardSortedSetResponse, error)
+	// TTL returns the remaining time to live of the sorted set
+	TTL(context.Context, *TTLSortedSetRequest) (*TTLSortedSetResponse, error)
+	// Expire sets a new time to live for the sorted set
+	Expire(context.Context, *ExpireSortedSetRequest) (*ExpireSortedSetResponse, error)
+	// Persist removes the time to live of the sorted set
+	Persist(context.Context, *PersistSortedSetRequest) (*PersistSortedSetResponse, error)
+	mustEmbedUnimplementedSortedSetServer()
+}
+
+// UnimplementedSortedSetServer must be embedded to have forward compatible implementations.
+type UnimplementedSortedSetServer struct {
+}
+
+func (UnimplementedSortedSetServer) New(context.Context, *NewSortedSetRequest) (*NewSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method New not implemented")
+}
+func (UnimplementedSortedSetServer) Del(context.Context, *DelSortedSetRequest) (*DelSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
+}
+func (UnimplementedSortedSetServer) Add(context.Context, *AddSortedSetRequest) (*AddSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedSortedSetServer) Incr(context.Context, *IncrSortedSetRequest) (*IncrSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incr not implemented")
+}
+func (UnimplementedSortedSetServer) RangeByScore(context.Context, *RangeByScoreSortedSetRequest) (*RangeByScoreSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeByScore not implemented")
+}
+func (UnimplementedSortedSetServer) RangeByRank(context.Context, *RangeByRankSortedSetRequest) (*RangeByRankSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeByRank not implemented")
+}
+func (UnimplementedSortedSetServer) RemoveRangeByScore(context.Context, *RemoveRangeByScoreSortedSetRequest) (*RemoveRangeByScoreSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRangeByScore not implemented")
+}
+func (UnimplementedSortedSetServer) Card(context.Context, *CardSortedSetRequest) (*CardSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Card not implemented")
+}
+func (UnimplementedSortedSetServer) TTL(context.Context, *TTLSortedSetRequest) (*TTLSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedSortedSetServer) Expire(context.Context, *ExpireSortedSetRequest) (*ExpireSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedSortedSetServer) Persist(context.Context, *PersistSortedSetRequest) (*PersistSortedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedSortedSetServer) mustEmbedUnimplementedSortedSetServer() {}
+
+// UnsafeSortedSetServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SortedSetServer will
+// result in compilation errors.
+type UnsafeSortedSetServer interface {
+	mustEmbedUnimplementedSortedSetServer()
+}
+
+func RegisterSortedSetServer(s grpc.ServiceRegistrar, srv SortedSetServer) {
+	s.RegisterService(&SortedSet_ServiceDesc, srv)
+}
+
+func _SortedSet_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).New(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/New",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).New(ctx, req.(*NewSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).Del(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/Del",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).Del(ctx, req.(*DelSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).Add(ctx, req.(*AddSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_Incr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).Incr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/Incr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).Incr(ctx, req.(*IncrSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_RangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeByScoreSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).RangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/RangeByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).RangeByScore(ctx, req.(*RangeByScoreSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_RangeByRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeByRankSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).RangeByRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/RangeByRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).RangeByRank(ctx, req.(*RangeByRankSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_RemoveRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRangeByScoreSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).RemoveRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/RemoveRangeByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).RemoveRangeByScore(ctx, req.(*RemoveRangeByScoreSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_Card_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).Card(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/Card",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).Card(ctx, req.(*CardSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).TTL(ctx, req.(*TTLSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).Expire(ctx, req.(*ExpireSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortedSet_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistSortedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortedSetServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.SortedSet/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortedSetServer).Persist(ctx, req.(*PersistSortedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SortedSet_ServiceDesc is the grpc.ServiceDesc for SortedSet service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SortedSet_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.SortedSet",
+	HandlerType: (*SortedSetServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _SortedSet_New_Handler,
+		},
+		{
+			MethodName: "Del",
+			Handler:    _SortedSet_Del_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _SortedSet_Add_Handler,
+		},
+		{
+			MethodName: "Incr",
+			Handler:    _SortedSet_Incr_Handler,
+		},
+		{
+			MethodName: "RangeByScore",
+			Handler:    _SortedSet_RangeByScore_Handler,
+		},
+		{
+			MethodName: "RangeByRank",
+			Handler:    _SortedSet_RangeByRank_Handler,
+		},
+		{
+			MethodName: "RemoveRangeByScore",
+			Handler:    _SortedSet_RemoveRangeByScore_Handler,
+		},
+		{
+			MethodName: "Card",
+			Handler:    _SortedSet_Card_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _SortedSet_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _SortedSet_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _SortedSet_Persist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamClient interface {
+	// New stream
+	New(ctx context.Context, in *NewStreamRequest, opts ...grpc.CallOption) (*NewStreamResponse, error)
+	// Del stream
+	Del(ctx context.Context, in *DelStreamRequest, opts ...grpc.CallOption) (*DelStreamResponse, error)
+	// Append an entry to the stream
+	Append(ctx context.Context, in *AppendStreamRequest, opts ...grpc.CallOption) (*AppendStreamResponse, error)
+	// Read entries starting at an offset
+	Read(ctx context.Context, in *ReadStreamRequest, opts ...grpc.CallOption) (*ReadStreamResponse, error)
+	// Commit the offset of a consumer group
+	Commit(ctx context.Context, in *CommitStreamRequest, opts ...grpc.CallOption) (*CommitStreamResponse, error)
+	// Offset returns the offset committed by a consumer group
+	Offset(ctx context.Context, in *OffsetStreamRequest, opts ...grpc.CallOption) (*OffsetStreamResponse, error)
+	// TTL returns the remaining time to live of the stream
+	TTL(ctx context.Context, in *TTLStreamRequest, opts ...grpc.CallOption) (*TTLStreamResponse, error)
+	// Expire sets a new time to live for the stream
+	Expire(ctx context.Context, in *ExpireStreamRequest, opts ...grpc.CallOption) (*ExpireStreamResponse, error)
+	// Persist removes the time to live of the stream
+	Persist(ctx context.Context, in *PersistStreamRequest, opts ...grpc.CallOption) (*PersistStreamResponse, error)
+}
+
+type streamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamClient(cc grpc.ClientConnInterface) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) New(ctx context.Context, in *NewStreamRequest, opts ...grpc.CallOption) (*NewStreamResponse, error) {
+	out := new(NewStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/New", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Del(ctx context.Context, in *DelStreamRequest, opts ...grpc.CallOption) (*DelStreamResponse, error) {
+	out := new(DelStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Del", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Append(ctx context.Context, in *AppendStreamRequest, opts ...grpc.CallOption) (*AppendStreamResponse, error) {
+	out := new(AppendStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Append", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Read(ctx context.Context, in *ReadStreamRequest, opts ...grpc.CallOption) (*ReadStreamResponse, error) {
+	out := new(ReadStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Commit(ctx context.Context, in *CommitStreamRequest, opts ...grpc.CallOption) (*CommitStreamResponse, error) {
+	out := new(CommitStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Offset(ctx context.Context, in *OffsetStreamRequest, opts ...grpc.CallOption) (*OffsetStreamResponse, error) {
+	out := new(OffsetStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Offset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) TTL(ctx context.Context, in *TTLStreamRequest, opts ...grpc.CallOption) (*TTLStreamResponse, error) {
+	out := new(TTLStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/TTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Expire(ctx context.Context, in *ExpireStreamRequest, opts ...grpc.CallOption) (*ExpireStreamResponse, error) {
+	out := new(ExpireStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamClient) Persist(ctx context.Context, in *PersistStreamRequest, opts ...grpc.CallOption) (*PersistStreamResponse, error) {
+	out := new(PersistStreamResponse)
+	err := c.cc.Invoke(ctx, "/protob.Stream/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreamServer is the server API for Stream service.
+// All implementations must embed UnimplementedStreamServer
+// for forward compatibility
+type StreamServer interface {
+	// New stream
+	New(context.Context, *NewStreamRequest) (*NewStreamResponse, error)
+	// Del stream
+	Del(context.Context, *DelStreamRequest) (*DelStreamResponse, error)
+	// Append an entry to the stream
+	Append(context.Context, *AppendStreamRequest) (*AppendStreamResponse, error)
+	// Read entries starting at an offset
+	Read(context.Context, *ReadStreamRequest) (*ReadStreamResponse, error)
+	// Commit the offset of a consumer group
+	Commit(context.Context, *CommitStreamRequest) (*CommitStreamResponse, error)
+	// Offset returns the offset committed by a consumer group
+	Offset(context.Context, *OffsetStreamRequest) (*OffsetStreamResponse, error)
+	// TTL returns the remaining time to live of the stream
+	TTL(context.Context, *TTLStreamRequest) (*TTLStreamResponse, error)
+	// Expire sets a new time to live for the stream
+	Expire(context.Context, *ExpireStreamRequest) (*ExpireStreamResponse, error)
+	// Persist removes the time to live of the stream
+	Persist(context.Context, *PersistStreamRequest) (*PersistStreamResponse, error)
+	mustEmbedUnimplementedStreamServer()
+}
+
+// UnimplementedStreamServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (UnimplementedStreamServer) New(context.Context, *NewStreamRequest) (*NewStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method New not implemented")
+}
+func (UnimplementedStreamServer) Del(context.Context, *DelStreamRequest) (*DelStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
+}
+func (UnimplementedStreamServer) Append(context.Context, *AppendStreamRequest) (*AppendStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (UnimplementedStreamServer) Read(context.Context, *ReadStreamRequest) (*ReadStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedStreamServer) Commit(context.Context, *CommitStreamRequest) (*CommitStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (UnimplementedStreamServer) Offset(context.Context, *OffsetStreamRequest) (*OffsetStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offset not implemented")
+}
+func (UnimplementedStreamServer) TTL(context.Context, *TTLStreamRequest) (*TTLStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedStreamServer) Expire(context.Context, *ExpireStreamRequest) (*ExpireStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedStreamServer) Persist(context.Context, *PersistStreamRequest) (*PersistStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
+
+// UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamServer will
+// result in compilation errors.
+type UnsafeStreamServer interface {
+	mustEmbedUnimplementedStreamServer()
+}
+
+func RegisterStreamServer(s grpc.ServiceRegistrar, srv StreamServer) {
+	s.RegisterService(&Stream_ServiceDesc, srv)
+}
+
+func _Stream_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).New(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/New",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).New(ctx, req.(*NewStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Del(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Del",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Del(ctx, req.(*DelStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Append(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Append",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Append(ctx, req.(*AppendStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Read(ctx, req.(*ReadStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Commit(ctx, req.(*CommitStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Offset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffsetStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Offset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Offset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Offset(ctx, req.(*OffsetStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).TTL(ctx, req.(*TTLStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Expire(ctx, req.(*ExpireStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stream_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Stream/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamServer).Persist(ctx, req.(*PersistStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _Stream_New_Handler,
+		},
+		{
+			MethodName: "Del",
+			Handler:    _Stream_Del_Handler,
+		},
+		{
+			MethodName: "Append",
+			Handler:    _Stream_Append_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Stream_Read_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _Stream_Commit_Handler,
+		},
+		{
+			MethodName: "Offset",
+			Handler:    _Stream_Offset_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _Stream_TTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _Stream_Expire_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Stream_Persist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// SyncClient is the client API for Sync service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SyncClient interface {
+	// Lock key for exclusive access
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// Unlock key
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// RefreshLock sets a new timeout for a held lock
+	RefreshLock(ctx context.Context, in *RefreshLockRequest, opts ...grpc.CallOption) (*RefreshLockResponse, error)
+	// LockInfo describes the lock at the key
+	LockInfo(ctx context.Context, in *LockInfoRequest, opts ...grpc.CallOption) (*LockInfoResponse, error)
+	// RLock key for shared access
+	RLock(ctx context.Context, in *RLockRequest, opts ...grpc.CallOption) (*RLockResponse, error)
+	// RUnlock releases shared access to key
+	RUnlock(ctx context.Context, in *RUnlockRequest, opts ...grpc.CallOption) (*RUnlockResponse, error)
+	// Acquire permits from the semaphore at key
+	Acquire(ctx context.Context, in *AcquireRequest, opts ...grpc.CallOption) (*AcquireResponse, error)
+	// Release permits to the semaphore at key
+	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error)
+}
+
+type syncClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncClient(cc grpc.ClientConnInterface) SyncClient {
+	return &syncClient{cc}
+}
+
+func (c *syncClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) RefreshLock(ctx context.Context, in *RefreshLockRequest, opts ...grpc.CallOption) (*RefreshLockResponse, error) {
+	out := new(RefreshLockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/RefreshLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) LockInfo(ctx context.Context, in *LockInfoRequest, opts ...grpc.CallOption) (*LockInfoResponse, error) {
+	out := new(LockInfoResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/LockInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) RLock(ctx context.Context, in *RLockRequest, opts ...grpc.CallOption) (*RLockResponse, error) {
+	out := new(RLockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/RLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) RUnlock(ctx context.Context, in *RUnlockRequest, opts ...grpc.CallOption) (*RUnlockResponse, error) {
+	out := new(RUnlockResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/RUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) Acquire(ctx context.Context, in *AcquireRequest, opts ...grpc.CallOption) (*AcquireResponse, error) {
+	out := new(AcquireResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/Acquire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error) {
+	out := new(ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/protob.Sync/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyncServer is the server API for Sync service.
+// All implementations must embed UnimplementedSyncServer
+// for forward compatibility
+type SyncServer interface {
+	// Lock key for exclusive access
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// Unlock key
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// RefreshLock sets a new timeout for a held lock
+	RefreshLock(context.Context, *RefreshLockRequest) (*RefreshLockResponse, error)
+	// LockInfo describes the lock at the key
+	LockInfo(context.Context, *LockInfoRequest) (*LockInfoResponse, error)
+	// RLock key for shared access
+	RLock(context.Context, *RLockRequest) (*RLockResponse, error)
+	// RUnlock releases shared access to key
+	RUnlock(context.Context, *RUnlockRequest) (*RUnlockResponse, error)
+	// Acquire permits from the semaphore at key
+	Acquire(context.Context, *AcquireRequest) (*AcquireResponse, error)
+	// Release permits to the semaphore at key
+	Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error)
+	mustEmbedUnimplementedSyncServer()
+}
+
+// UnimplementedSyncServer must be embedded to have forward compatible implementations.
+type UnimplementedSyncServer struct {
+}
+
+func (UnimplementedSyncServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedSyncServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedSyncServer) RefreshLock(context.Context, *RefreshLockRequest) (*RefreshLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshLock not implemented")
+}
+func (UnimplementedSyncServer) LockInfo(context.Context, *LockInfoRequest) (*LockInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockInfo not implemented")
+}
+func (UnimplementedSyncServer) RLock(context.Context, *RLockRequest) (*RLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RLock not implemented")
+}
+func (UnimplementedSyncServer) RUnlock(context.Context, *RUnlockRequest) (*RUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RUnlock not implemented")
+}
+func (UnimplementedSyncServer) Acquire(context.Context, *AcquireRequest) (*AcquireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acquire not implemented")
+}
+func (UnimplementedSyncServer) Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedSyncServer) mustEmbedUnimplementedSyncServer() {}
+
+// UnsafeSyncServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncServer will
+// result in compilation errors.
+type UnsafeSyncServer interface {
+	mustEmbedUnimplementedSyncServer()
+}
+
+func RegisterSyncServer(s grpc.ServiceRegistrar, srv SyncServer) {
+	s.RegisterService(&Sync_ServiceDesc, srv)
+}
+
+func _Sync_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_RefreshLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).RefreshLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/RefreshLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).RefreshLock(ctx, req.(*RefreshLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_LockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).LockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/LockInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).LockInfo(ctx, req.(*LockInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_RLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).RLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/RLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).RLock(ctx, req.(*RLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_RUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).RUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/RUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).RUnlock(ctx, req.(*RUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_Acquire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).Acquire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/Acquire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).Acquire(ctx, req.(*AcquireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Sync/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).Release(ctx, req.(*ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Sync_ServiceDesc is the grpc.ServiceDesc for Sync service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Sync_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Sync",
+	HandlerType: (*SyncServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lock",
+			Handler:    _Sync_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Sync_Unlock_Handler,
+		},
+		{
+			MethodName: "RefreshLock",
+			Handler:    _Sync_RefreshLock_Handler,
+		},
+		{
+			MethodName: "LockInfo",
+			Handler:    _Sync_LockInfo_Handler,
+		},
+		{
+			MethodName: "RLock",
+			Handler:    _Sync_RLock_Handler,
+		},
+		{
+			MethodName: "RUnlock",
+			Handler:    _Sync_RUnlock_Handler,
+		},
+		{
+			MethodName: "Acquire",
+			Handler:    _Sync_Acquire_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Sync_Release_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/protob/eventstore.proto",
 }
 
-// SyncClient is the client API for Sync service.
+// WatchClient is the client API for Watch service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type SyncClient interface {
-	// Lock key for exclusive access
-	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
-	// Unlock key
-	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+type WatchClient interface {
+	// Watch streams changes to keys. The revision at the time the
+	// watch is placed is sent as the eventstore-revision header.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Watch_WatchClient, error)
 }
 
-type syncClient struct {
+type watchClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewSyncClient(cc grpc.ClientConnInterface) SyncClient {
-	return &syncClient{cc}
+func NewWatchClient(cc grpc.ClientConnInterface) WatchClient {
+	return &watchClient{cc}
 }
 
-func (c *syncClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
-	out := new(LockResponse)
-	err := c.cc.Invoke(ctx, "/protob.Sync/Lock", in, out, opts...)
+func (c *watchClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Watch_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Watch_ServiceDesc.Streams[0], "/protob.Watch/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Watch_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type watchWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServer is the server API for Watch service.
+// All implementations must embed UnimplementedWatchServer
+// for forward compatibility
+type WatchServer interface {
+	// Watch streams changes to keys. The revision at the time the
+	// watch is placed is sent as the eventstore-revision header.
+	Watch(*WatchRequest, Watch_WatchServer) error
+	mustEmbedUnimplementedWatchServer()
+}
+
+// UnimplementedWatchServer must be embedded to have forward compatible implementations.
+type UnimplementedWatchServer struct {
+}
+
+func (UnimplementedWatchServer) Watch(*WatchRequest, Watch_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedWatchServer) mustEmbedUnimplementedWatchServer() {}
+
+// UnsafeWatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchServer will
+// result in compilation errors.
+type UnsafeWatchServer interface {
+	mustEmbedUnimplementedWatchServer()
+}
+
+func RegisterWatchServer(s grpc.ServiceRegistrar, srv WatchServer) {
+	s.RegisterService(&Watch_ServiceDesc, srv)
+}
+
+func _Watch_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServer).Watch(m, &watchWatchServer{stream})
+}
+
+type Watch_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type watchWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Watch_ServiceDesc is the grpc.ServiceDesc for Watch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Watch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Watch",
+	HandlerType: (*WatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Watch_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// ScopeClient is the client API for Scope service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScopeClient interface {
+	// Scan keys at the scope
+	Scan(ctx context.Context, in *ScanScopeRequest, opts ...grpc.CallOption) (*ScanScopeResponse, error)
+	// Drop all keys and locks at the scope
+	Drop(ctx context.Context, in *DropScopeRequest, opts ...grpc.CallOption) (*DropScopeResponse, error)
+}
+
+type scopeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScopeClient(cc grpc.ClientConnInterface) ScopeClient {
+	return &scopeClient{cc}
+}
+
+func (c *scopeClient) Scan(ctx context.Context, in *ScanScopeRequest, opts ...grpc.CallOption) (*ScanScopeResponse, error) {
+	out := new(ScanScopeResponse)
+	err := c.cc.Invoke(ctx, "/protob.Scope/Scan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *syncClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
-	out := new(UnlockResponse)
-	err := c.cc.Invoke(ctx, "/protob.Sync/Unlock", in, out, opts...)
+func (c *scopeClient) Drop(ctx context.Context, in *DropScopeRequest, opts ...grpc.CallOption) (*DropScopeResponse, error) {
+	out := new(DropScopeResponse)
+	err := c.cc.Invoke(ctx, "/protob.Scope/Drop", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SyncServer is the server API for Sync service.
-// All implementations must embed UnimplementedSyncServer
+// ScopeServer is the server API for Scope service.
+// All implementations must embed UnimplementedScopeServer
 // for forward compatibility
-type SyncServer interface {
-	// Lock key for exclusive access
-	Lock(context.Context, *LockRequest) (*LockResponse, error)
-	// Unlock key
-	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
-	mustEmbedUnimplementedSyncServer()
+type ScopeServer interface {
+	// Scan keys at the scope
+	Scan(context.Context, *ScanScopeRequest) (*ScanScopeResponse, error)
+	// Drop all keys and locks at the scope
+	Drop(context.Context, *DropScopeRequest) (*DropScopeResponse, error)
+	mustEmbedUnimplementedScopeServer()
 }
 
-// UnimplementedSyncServer must be embedded to have forward compatible implementations.
-type UnimplementedSyncServer struct {
+// UnimplementedScopeServer must be embedded to have forward compatible implementations.
+type UnimplementedScopeServer struct {
 }
 
-func (UnimplementedSyncServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+func (UnimplementedScopeServer) Scan(context.Context, *ScanScopeRequest) (*ScanScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
-func (UnimplementedSyncServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+func (UnimplementedScopeServer) Drop(context.Context, *DropScopeRequest) (*DropScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drop not implemented")
 }
-func (UnimplementedSyncServer) mustEmbedUnimplementedSyncServer() {}
+func (UnimplementedScopeServer) mustEmbedUnimplementedScopeServer() {}
 
-// UnsafeSyncServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SyncServer will
+// UnsafeScopeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScopeServer will
 // result in compilation errors.
-type UnsafeSyncServer interface {
-	mustEmbedUnimplementedSyncServer()
+type UnsafeScopeServer interface {
+	mustEmbedUnimplementedScopeServer()
 }
 
-func RegisterSyncServer(s grpc.ServiceRegistrar, srv SyncServer) {
-	s.RegisterService(&Sync_ServiceDesc, srv)
+func RegisterScopeServer(s grpc.ServiceRegistrar, srv ScopeServer) {
+	s.RegisterService(&Scope_ServiceDesc, srv)
 }
 
-func _Sync_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockRequest)
+func _Scope_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanScopeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SyncServer).Lock(ctx, in)
+		return srv.(ScopeServer).Scan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protob.Sync/Lock",
+		FullMethod: "/protob.Scope/Scan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyncServer).Lock(ctx, req.(*LockRequest))
+		return srv.(ScopeServer).Scan(ctx, req.(*ScanScopeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Sync_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
+func _Scope_Drop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropScopeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SyncServer).Unlock(ctx, in)
+		return srv.(ScopeServer).Drop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protob.Sync/Unlock",
+		FullMethod: "/protob.Scope/Drop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyncServer).Unlock(ctx, req.(*UnlockRequest))
+		return srv.(ScopeServer).Drop(ctx, req.(*DropScopeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Sync_ServiceDesc is the grpc.ServiceDesc for Sync service.
+// Scope_ServiceDesc is the grpc.ServiceDesc for Scope service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Sync_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "protob.Sync",
-	HandlerType: (*SyncServer)(nil),
+var Scope_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Scope",
+	HandlerType: (*ScopeServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Lock",
-			Handler:    _Sync_Lock_Handler,
+			MethodName: "Scan",
+			Handler:    _Scope_Scan_Handler,
 		},
 		{
-			MethodName: "Unlock",
-			Handler:    _Sync_Unlock_Handler,
+			MethodName: "Drop",
+			Handler:    _Scope_Drop_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// BatchClient is the client API for Batch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BatchClient interface {
+	// Batch executes multiple operations in one request
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+}
+
+type batchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBatchClient(cc grpc.ClientConnInterface) BatchClient {
+	return &batchClient{cc}
+}
+
+func (c *batchClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/protob.Batch/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchServer is the server API for Batch service.
+// All implementations must embed UnimplementedBatchServer
+// for forward compatibility
+type BatchServer interface {
+	// Batch executes multiple operations in one request
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	mustEmbedUnimplementedBatchServer()
+}
+
+// UnimplementedBatchServer must be embedded to have forward compatible implementations.
+type UnimplementedBatchServer struct {
+}
+
+func (UnimplementedBatchServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedBatchServer) mustEmbedUnimplementedBatchServer() {}
+
+// UnsafeBatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BatchServer will
+// result in compilation errors.
+type UnsafeBatchServer interface {
+	mustEmbedUnimplementedBatchServer()
+}
+
+func RegisterBatchServer(s grpc.ServiceRegistrar, srv BatchServer) {
+	s.RegisterService(&Batch_ServiceDesc, srv)
+}
+
+func _Batch_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Batch/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Batch_ServiceDesc is the grpc.ServiceDesc for Batch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Batch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Batch",
+	HandlerType: (*BatchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Batch",
+			Handler:    _Batch_Batch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/protob/eventstore.proto",
+}
+
+// TxnClient is the client API for Txn service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxnClient interface {
+	// Txn applies all operations atomically when the guards hold
+	Txn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*TxnResponse, error)
+}
+
+type txnClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxnClient(cc grpc.ClientConnInterface) TxnClient {
+	return &txnClient{cc}
+}
+
+func (c *txnClient) Txn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*TxnResponse, error) {
+	out := new(TxnResponse)
+	err := c.cc.Invoke(ctx, "/protob.Txn/Txn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxnServer is the server API for Txn service.
+// All implementations must embed UnimplementedTxnServer
+// for forward compatibility
+type TxnServer interface {
+	// Txn applies all operations atomically when the guards hold
+	Txn(context.Context, *TxnRequest) (*TxnResponse, error)
+	mustEmbedUnimplementedTxnServer()
+}
+
+// UnimplementedTxnServer must be embedded to have forward compatible implementations.
+type UnimplementedTxnServer struct {
+}
+
+func (UnimplementedTxnServer) Txn(context.Context, *TxnRequest) (*TxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txn not implemented")
+}
+func (UnimplementedTxnServer) mustEmbedUnimplementedTxnServer() {}
+
+// UnsafeTxnServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxnServer will
+// result in compilation errors.
+type UnsafeTxnServer interface {
+	mustEmbedUnimplementedTxnServer()
+}
+
+func RegisterTxnServer(s grpc.ServiceRegistrar, srv TxnServer) {
+	s.RegisterService(&Txn_ServiceDesc, srv)
+}
+
+func _Txn_Txn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnServer).Txn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.Txn/Txn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnServer).Txn(ctx, req.(*TxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Txn_ServiceDesc is the grpc.ServiceDesc for Txn service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Txn_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.Txn",
+	HandlerType: (*TxnServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Txn",
+			Handler:    _Txn_Txn_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -18,6 +18,7 @@ package protob
 import (
 	"errors"
 	"fmt"
+	"math"
 )
 
 // Validate ScopeType
@@ -103,6 +104,51 @@ func (x *IncrKVRequest) Validate() error {
 
 // Validate DecrKVRequest
 func (x *DecrKVRequest) Validate() error {
+	// the decrement is applied as a negated increment
+	if x.Decr == math.MinInt64 {
+		return errors.New("decrement is out of range")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate IncrFloatKVRequest
+func (x *IncrFloatKVRequest) Validate() error {
+	if math.IsNaN(x.Incr) || math.IsInf(x.Incr, 0) {
+		return errors.New("increment needs to be a finite number")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate CompareAndSwapKVRequest
+func (x *CompareAndSwapKVRequest) Validate() error {
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+
+	if x.Version == 0 {
+		return errors.New("version needs to be informed")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate SetIfNotExistsKVRequest
+func (x *SetIfNotExistsKVRequest) Validate() error {
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate SetIfExistsKVRequest
+func (x *SetIfExistsKVRequest) Validate() error {
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+
 	return x.Location.Validate()
 }
 
@@ -111,6 +157,9 @@ func (x *LockRequest) Validate() error {
 	if x.Timeout < 0 {
 		return errors.New("timeout cannot be negative")
 	}
+	if x.Wait < 0 {
+		return errors.New("wait cannot be negative")
+	}
 
 	return x.Location.Validate()
 }
@@ -123,6 +172,60 @@ func (x *UnlockRequest) Validate() error {
 	return x.Location.Validate()
 }
 
+// Validate RefreshLockRequest
+func (x *RefreshLockRequest) Validate() error {
+	if len(x.Unlock) == 0 {
+		return errors.New("no unlock code informed")
+	}
+	if x.Timeout < 0 {
+		return errors.New("timeout cannot be negative")
+	}
+	return x.Location.Validate()
+}
+
+// Validate LockInfoRequest
+func (x *LockInfoRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate RLockRequest
+func (x *RLockRequest) Validate() error {
+	if x.Timeout < 0 {
+		return errors.New("timeout cannot be negative")
+	}
+	return x.Location.Validate()
+}
+
+// Validate RUnlockRequest
+func (x *RUnlockRequest) Validate() error {
+	if len(x.Unlock) == 0 {
+		return errors.New("no unlock code informed")
+	}
+	return x.Location.Validate()
+}
+
+// Validate AcquireRequest
+func (x *AcquireRequest) Validate() error {
+	if x.Permits <= 0 {
+		return errors.New("permits must be positive")
+	}
+	if x.Permits > x.Limit {
+		return errors.New("permits cannot exceed the limit")
+	}
+	if x.Timeout < 0 {
+		return errors.New("timeout cannot be negative")
+	}
+	return x.Location.Validate()
+}
+
+// Validate ReleaseRequest
+func (x *ReleaseRequest) Validate() error {
+	if len(x.Release) == 0 {
+		return errors.New("no release code informed")
+	}
+	return x.Location.Validate()
+}
+
 func (x *NewMapRequest) Validate() error {
 	if x.Ttl < 0 {
 		return errors.New("TTL cannot be negative")
@@ -143,6 +246,46 @@ func (x *SetMapFieldRequest) Validate() error {
 	if len(x.Field) == 0 {
 		return errors.New("no map field informed")
 	}
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+	return x.Location.Validate()
+}
+
+func (x *SetIfNotExistsMapFieldRequest) Validate() error {
+	if len(x.Field) == 0 {
+		return errors.New("no map field informed")
+	}
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+	return x.Location.Validate()
+}
+
+func (x *MSetMapFieldsRequest) Validate() error {
+	if len(x.Entries) == 0 {
+		return errors.New("no map fields informed")
+	}
+	for _, e := range x.Entries {
+		if e.GetField() == "" {
+			return errors.New("no map field informed")
+		}
+		if e.Ttl < 0 {
+			return errors.New("TTL cannot be negative")
+		}
+	}
+	return x.Location.Validate()
+}
+
+func (x *MGetMapFieldsRequest) Validate() error {
+	if len(x.Fields) == 0 {
+		return errors.New("no map fields informed")
+	}
+	for _, f := range x.Fields {
+		if f == "" {
+			return errors.New("no map field informed")
+		}
+	}
 	return x.Location.Validate()
 }
 
@@ -153,10 +296,23 @@ func (x *IncrMapFieldRequest) Validate() error {
 	return x.Location.Validate()
 }
 
+func (x *IncrFloatMapFieldRequest) Validate() error {
+	if len(x.Field) == 0 {
+		return errors.New("no map field informed")
+	}
+	if math.IsNaN(x.Incr) || math.IsInf(x.Incr, 0) {
+		return errors.New("increment needs to be a finite number")
+	}
+	return x.Location.Validate()
+}
+
 func (x *DecrMapFieldRequest) Validate() error {
 	if len(x.Field) == 0 {
 		return errors.New("no map field informed")
 	}
+	if x.Decr == math.MinInt64 {
+		return errors.New("decrement is out of range")
+	}
 	return x.Location.Validate()
 }
 
@@ -183,6 +339,14 @@ func (x *NewQueueRequest) Validate() error {
 		return errors.New("TTL cannot be negative")
 	}
 
+	if x.MaxLen < 0 {
+		return errors.New("MaxLen cannot be negative")
+	}
+
+	if _, ok := QueueOverflow_name[int32(x.Overflow)]; !ok {
+		return fmt.Errorf("unknown overflow policy %d", x.Overflow)
+	}
+
 	return x.Location.Validate()
 }
 
@@ -195,6 +359,10 @@ func (x *LenQueueRequest) Validate() error {
 }
 
 func (x *PushQueueRequest) Validate() error {
+	if x.Delay < 0 {
+		return errors.New("Delay cannot be negative")
+	}
+
 	return x.Location.Validate()
 }
 
@@ -202,10 +370,68 @@ func (x *PopQueueRequest) Validate() error {
 	return x.Location.Validate()
 }
 
+func (x *PushFrontQueueRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+func (x *PopBackQueueRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+func (x *RangeQueueRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+func (x *TrimQueueRequest) Validate() error {
+	return x.Location.Validate()
+}
+
 func (x *PeekQueueRequest) Validate() error {
 	return x.Location.Validate()
 }
 
+func (x *BlockingPopQueueRequest) Validate() error {
+	if x.Timeout < 0 {
+		return errors.New("Timeout cannot be negative")
+	}
+	return x.Location.Validate()
+}
+
+// Validate ReserveQueueRequest
+func (x *ReserveQueueRequest) Validate() error {
+	if x.Visibility <= 0 {
+		return errors.New("Visibility must be greater than zero")
+	}
+
+	if x.MaxDeliveries < 0 {
+		return errors.New("MaxDeliveries cannot be negative")
+	}
+
+	if err := x.Location.Validate(); err != nil {
+		return err
+	}
+
+	if x.DeadLetter == x.Location.Key {
+		return errors.New("dead-letter queue cannot be the reserved queue")
+	}
+
+	return nil
+}
+
+func (x *AckQueueRequest) Validate() error {
+	if x.Id == "" {
+		return errors.New("reservation id needs to be informed")
+	}
+	return x.Location.Validate()
+}
+
+func (x *NackQueueRequest) Validate() error {
+	if x.Id == "" {
+		return errors.New("reservation id needs to be informed")
+	}
+	return x.Location.Validate()
+}
+
 func (x *IndexQueueRequest) Validate() error {
 	if x.Index < 0 {
 		return errors.New("Index cannot be negative")
@@ -216,3 +442,532 @@ func (x *IndexQueueRequest) Validate() error {
 func (x *GetAllQueuesRequest) Validate() error {
 	return x.Location.Validate()
 }
+
+// Validate WatchRequest
+func (x *WatchRequest) Validate() error {
+	if x == nil {
+		return errors.New("watch request cannot be nil")
+	}
+
+	// Prefixes can be empty to watch every key at the scope.
+	if x.Prefix {
+		if x.Location == nil {
+			return errors.New("location cannot be nil")
+		}
+		return x.Location.Scope.Validate()
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate ScanScopeRequest
+func (x *ScanScopeRequest) Validate() error {
+	if x == nil {
+		return errors.New("scan request cannot be nil")
+	}
+
+	if x.Count < 0 {
+		return errors.New("count cannot be negative")
+	}
+
+	return x.Scope.Validate()
+}
+
+// Validate DropScopeRequest
+func (x *DropScopeRequest) Validate() error {
+	if x == nil {
+		return errors.New("drop request cannot be nil")
+	}
+
+	if err := x.Scope.Validate(); err != nil {
+		return err
+	}
+
+	if x.Scope.Type == ScopeChoice_Global {
+		return errors.New("global scope cannot be dropped")
+	}
+
+	return nil
+}
+
+// Validate TTLKVRequest
+func (x *TTLKVRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ExpireKVRequest
+func (x *ExpireKVRequest) Validate() error {
+	if x.Ttl <= 0 {
+		return errors.New("TTL needs to be positive")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate PersistKVRequest
+func (x *PersistKVRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate TTLMapRequest
+func (x *TTLMapRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ExpireMapRequest
+func (x *ExpireMapRequest) Validate() error {
+	if x.Ttl <= 0 {
+		return errors.New("TTL needs to be positive")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate PersistMapRequest
+func (x *PersistMapRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate TTLQueueRequest
+func (x *TTLQueueRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ExpireQueueRequest
+func (x *ExpireQueueRequest) Validate() error {
+	if x.Ttl <= 0 {
+		return errors.New("TTL needs to be positive")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate PersistQueueRequest
+func (x *PersistQueueRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate NewSetRequest
+func (x *NewSetRequest) Validate() error {
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate DelSetRequest
+func (x *DelSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate AddSetRequest
+func (x *AddSetRequest) Validate() error {
+	if err := validateMembers(x.Members); err != nil {
+		return err
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate RemoveSetRequest
+func (x *RemoveSetRequest) Validate() error {
+	if err := validateMembers(x.Members); err != nil {
+		return err
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate IsMemberSetRequest
+func (x *IsMemberSetRequest) Validate() error {
+	if len(x.Member) == 0 {
+		return errors.New("no set member informed")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate MembersSetRequest
+func (x *MembersSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate CardSetRequest
+func (x *CardSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate PopSetRequest
+func (x *PopSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate TTLSetRequest
+func (x *TTLSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ExpireSetRequest
+func (x *ExpireSetRequest) Validate() error {
+	if x.Ttl <= 0 {
+		return errors.New("TTL needs to be positive")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate PersistSetRequest
+func (x *PersistSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate NewSortedSetRequest
+func (x *NewSortedSetRequest) Validate() error {
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate DelSortedSetRequest
+func (x *DelSortedSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate AddSortedSetRequest
+func (x *AddSortedSetRequest) Validate() error {
+	if len(x.Members) == 0 {
+		return errors.New("no sorted set members informed")
+	}
+
+	for _, m := range x.Members {
+		if len(m.GetMember()) == 0 {
+			return errors.New("sorted set members cannot be empty")
+		}
+		if math.IsNaN(m.GetScore()) || math.IsInf(m.GetScore(), 0) {
+			return errors.New("score needs to be a finite number")
+		}
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate IncrSortedSetRequest
+func (x *IncrSortedSetRequest) Validate() error {
+	if len(x.Member) == 0 {
+		return errors.New("no sorted set member informed")
+	}
+	if math.IsNaN(x.Incr) || math.IsInf(x.Incr, 0) {
+		return errors.New("increment needs to be a finite number")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate RangeByScoreSortedSetRequest
+func (x *RangeByScoreSortedSetRequest) Validate() error {
+	if math.IsNaN(x.Min) || math.IsNaN(x.Max) {
+		return errors.New("score range cannot be NaN")
+	}
+	if x.Limit < 0 {
+		return errors.New("Limit cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate RangeByRankSortedSetRequest
+func (x *RangeByRankSortedSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate RemoveRangeByScoreSortedSetRequest
+func (x *RemoveRangeByScoreSortedSetRequest) Validate() error {
+	if math.IsNaN(x.Min) || math.IsNaN(x.Max) {
+		return errors.New("score range cannot be NaN")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate CardSortedSetRequest
+func (x *CardSortedSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate TTLSortedSetRequest
+func (x *TTLSortedSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ExpireSortedSetRequest
+func (x *ExpireSortedSetRequest) Validate() error {
+	if x.Ttl <= 0 {
+		return errors.New("TTL needs to be positive")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate PersistSortedSetRequest
+func (x *PersistSortedSetRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate NewStreamRequest
+func (x *NewStreamRequest) Validate() error {
+	if x.Ttl < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+	if x.MaxLen < 0 {
+		return errors.New("MaxLen cannot be negative")
+	}
+	if x.MaxAge < 0 {
+		return errors.New("MaxAge cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate DelStreamRequest
+func (x *DelStreamRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate AppendStreamRequest
+func (x *AppendStreamRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ReadStreamRequest
+func (x *ReadStreamRequest) Validate() error {
+	if x.Offset < 0 {
+		return errors.New("Offset cannot be negative")
+	}
+	if x.Limit < 0 {
+		return errors.New("Limit cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate CommitStreamRequest
+func (x *CommitStreamRequest) Validate() error {
+	if len(x.Group) == 0 {
+		return errors.New("no consumer group informed")
+	}
+	if x.Offset < 0 {
+		return errors.New("Offset cannot be negative")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate OffsetStreamRequest
+func (x *OffsetStreamRequest) Validate() error {
+	if len(x.Group) == 0 {
+		return errors.New("no consumer group informed")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate TTLStreamRequest
+func (x *TTLStreamRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+// Validate ExpireStreamRequest
+func (x *ExpireStreamRequest) Validate() error {
+	if x.Ttl <= 0 {
+		return errors.New("TTL needs to be positive")
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate PersistStreamRequest
+func (x *PersistStreamRequest) Validate() error {
+	return x.Location.Validate()
+}
+
+func validateMembers(members []string) error {
+	if len(members) == 0 {
+		return errors.New("no set members informed")
+	}
+
+	for _, m := range members {
+		if len(m) == 0 {
+			return errors.New("set members cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// Validate MGetKVRequest
+func (x *MGetKVRequest) Validate() error {
+	if x == nil {
+		return errors.New("get request cannot be nil")
+	}
+
+	if err := validateKeys(x.Keys); err != nil {
+		return err
+	}
+
+	return x.Scope.Validate()
+}
+
+// Validate MSetKVRequest
+func (x *MSetKVRequest) Validate() error {
+	if x == nil {
+		return errors.New("save request cannot be nil")
+	}
+
+	if len(x.Entries) == 0 {
+		return errors.New("no entries informed")
+	}
+
+	for _, e := range x.Entries {
+		if e.GetKey() == "" {
+			return errors.New("location key needs to be informed")
+		}
+		if e.Ttl < 0 {
+			return errors.New("TTL cannot be negative")
+		}
+	}
+
+	return x.Scope.Validate()
+}
+
+// Validate MDelKVRequest
+func (x *MDelKVRequest) Validate() error {
+	if x == nil {
+		return errors.New("delete request cannot be nil")
+	}
+
+	if err := validateKeys(x.Keys); err != nil {
+		return err
+	}
+
+	return x.Scope.Validate()
+}
+
+func validateKeys(keys []string) error {
+	if len(keys) == 0 {
+		return errors.New("no keys informed")
+	}
+
+	for _, k := range keys {
+		if k == "" {
+			return errors.New("location key needs to be informed")
+		}
+	}
+
+	return nil
+}
+
+// Validate BatchRequest. Each of the operations
+// is validated when it is executed.
+func (x *BatchRequest) Validate() error {
+	if x == nil {
+		return errors.New("batch request cannot be nil")
+	}
+
+	if len(x.Ops) == 0 {
+		return errors.New("no operations informed")
+	}
+
+	for i, op := range x.Ops {
+		if op.GetOp() == nil {
+			return fmt.Errorf("operation %d is empty", i)
+		}
+	}
+
+	return nil
+}
+
+// Validate TxnRequest
+func (x *TxnRequest) Validate() error {
+	if x == nil {
+		return errors.New("transaction request cannot be nil")
+	}
+
+	if len(x.Ops) == 0 {
+		return errors.New("no operations informed")
+	}
+
+	for i, g := range x.Guards {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("guard %d: %w", i, err)
+		}
+	}
+
+	for i, op := range x.Ops {
+		if err := op.Validate(); err != nil {
+			return fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate TxnGuard
+func (x *TxnGuard) Validate() error {
+	if x == nil {
+		return errors.New("guard cannot be nil")
+	}
+
+	switch c := x.Condition.(type) {
+	case nil:
+		return errors.New("no guard condition informed")
+	case *TxnGuard_Field:
+		if c.Field.GetField() == "" {
+			return errors.New("no map field informed")
+		}
+	}
+
+	return x.Location.Validate()
+}
+
+// Validate TxnOperation
+func (x *TxnOperation) Validate() error {
+	var r interface{ Validate() error }
+
+	switch op := x.GetOp().(type) {
+	case *TxnOperation_KvSet:
+		r = op.KvSet
+	case *TxnOperation_KvDel:
+		r = op.KvDel
+	case *TxnOperation_KvIncr:
+		r = op.KvIncr
+	case *TxnOperation_KvDecr:
+		r = op.KvDecr
+	case *TxnOperation_MapNew:
+		r = op.MapNew
+	case *TxnOperation_MapDel:
+		r = op.MapDel
+	case *TxnOperation_MapFieldSet:
+		r = op.MapFieldSet
+	case *TxnOperation_MapFieldDel:
+		r = op.MapFieldDel
+	case *TxnOperation_MapFieldIncr:
+		r = op.MapFieldIncr
+	case *TxnOperation_MapFieldDecr:
+		r = op.MapFieldDecr
+	case *TxnOperation_QueueNew:
+		r = op.QueueNew
+	case *TxnOperation_QueueDel:
+		r = op.QueueDel
+	case *TxnOperation_QueuePush:
+		r = op.QueuePush
+	case *TxnOperation_QueuePop:
+		r = op.QueuePop
+	default:
+		return errors.New("operation is empty")
+	}
+
+	return r.Validate()
+}
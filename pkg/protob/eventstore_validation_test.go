@@ -18,6 +18,7 @@ package protob
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -302,3 +303,888 @@ func TestDeleteValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchValidation(t *testing.T) {
+	testCases := map[string]struct {
+		wr       *WatchRequest
+		expected error
+	}{
+		"valid key request": {
+			wr: &WatchRequest{
+				Location: &LocationType{
+					Scope: &ScopeType{
+						Type: ScopeChoice_Global,
+					},
+					Key: "mykey",
+				},
+			},
+			expected: nil,
+		},
+
+		"valid empty prefix request": {
+			wr: &WatchRequest{
+				Location: &LocationType{
+					Scope: &ScopeType{
+						Type:   ScopeChoice_Bridge,
+						Bridge: "mybridge",
+					},
+				},
+				Prefix: true,
+			},
+			expected: nil,
+		},
+
+		"error: missing key": {
+			wr: &WatchRequest{
+				Location: &LocationType{
+					Scope: &ScopeType{
+						Type: ScopeChoice_Global,
+					},
+				},
+			},
+			expected: errors.New("location key needs to be informed"),
+		},
+
+		"error: nil prefix location": {
+			wr: &WatchRequest{
+				Prefix: true,
+			},
+			expected: errors.New("location cannot be nil"),
+		},
+
+		"error: invalid prefix scope": {
+			wr: &WatchRequest{
+				Location: &LocationType{
+					Scope: &ScopeType{
+						Type: ScopeChoice_Bridge,
+					},
+				},
+				Prefix: true,
+			},
+			expected: errors.New("bridge scope needs the bridge identifier to be informed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.wr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestCompareAndSwapValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{
+			Type: ScopeChoice_Global,
+		},
+		Key: "mykey",
+	}
+
+	testCases := map[string]struct {
+		cr       *CompareAndSwapKVRequest
+		expected error
+	}{
+		"valid request": {
+			cr: &CompareAndSwapKVRequest{
+				Location: location,
+				Version:  3,
+			},
+			expected: nil,
+		},
+
+		"error: missing version": {
+			cr: &CompareAndSwapKVRequest{
+				Location: location,
+			},
+			expected: errors.New("version needs to be informed"),
+		},
+
+		"error: negative TTL": {
+			cr: &CompareAndSwapKVRequest{
+				Location: location,
+				Ttl:      -1,
+				Version:  3,
+			},
+			expected: errors.New("TTL cannot be negative"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestScanValidation(t *testing.T) {
+	testCases := map[string]struct {
+		sr       *ScanScopeRequest
+		expected error
+	}{
+		"valid request": {
+			sr: &ScanScopeRequest{
+				Scope:  &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+				Prefix: "my",
+				Count:  10,
+			},
+			expected: nil,
+		},
+
+		"error: missing scope": {
+			sr:       &ScanScopeRequest{},
+			expected: errors.New("scope cannot be nil"),
+		},
+
+		"error: bridge scope without bridge": {
+			sr: &ScanScopeRequest{
+				Scope: &ScopeType{Type: ScopeChoice_Bridge},
+			},
+			expected: errors.New("bridge scope needs the bridge identifier to be informed"),
+		},
+
+		"error: negative count": {
+			sr: &ScanScopeRequest{
+				Scope: &ScopeType{Type: ScopeChoice_Global},
+				Count: -1,
+			},
+			expected: errors.New("count cannot be negative"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.sr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestDropScopeValidation(t *testing.T) {
+	testCases := map[string]struct {
+		dr       *DropScopeRequest
+		expected error
+	}{
+		"valid bridge request": {
+			dr: &DropScopeRequest{
+				Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+			},
+			expected: nil,
+		},
+
+		"valid instance request": {
+			dr: &DropScopeRequest{
+				Scope: &ScopeType{Type: ScopeChoice_Instance, Bridge: "mybridge", Instance: "myinstance"},
+			},
+			expected: nil,
+		},
+
+		"error: missing scope": {
+			dr:       &DropScopeRequest{},
+			expected: errors.New("scope cannot be nil"),
+		},
+
+		"error: global scope": {
+			dr: &DropScopeRequest{
+				Scope: &ScopeType{Type: ScopeChoice_Global},
+			},
+			expected: errors.New("global scope cannot be dropped"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.dr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestExpireValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{
+			Type: ScopeChoice_Global,
+		},
+		Key: "mykey",
+	}
+
+	testCases := map[string]struct {
+		er       *ExpireMapRequest
+		expected error
+	}{
+		"valid request": {
+			er: &ExpireMapRequest{
+				Location: location,
+				Ttl:      10,
+			},
+			expected: nil,
+		},
+
+		"error: missing TTL": {
+			er: &ExpireMapRequest{
+				Location: location,
+			},
+			expected: errors.New("TTL needs to be positive"),
+		},
+
+		"error: missing key": {
+			er: &ExpireMapRequest{
+				Location: &LocationType{Scope: location.Scope},
+				Ttl:      10,
+			},
+			expected: errors.New("location key needs to be informed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.er.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestIncrFloatValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{
+			Type: ScopeChoice_Global,
+		},
+		Key: "mykey",
+	}
+
+	testCases := map[string]struct {
+		ir       *IncrFloatKVRequest
+		expected error
+	}{
+		"valid request": {
+			ir: &IncrFloatKVRequest{
+				Location: location,
+				Incr:     -1.5,
+			},
+			expected: nil,
+		},
+
+		"error: NaN increment": {
+			ir: &IncrFloatKVRequest{
+				Location: location,
+				Incr:     math.NaN(),
+			},
+			expected: errors.New("increment needs to be a finite number"),
+		},
+
+		"error: infinite increment": {
+			ir: &IncrFloatKVRequest{
+				Location: location,
+				Incr:     math.Inf(-1),
+			},
+			expected: errors.New("increment needs to be a finite number"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ir.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestDecrValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{
+			Type: ScopeChoice_Global,
+		},
+		Key: "mykey",
+	}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid request": {
+			r: &DecrKVRequest{
+				Location: location,
+				Decr:     math.MaxInt64,
+			},
+			expected: nil,
+		},
+
+		"error: minimum decrement": {
+			r: &DecrKVRequest{
+				Location: location,
+				Decr:     math.MinInt64,
+			},
+			expected: errors.New("decrement is out of range"),
+		},
+
+		"error: minimum map field decrement": {
+			r: &DecrMapFieldRequest{
+				Location: location,
+				Field:    "myfield",
+				Decr:     math.MinInt64,
+			},
+			expected: errors.New("decrement is out of range"),
+		},
+
+		"error: minimum decrement at transaction": {
+			r: &TxnOperation{
+				Op: &TxnOperation_KvDecr{KvDecr: &DecrKVRequest{
+					Location: location,
+					Decr:     math.MinInt64,
+				}},
+			},
+			expected: errors.New("decrement is out of range"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestMultiKeyValidation(t *testing.T) {
+	scope := &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid get request": {
+			r:        &MGetKVRequest{Scope: scope, Keys: []string{"a", "b"}},
+			expected: nil,
+		},
+
+		"valid set request": {
+			r: &MSetKVRequest{Scope: scope, Entries: []*KVEntry{
+				{Key: "a", Ttl: 10},
+				{Key: "b"},
+			}},
+			expected: nil,
+		},
+
+		"error: no keys": {
+			r:        &MDelKVRequest{Scope: scope},
+			expected: errors.New("no keys informed"),
+		},
+
+		"error: empty key": {
+			r:        &MGetKVRequest{Scope: scope, Keys: []string{"a", ""}},
+			expected: errors.New("location key needs to be informed"),
+		},
+
+		"error: missing scope": {
+			r:        &MDelKVRequest{Keys: []string{"a"}},
+			expected: errors.New("scope cannot be nil"),
+		},
+
+		"error: no entries": {
+			r:        &MSetKVRequest{Scope: scope},
+			expected: errors.New("no entries informed"),
+		},
+
+		"error: negative TTL": {
+			r: &MSetKVRequest{Scope: scope, Entries: []*KVEntry{
+				{Key: "a", Ttl: -1},
+			}},
+			expected: errors.New("TTL cannot be negative"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestBatchValidation(t *testing.T) {
+	testCases := map[string]struct {
+		br       *BatchRequest
+		expected error
+	}{
+		"valid request": {
+			br: &BatchRequest{Ops: []*BatchOperation{
+				{Op: &BatchOperation_KvGet{KvGet: &GetKVRequest{}}},
+			}},
+			expected: nil,
+		},
+
+		"error: no operations": {
+			br:       &BatchRequest{},
+			expected: errors.New("no operations informed"),
+		},
+
+		"error: empty operation": {
+			br: &BatchRequest{Ops: []*BatchOperation{
+				{Op: &BatchOperation_KvGet{KvGet: &GetKVRequest{}}},
+				{},
+			}},
+			expected: errors.New("operation 1 is empty"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.br.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestTxnValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+	pop := &TxnOperation{Op: &TxnOperation_QueuePop{QueuePop: &PopQueueRequest{Location: location}}}
+
+	testCases := map[string]struct {
+		tr       *TxnRequest
+		expected string
+	}{
+		"valid request": {
+			tr: &TxnRequest{
+				Guards: []*TxnGuard{
+					{Location: location, Condition: &TxnGuard_Exists{Exists: false}},
+					{Location: location, Condition: &TxnGuard_Field{Field: &TxnFieldGuard{Field: "f"}}},
+				},
+				Ops: []*TxnOperation{pop},
+			},
+		},
+
+		"error: no operations": {
+			tr:       &TxnRequest{},
+			expected: "no operations informed",
+		},
+
+		"error: guard without condition": {
+			tr: &TxnRequest{
+				Guards: []*TxnGuard{{Location: location}},
+				Ops:    []*TxnOperation{pop},
+			},
+			expected: "guard 0: no guard condition informed",
+		},
+
+		"error: field guard without field": {
+			tr: &TxnRequest{
+				Guards: []*TxnGuard{
+					{Location: location, Condition: &TxnGuard_Field{Field: &TxnFieldGuard{}}},
+				},
+				Ops: []*TxnOperation{pop},
+			},
+			expected: "guard 0: no map field informed",
+		},
+
+		"error: empty operation": {
+			tr: &TxnRequest{
+				Ops: []*TxnOperation{pop, {}},
+			},
+			expected: "operation 1: operation is empty",
+		},
+
+		"error: invalid operation": {
+			tr: &TxnRequest{
+				Ops: []*TxnOperation{
+					{Op: &TxnOperation_KvSet{KvSet: &SetKVRequest{Location: location, Ttl: -1}}},
+				},
+			},
+			expected: "operation 0: TTL cannot be negative",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.tr.Validate()
+			if tc.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func TestReserveValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		rr       *ReserveQueueRequest
+		expected error
+	}{
+		"valid request": {
+			rr:       &ReserveQueueRequest{Location: location, Visibility: 30, MaxDeliveries: 3, DeadLetter: "failed"},
+			expected: nil,
+		},
+
+		"error: no visibility": {
+			rr:       &ReserveQueueRequest{Location: location},
+			expected: errors.New("Visibility must be greater than zero"),
+		},
+
+		"error: negative max deliveries": {
+			rr:       &ReserveQueueRequest{Location: location, Visibility: 30, MaxDeliveries: -1},
+			expected: errors.New("MaxDeliveries cannot be negative"),
+		},
+
+		"error: dead-letter at the same key": {
+			rr:       &ReserveQueueRequest{Location: location, Visibility: 30, DeadLetter: "mykey"},
+			expected: errors.New("dead-letter queue cannot be the reserved queue"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.rr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestNewQueueValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		nr       *NewQueueRequest
+		expected error
+	}{
+		"valid request": {
+			nr:       &NewQueueRequest{Location: location, MaxLen: 10, Overflow: QueueOverflow_OverflowDropOldest},
+			expected: nil,
+		},
+
+		"error: negative max length": {
+			nr:       &NewQueueRequest{Location: location, MaxLen: -1},
+			expected: errors.New("MaxLen cannot be negative"),
+		},
+
+		"error: unknown overflow policy": {
+			nr:       &NewQueueRequest{Location: location, MaxLen: 10, Overflow: 5},
+			expected: errors.New("unknown overflow policy 5"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.nr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestPushQueueValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		pr       *PushQueueRequest
+		expected error
+	}{
+		"valid request": {
+			pr:       &PushQueueRequest{Location: location, Priority: -5, Delay: 10},
+			expected: nil,
+		},
+
+		"error: negative delay": {
+			pr:       &PushQueueRequest{Location: location, Delay: -1},
+			expected: errors.New("Delay cannot be negative"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.pr.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestSetMembersValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Instance, Bridge: "mybridge", Instance: "myinstance"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid add": {
+			r:        &AddSetRequest{Location: location, Members: []string{"a", "b"}},
+			expected: nil,
+		},
+
+		"error: add without members": {
+			r:        &AddSetRequest{Location: location},
+			expected: errors.New("no set members informed"),
+		},
+
+		"error: remove empty member": {
+			r:        &RemoveSetRequest{Location: location, Members: []string{"a", ""}},
+			expected: errors.New("set members cannot be empty"),
+		},
+
+		"error: empty member check": {
+			r:        &IsMemberSetRequest{Location: location},
+			expected: errors.New("no set member informed"),
+		},
+
+		"error: add without location": {
+			r:        &AddSetRequest{Members: []string{"a"}},
+			expected: errors.New("location cannot be nil"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestSortedSetValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Instance, Bridge: "mybridge", Instance: "myinstance"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid add": {
+			r:        &AddSortedSetRequest{Location: location, Members: []*ScoredMember{{Member: "a", Score: -1.5}}},
+			expected: nil,
+		},
+
+		"error: add without members": {
+			r:        &AddSortedSetRequest{Location: location},
+			expected: errors.New("no sorted set members informed"),
+		},
+
+		"error: add infinite score": {
+			r:        &AddSortedSetRequest{Location: location, Members: []*ScoredMember{{Member: "a", Score: math.Inf(1)}}},
+			expected: errors.New("score needs to be a finite number"),
+		},
+
+		"valid unbounded range": {
+			r:        &RangeByScoreSortedSetRequest{Location: location, Min: math.Inf(-1), Max: math.Inf(1)},
+			expected: nil,
+		},
+
+		"error: NaN range": {
+			r:        &RemoveRangeByScoreSortedSetRequest{Location: location, Min: math.NaN()},
+			expected: errors.New("score range cannot be NaN"),
+		},
+
+		"error: negative limit": {
+			r:        &RangeByScoreSortedSetRequest{Location: location, Limit: -1},
+			expected: errors.New("Limit cannot be negative"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestStreamValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid new": {
+			r:        &NewStreamRequest{Location: location, MaxLen: 100, MaxAge: 60},
+			expected: nil,
+		},
+
+		"error: new negative max age": {
+			r:        &NewStreamRequest{Location: location, MaxAge: -1},
+			expected: errors.New("MaxAge cannot be negative"),
+		},
+
+		"valid read": {
+			r:        &ReadStreamRequest{Location: location, Offset: 10, Limit: 5},
+			expected: nil,
+		},
+
+		"error: read negative offset": {
+			r:        &ReadStreamRequest{Location: location, Offset: -1},
+			expected: errors.New("Offset cannot be negative"),
+		},
+
+		"valid commit": {
+			r:        &CommitStreamRequest{Location: location, Group: "indexer", Offset: 11},
+			expected: nil,
+		},
+
+		"error: commit without group": {
+			r:        &CommitStreamRequest{Location: location, Offset: 11},
+			expected: errors.New("no consumer group informed"),
+		},
+
+		"error: offset without group": {
+			r:        &OffsetStreamRequest{Location: location},
+			expected: errors.New("no consumer group informed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestMapFieldsValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid set with TTL": {
+			r:        &SetMapFieldRequest{Location: location, Field: "f", Ttl: 60},
+			expected: nil,
+		},
+
+		"error: set negative TTL": {
+			r:        &SetMapFieldRequest{Location: location, Field: "f", Ttl: -1},
+			expected: errors.New("TTL cannot be negative"),
+		},
+
+		"error: set if not exists without field": {
+			r:        &SetIfNotExistsMapFieldRequest{Location: location},
+			expected: errors.New("no map field informed"),
+		},
+
+		"valid mset": {
+			r: &MSetMapFieldsRequest{Location: location, Entries: []*MapFieldEntry{
+				{Field: "a", Value: []byte("1")},
+				{Field: "b", Value: []byte("2"), Ttl: 10},
+			}},
+			expected: nil,
+		},
+
+		"error: mset without entries": {
+			r:        &MSetMapFieldsRequest{Location: location},
+			expected: errors.New("no map fields informed"),
+		},
+
+		"error: mset negative TTL": {
+			r:        &MSetMapFieldsRequest{Location: location, Entries: []*MapFieldEntry{{Field: "a", Ttl: -1}}},
+			expected: errors.New("TTL cannot be negative"),
+		},
+
+		"valid mget": {
+			r:        &MGetMapFieldsRequest{Location: location, Fields: []string{"a", "b"}},
+			expected: nil,
+		},
+
+		"error: mget empty field": {
+			r:        &MGetMapFieldsRequest{Location: location, Fields: []string{"a", ""}},
+			expected: errors.New("no map field informed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestLockValidation(t *testing.T) {
+	location := &LocationType{
+		Scope: &ScopeType{Type: ScopeChoice_Bridge, Bridge: "mybridge"},
+		Key:   "mykey",
+	}
+
+	testCases := map[string]struct {
+		r        interface{ Validate() error }
+		expected error
+	}{
+		"valid lock with wait": {
+			r:        &LockRequest{Location: location, Timeout: 10, Wait: 5},
+			expected: nil,
+		},
+
+		"error: negative timeout": {
+			r:        &LockRequest{Location: location, Timeout: -1},
+			expected: errors.New("timeout cannot be negative"),
+		},
+
+		"error: negative wait": {
+			r:        &LockRequest{Location: location, Wait: -1},
+			expected: errors.New("wait cannot be negative"),
+		},
+
+		"error: refresh without unlock code": {
+			r:        &RefreshLockRequest{Location: location, Timeout: 10},
+			expected: errors.New("no unlock code informed"),
+		},
+
+		"valid rlock": {
+			r:        &RLockRequest{Location: location, Timeout: 10},
+			expected: nil,
+		},
+
+		"error: runlock without unlock code": {
+			r:        &RUnlockRequest{Location: location},
+			expected: errors.New("no unlock code informed"),
+		},
+
+		"valid acquire": {
+			r:        &AcquireRequest{Location: location, Permits: 2, Limit: 5, Timeout: 30},
+			expected: nil,
+		},
+
+		"error: acquire without permits": {
+			r:        &AcquireRequest{Location: location, Limit: 5},
+			expected: errors.New("permits must be positive"),
+		},
+
+		"error: acquire over the limit": {
+			r:        &AcquireRequest{Location: location, Permits: 6, Limit: 5},
+			expected: errors.New("permits cannot exceed the limit"),
+		},
+
+		"error: release without release code": {
+			r:        &ReleaseRequest{Location: location},
+			expected: errors.New("no release code informed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.Validate()
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
@@ -145,6 +145,46 @@ func (c *client) Decr(ctx context.Context, in *protob.DecrKVRequest, opts ...grp
 	return nil, errors.New("not implemented")
 }
 
+func (c *client) IncrFloat(ctx context.Context, in *protob.IncrFloatKVRequest, opts ...grpc.CallOption) (*protob.IncrFloatKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) CompareAndSwap(ctx context.Context, in *protob.CompareAndSwapKVRequest, opts ...grpc.CallOption) (*protob.CompareAndSwapKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) SetIfNotExists(ctx context.Context, in *protob.SetIfNotExistsKVRequest, opts ...grpc.CallOption) (*protob.SetIfNotExistsKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) SetIfExists(ctx context.Context, in *protob.SetIfExistsKVRequest, opts ...grpc.CallOption) (*protob.SetIfExistsKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) TTL(ctx context.Context, in *protob.TTLKVRequest, opts ...grpc.CallOption) (*protob.TTLKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) Expire(ctx context.Context, in *protob.ExpireKVRequest, opts ...grpc.CallOption) (*protob.ExpireKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) Persist(ctx context.Context, in *protob.PersistKVRequest, opts ...grpc.CallOption) (*protob.PersistKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) MGet(ctx context.Context, in *protob.MGetKVRequest, opts ...grpc.CallOption) (*protob.MGetKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) MSet(ctx context.Context, in *protob.MSetKVRequest, opts ...grpc.CallOption) (*protob.MSetKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *client) MDel(ctx context.Context, in *protob.MDelKVRequest, opts ...grpc.CallOption) (*protob.MDelKVResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (c *client) Lock(ctx context.Context, in *protob.LockRequest, opts ...grpc.CallOption) (*protob.LockResponse, error) {
 	return nil, errors.New("not implemented")
 }
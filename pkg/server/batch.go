@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// batchServer executes operations using the KV, Map and Queue
+// handlers, which validate them and notify watchers as if they
+// were requested one by one.
+type batchServer struct {
+	eventstore.UnimplementedBatchServer
+	kv *kvServer
+	m  *mapServer
+	q  *queueServer
+}
+
+// Batch executes the operations in order. Failed operations do
+// not prevent the rest from being executed, their error is
+// informed at the matching result instead.
+func (s *batchServer) Batch(ctx context.Context, in *eventstore.BatchRequest) (*eventstore.BatchResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	res := &eventstore.BatchResponse{
+		Results: make([]*eventstore.BatchResult, 0, len(in.Ops)),
+	}
+	for _, op := range in.Ops {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		res.Results = append(res.Results, s.exec(ctx, op))
+	}
+
+	return res, nil
+}
+
+func (s *batchServer) exec(ctx context.Context, op *eventstore.BatchOperation) *eventstore.BatchResult {
+	var (
+		r   eventstore.BatchResult
+		err error
+	)
+
+	switch o := op.Op.(type) {
+	case *eventstore.BatchOperation_KvSet:
+		res := &eventstore.BatchResult_KvSet{}
+		res.KvSet, err = s.kv.Set(ctx, o.KvSet)
+		r.Result = res
+	case *eventstore.BatchOperation_KvGet:
+		res := &eventstore.BatchResult_KvGet{}
+		res.KvGet, err = s.kv.Get(ctx, o.KvGet)
+		r.Result = res
+	case *eventstore.BatchOperation_KvDel:
+		res := &eventstore.BatchResult_KvDel{}
+		res.KvDel, err = s.kv.Del(ctx, o.KvDel)
+		r.Result = res
+	case *eventstore.BatchOperation_KvIncr:
+		res := &eventstore.BatchResult_KvIncr{}
+		res.KvIncr, err = s.kv.Incr(ctx, o.KvIncr)
+		r.Result = res
+	case *eventstore.BatchOperation_KvDecr:
+		res := &eventstore.BatchResult_KvDecr{}
+		res.KvDecr, err = s.kv.Decr(ctx, o.KvDecr)
+		r.Result = res
+
+	case *eventstore.BatchOperation_MapNew:
+		res := &eventstore.BatchResult_MapNew{}
+		res.MapNew, err = s.m.New(ctx, o.MapNew)
+		r.Result = res
+	case *eventstore.BatchOperation_MapDel:
+		res := &eventstore.BatchResult_MapDel{}
+		res.MapDel, err = s.m.Del(ctx, o.MapDel)
+		r.Result = res
+	case *eventstore.BatchOperation_MapGetFields:
+		res := &eventstore.BatchResult_MapGetFields{}
+		res.MapGetFields, err = s.m.GetFields(ctx, o.MapGetFields)
+		r.Result = res
+	case *eventstore.BatchOperation_MapLen:
+		res := &eventstore.BatchResult_MapLen{}
+		res.MapLen, err = s.m.Len(ctx, o.MapLen)
+		r.Result = res
+	case *eventstore.BatchOperation_MapFieldSet:
+		res := &eventstore.BatchResult_MapFieldSet{}
+		res.MapFieldSet, err = s.m.FieldSet(ctx, o.MapFieldSet)
+		r.Result = res
+	case *eventstore.BatchOperation_MapFieldGet:
+		res := &eventstore.BatchResult_MapFieldGet{}
+		res.MapFieldGet, err = s.m.FieldGet(ctx, o.MapFieldGet)
+		r.Result = res
+	case *eventstore.BatchOperation_MapFieldDel:
+		res := &eventstore.BatchResult_MapFieldDel{}
+		res.MapFieldDel, err = s.m.FieldDel(ctx, o.MapFieldDel)
+		r.Result = res
+	case *eventstore.BatchOperation_MapFieldIncr:
+		res := &eventstore.BatchResult_MapFieldIncr{}
+		res.MapFieldIncr, err = s.m.FieldIncr(ctx, o.MapFieldIncr)
+		r.Result = res
+	case *eventstore.BatchOperation_MapFieldDecr:
+		res := &eventstore.BatchResult_MapFieldDecr{}
+		res.MapFieldDecr, err = s.m.FieldDecr(ctx, o.MapFieldDecr)
+		r.Result = res
+
+	case *eventstore.BatchOperation_QueueNew:
+		res := &eventstore.BatchResult_QueueNew{}
+		res.QueueNew, err = s.q.New(ctx, o.QueueNew)
+		r.Result = res
+	case *eventstore.BatchOperation_QueueDel:
+		res := &eventstore.BatchResult_QueueDel{}
+		res.QueueDel, err = s.q.Del(ctx, o.QueueDel)
+		r.Result = res
+	case *eventstore.BatchOperation_QueueLen:
+		res := &eventstore.BatchResult_QueueLen{}
+		res.QueueLen, err = s.q.Len(ctx, o.QueueLen)
+		r.Result = res
+	case *eventstore.BatchOperation_QueuePush:
+		res := &eventstore.BatchResult_QueuePush{}
+		res.QueuePush, err = s.q.Push(ctx, o.QueuePush)
+		r.Result = res
+	case *eventstore.BatchOperation_QueuePop:
+		res := &eventstore.BatchResult_QueuePop{}
+		res.QueuePop, err = s.q.Pop(ctx, o.QueuePop)
+		r.Result = res
+	case *eventstore.BatchOperation_QueuePeek:
+		res := &eventstore.BatchResult_QueuePeek{}
+		res.QueuePeek, err = s.q.Peek(ctx, o.QueuePeek)
+		r.Result = res
+
+	default:
+		err = status.Errorf(codes.Unimplemented, "unknown batch operation %T", o)
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+		r.Result = &eventstore.BatchResult_Error{
+			Error: &eventstore.BatchError{
+				Code:    int32(st.Code()),
+				Message: st.Message(),
+			},
+		}
+	}
+
+	return &r
+}
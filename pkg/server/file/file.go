@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package file implements a persistent EventStore that serves data from
+// memory and records every change at an append-only log file, which is
+// replayed when the store is opened.
+//
+// Entries are recorded as a whole when they are created or replaced,
+// and every later change to them records only what changed, so that
+// writing to a collection costs log space proportional to the write.
+// Compaction merges those changes into the entries they modify, and
+// drops overwritten, deleted and expired records. It runs periodically,
+// and as soon as changes and garbage records take as much space as the
+// live entries once the file is large enough.
+package file
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/triggermesh/eventstore/pkg/server"
+	"github.com/triggermesh/eventstore/pkg/server/memory"
+)
+
+const defaultCompactInterval = time.Minute
+
+// Store is an in-memory store backed by a log file.
+type Store struct {
+	*memory.Store
+
+	log *journal
+
+	compactInterval time.Duration
+	memoryOpts      []memory.Option
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+var _ server.Storage = (*Store)(nil)
+
+// Option customizes the file backed store.
+type Option func(*Store)
+
+// WithCompactInterval sets how often the log file is checked for
+// compaction, which merges changes into the entries they modify and
+// drops overwritten, deleted and expired records.
+func WithCompactInterval(d time.Duration) Option {
+	return func(s *Store) {
+		s.compactInterval = d
+	}
+}
+
+// WithSyncWrites flushes every record to stable storage before
+// acknowledging the operation. Without it, records written before a
+// process crash are preserved, but those written before an operating
+// system crash might not.
+func WithSyncWrites() Option {
+	return func(s *Store) {
+		s.log.syncWrites = true
+	}
+}
+
+// WithMemoryOptions customizes the in-memory store that serves the data.
+func WithMemoryOptions(opts ...memory.Option) Option {
+	return func(s *Store) {
+		s.memoryOpts = append(s.memoryOpts, opts...)
+	}
+}
+
+// Open the store persisted at path, creating it if it does not exist.
+// Entries found at the log file are restored, except those expired
+// while the store was closed.
+func Open(path string, opts ...Option) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("creating directory for %s: %w", path, err)
+	}
+
+	s := &Store{
+		log:             newJournal(path),
+		compactInterval: defaultCompactInterval,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+
+	for _, f := range opts {
+		f(s)
+	}
+
+	records, err := s.log.open()
+	if err != nil {
+		return nil, err
+	}
+
+	s.Store = memory.New(append(s.memoryOpts, memory.WithJournal(s.log))...)
+
+	for k, r := range records {
+		if err := s.Store.Restore(k, r.data, r.appended...); err != nil {
+			_ = s.Store.Close()
+			_ = s.log.close()
+			return nil, err
+		}
+	}
+
+	go s.compactLoop()
+
+	return s, nil
+}
+
+// Close stops background processing and closes the log file.
+func (s *Store) Close() error {
+	close(s.stop)
+	<-s.done
+
+	if err := s.Store.Close(); err != nil {
+		return err
+	}
+
+	return s.log.close()
+}
+
+func (s *Store) compactLoop() {
+	defer close(s.done)
+
+	t := time.NewTicker(s.compactInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-t.C:
+		case <-s.log.compactc:
+		}
+
+		if !s.log.needsCompaction() {
+			continue
+		}
+		if err := s.log.compact(); err != nil {
+			log.Printf("compacting %s: %v", s.log.path, err)
+		}
+	}
+}
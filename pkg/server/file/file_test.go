@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+func location(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{
+			Type:     eventstore.ScopeChoice_Instance,
+			Bridge:   "test-bridge",
+			Instance: "test-instance",
+		},
+		Key: key,
+	}
+}
+
+func openTestStore(t *testing.T, path string) *Store {
+	s, err := Open(path, WithCompactInterval(time.Hour))
+	require.NoError(t, err)
+	return s
+}
+
+func TestReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	version, err := s.Set(ctx, location("kv"), []byte("value"), 0)
+	require.NoError(t, err)
+	deleted, err := s.Set(ctx, location("deleted"), []byte("value"), 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Del(ctx, location("deleted")))
+	_, err = s.IncrBy(ctx, location("counter"), 3)
+	require.NoError(t, err)
+
+	require.NoError(t, s.HNew(ctx, location("map"), 0))
+	require.NoError(t, s.HNew(ctx, location("empty-map"), 0))
+	require.NoError(t, s.HSet(ctx, location("map"), "a", []byte("1"), 0))
+	require.NoError(t, s.HSet(ctx, location("map"), "b", []byte("2"), 0))
+	require.NoError(t, s.HDel(ctx, location("map"), "a"))
+
+	require.NoError(t, s.LNew(ctx, location("queue"), 0, server.QueueLimit{}))
+	require.NoError(t, s.LNew(ctx, location("empty-queue"), 0, server.QueueLimit{}))
+	require.NoError(t, s.RPush(ctx, location("queue"), []byte("x"), server.PushOptions{}))
+	require.NoError(t, s.RPush(ctx, location("queue"), []byte("y"), server.PushOptions{}))
+	_, err = s.LPop(ctx, location("queue"))
+	require.NoError(t, err)
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpHSet, Location: location("map"), Field: "c", Value: []byte("3")},
+		{Kind: server.OpRPush, Location: location("queue"), Value: []byte("z")},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.SNew(ctx, location("set"), 0))
+	require.NoError(t, s.SNew(ctx, location("empty-set"), 0))
+	_, err = s.SAdd(ctx, location("set"), []string{"a", "b"})
+	require.NoError(t, err)
+	_, err = s.SRem(ctx, location("set"), []string{"a"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.ZNew(ctx, location("zset"), 0))
+	_, err = s.ZAdd(ctx, location("zset"), []server.ScoredMember{{Member: "a", Score: 2}, {Member: "b", Score: 1}})
+	require.NoError(t, err)
+
+	require.NoError(t, s.XNew(ctx, location("stream"), 0, server.StreamRetention{MaxLen: 1}))
+	_, err = s.XAdd(ctx, location("stream"), []byte("e1"))
+	require.NoError(t, err)
+	_, err = s.XAdd(ctx, location("stream"), []byte("e2"))
+	require.NoError(t, err)
+	require.NoError(t, s.XCommit(ctx, location("stream"), "g", 2))
+
+	dropped := &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Bridge, Bridge: "dropped"},
+		Key:   "kv",
+	}
+	_, err = s.Set(ctx, dropped, []byte("value"), 0)
+	require.NoError(t, err)
+	_, err = s.DropScope(ctx, dropped.Scope)
+	require.NoError(t, err)
+
+	_, err = s.Lock(ctx, location("kv"), 0, "")
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	s = openTestStore(t, path)
+	defer s.Close()
+
+	v, restored, err := s.Get(ctx, location("kv"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), v)
+	assert.Equal(t, version, restored)
+
+	_, _, err = s.Get(ctx, location("deleted"))
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	recreated, err := s.Set(ctx, location("deleted"), []byte("value"), 0)
+	require.NoError(t, err)
+	assert.Greater(t, recreated, deleted, "versions should not be reused")
+
+	n, err := s.IncrBy(ctx, location("counter"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+
+	fields, err := s.HGetAll(ctx, location("map"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"b": []byte("2"), "c": []byte("3")}, fields)
+	require.NoError(t, s.HSet(ctx, location("empty-map"), "a", []byte("1"), 0))
+
+	items, err := s.LRange(ctx, location("queue"), 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("y"), []byte("z")}, items)
+	require.NoError(t, s.RPush(ctx, location("empty-queue"), []byte("x"), server.PushOptions{}))
+
+	members, err := s.SMembers(ctx, location("set"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, members)
+	_, err = s.SAdd(ctx, location("empty-set"), []string{"a"})
+	require.NoError(t, err)
+
+	scored, err := s.ZRange(ctx, location("zset"), 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{{Member: "b", Score: 1}, {Member: "a", Score: 2}}, scored)
+
+	entries, err := s.XRange(ctx, location("stream"), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(2), entries[0].Offset)
+	assert.Equal(t, []byte("e2"), entries[0].Value)
+
+	offset, err := s.XAdd(ctx, location("stream"), []byte("e3"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), offset, "stream offsets should survive a restart")
+
+	offset, err = s.XOffset(ctx, location("stream"), "g")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), offset)
+
+	_, err = s.Lock(ctx, location("kv"), 0, "")
+	assert.NoError(t, err, "locks should not survive a restart")
+
+	_, _, err = s.Get(ctx, dropped)
+	assert.ErrorIs(t, err, server.ErrNotFound, "dropped scopes should not be restored")
+}
+
+func TestReplayExpired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	_, err := s.Set(ctx, location("short"), []byte("value"), 50*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, location("long"), time.Hour))
+	require.NoError(t, s.Close())
+
+	time.Sleep(100 * time.Millisecond)
+
+	s = openTestStore(t, path)
+	defer s.Close()
+
+	_, _, err = s.Get(ctx, location("short"))
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, err = s.HLen(ctx, location("long"))
+	assert.NoError(t, err)
+}
+
+func TestRecoverIncompleteRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	_, err := s.Set(ctx, location("kv"), []byte("value"), 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	// simulate a crash in the middle of writing a record
+	frame := encodeRecord(&record{op: opPut, key: "partial", data: []byte("value")})
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write(frame[:len(frame)-2])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s = openTestStore(t, path)
+	_, err = s.Set(ctx, location("other"), []byte("other"), 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	s = openTestStore(t, path)
+	defer s.Close()
+
+	for _, k := range []string{"kv", "other"} {
+		_, _, err := s.Get(ctx, location(k))
+		assert.NoError(t, err, "key %q should have been restored", k)
+	}
+}
+
+func TestRecoverIncompleteBatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	for _, k := range []string{"a", "b"} {
+		_, err := s.Set(ctx, location(k), []byte("value"), 0)
+		require.NoError(t, err)
+	}
+	_, err := s.DropScope(ctx, location("").Scope)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	// simulate a crash right before completing the batch
+	// that holds the removal of both keys
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	s = openTestStore(t, path)
+	defer s.Close()
+
+	for _, k := range []string{"a", "b"} {
+		_, _, err := s.Get(ctx, location(k))
+		assert.NoError(t, err, "key %q should have been kept", k)
+	}
+}
+
+func TestCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	require.NoError(t, s.HNew(ctx, location("map"), 0))
+	for i := 0; i < 100; i++ {
+		_, err := s.HIncrBy(ctx, location("map"), "counter", 1)
+		require.NoError(t, err)
+	}
+	_, err := s.Set(ctx, location("expiring"), []byte("value"), 50*time.Millisecond)
+	require.NoError(t, err)
+	_, err = s.Set(ctx, location("deleted"), []byte("value"), 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Del(ctx, location("deleted")))
+
+	time.Sleep(100 * time.Millisecond)
+
+	before, err := os.Stat(path)
+	require.NoError(t, err)
+
+	require.True(t, s.log.needsCompaction())
+	require.NoError(t, s.log.compact())
+	assert.False(t, s.log.needsCompaction())
+	assert.Equal(t, 1, s.log.records)
+
+	after, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, after.Size(), before.Size())
+
+	// records written after compaction are appended to the new file
+	_, err = s.Set(ctx, location("kv"), []byte("value"), 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	s = openTestStore(t, path)
+	defer s.Close()
+
+	v, err := s.HGet(ctx, location("map"), "counter")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("100"), v)
+
+	_, _, err = s.Get(ctx, location("kv"))
+	assert.NoError(t, err)
+	_, _, err = s.Get(ctx, location("expiring"))
+	assert.ErrorIs(t, err, server.ErrNotFound)
+}
+
+func TestAppendChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	defer s.Close()
+	s.log.autoCompactSize = math.MaxInt64
+
+	require.NoError(t, s.LNew(ctx, location("queue"), 0, server.QueueLimit{}))
+	before, err := os.Stat(path)
+	require.NoError(t, err)
+
+	// Every push records only the pushed item, instead of
+	// making the log file grow with the size of the queue.
+	item := make([]byte, 100)
+	for i := 0; i < 500; i++ {
+		require.NoError(t, s.RPush(ctx, location("queue"), item, server.PushOptions{}))
+	}
+
+	after, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, after.Size()-before.Size(), 2*500*int64(len(item)))
+}
+
+func TestAutoCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eventstore.log")
+	ctx := context.Background()
+
+	s := openTestStore(t, path)
+	s.log.autoCompactSize = 64 << 10
+
+	// Changes to the queue take log space while the queue
+	// stays small, until they are merged into the queue.
+	require.NoError(t, s.LNew(ctx, location("queue"), 0, server.QueueLimit{}))
+	item := make([]byte, 100)
+	for i := 0; i < 2000; i++ {
+		require.NoError(t, s.RPush(ctx, location("queue"), item, server.PushOptions{}))
+		_, err := s.LPop(ctx, location("queue"))
+		require.NoError(t, err)
+	}
+
+	assert.Eventually(t, func() bool {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		return info.Size() < 2*s.log.autoCompactSize
+	}, 5*time.Second, 10*time.Millisecond, "log file should be compacted as it grows")
+
+	require.NoError(t, s.Close())
+
+	s = openTestStore(t, path)
+	defer s.Close()
+
+	n, err := s.LLen(ctx, location("queue"))
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
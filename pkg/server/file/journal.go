@@ -0,0 +1,630 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/triggermesh/eventstore/pkg/server/memory"
+)
+
+type op byte
+
+const (
+	opPut op = iota + 1
+	opDelete
+	// opBatch records hold a sequence of framed put, delete
+	// and append records that are applied together.
+	opBatch
+	// opAppend records hold a change to the entry
+	// recorded for the key by a previous put record.
+	opAppend
+)
+
+// record is a single change written at the log file.
+type record struct {
+	op       op
+	key      string
+	expireAt time.Time
+	data     []byte
+	// offset and size of the framed record at the log file,
+	// records held by a batch taking the offset of the batch.
+	offset int64
+	size   int64
+	// appended holds the changes recorded for
+	// the key after a put record, in order.
+	appended [][]byte
+}
+
+func (r *record) expired(now time.Time) bool {
+	return !r.expireAt.IsZero() && !now.Before(r.expireAt)
+}
+
+// frameHeaderSize is the size of the payload length
+// and checksum preceding each record at the log file.
+const frameHeaderSize = 8
+
+// maxPayloadSize guards against allocating huge buffers
+// when reading a corrupted record length.
+const maxPayloadSize = 1 << 30
+
+// minAutoCompactSize is the default size the log file must reach
+// before it is compacted as soon as it holds as many garbage bytes as
+// live ones, instead of waiting for the periodic check.
+const minAutoCompactSize = 16 << 20
+
+var errCorrupted = errors.New("corrupted record")
+
+// indexEntry describes the live put record for a key. Changes
+// appended to it are not accounted as live, since compacting
+// the log file merges them into the put record.
+type indexEntry struct {
+	expireAt time.Time
+	offset   int64
+	size     int64
+}
+
+// journal is an append-only log file that implements memory.Journal.
+type journal struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	size int64
+
+	// index keeps the expiration and size of the put record for
+	// every live key, which along with the size of the file is used
+	// to decide when compaction is worth it.
+	index   map[string]indexEntry
+	live    int64
+	records int
+
+	// compactc is signaled when the file grows past autoCompactSize
+	// and is worth compacting right away.
+	compactc        chan struct{}
+	autoCompactSize int64
+
+	// merge applies the changes appended to a put record.
+	merge func(data []byte, appended [][]byte) ([]byte, error)
+
+	syncWrites bool
+	now        func() time.Time
+}
+
+func newJournal(path string) *journal {
+	return &journal{
+		path:            path,
+		index:           make(map[string]indexEntry),
+		compactc:        make(chan struct{}, 1),
+		autoCompactSize: minAutoCompactSize,
+		merge:           memory.Merge,
+		now:             time.Now,
+	}
+}
+
+// open reads all records from the log file and prepares it for
+// appending. Records at the end of the file that were partially
+// written are discarded. Returns the latest live put record for
+// each key, along with the changes appended to it.
+func (j *journal) open() (map[string]*record, error) {
+	// leftovers from an interrupted compaction
+	if err := os.Remove(j.compactPath()); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(j.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", j.path, err)
+	}
+
+	live, n, size, err := readRecords(bufio.NewReader(f))
+	switch {
+	case errors.Is(err, errCorrupted), errors.Is(err, io.ErrUnexpectedEOF):
+		log.Printf("discarding incomplete records at %s after offset %d", j.path, size)
+		if err := f.Truncate(size); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("truncating %s: %w", j.path, err)
+		}
+	case err != nil:
+		_ = f.Close()
+		return nil, fmt.Errorf("reading %s: %w", j.path, err)
+	}
+
+	now := j.now()
+	for k, r := range live {
+		if r.expired(now) {
+			delete(live, k)
+			continue
+		}
+		j.index[k] = indexEntry{expireAt: r.expireAt, offset: r.offset, size: r.size}
+		j.live += r.size
+	}
+
+	j.f = f
+	j.size = size
+	j.records = n
+
+	return live, nil
+}
+
+func (j *journal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.f.Close()
+}
+
+// Put implements memory.Journal.
+func (j *journal) Put(key string, data []byte, expireAt time.Time) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	r := &record{op: opPut, key: key, expireAt: expireAt, data: data}
+	if err := j.append(r); err != nil {
+		return err
+	}
+
+	j.put(r)
+	return nil
+}
+
+// Append implements memory.Journal.
+func (j *journal) Append(key string, delta []byte, expireAt time.Time) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	r := &record{op: opAppend, key: key, expireAt: expireAt, data: delta}
+	if err := j.append(r); err != nil {
+		return err
+	}
+
+	j.update(r)
+	return nil
+}
+
+// Delete implements memory.Journal.
+func (j *journal) Delete(key string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.append(&record{op: opDelete, key: key}); err != nil {
+		return err
+	}
+
+	j.delete(key)
+	return nil
+}
+
+// Commit implements memory.Journal, writing all changes
+// as a single record at the log file.
+func (j *journal) Commit(changes []memory.Change) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	records := make([]*record, len(changes))
+	var data []byte
+	for i, c := range changes {
+		r := &record{op: opPut, key: c.Key, expireAt: c.ExpireAt, data: c.Data}
+		switch {
+		case c.Delete:
+			r = &record{op: opDelete, key: c.Key}
+		case c.Delta:
+			r.op = opAppend
+		}
+
+		frame := encodeRecord(r)
+		r.size = int64(len(frame))
+		data = append(data, frame...)
+		records[i] = r
+	}
+
+	batch := &record{op: opBatch, data: data}
+	if err := j.append(batch); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		r.offset = batch.offset
+		switch r.op {
+		case opDelete:
+			j.delete(r.key)
+		case opAppend:
+			j.update(r)
+		default:
+			j.put(r)
+		}
+	}
+	return nil
+}
+
+// put indexes the record as the live one for its key.
+// Must be called with the lock held.
+func (j *journal) put(r *record) {
+	j.delete(r.key)
+	j.index[r.key] = indexEntry{expireAt: r.expireAt, offset: r.offset, size: r.size}
+	j.live += r.size
+}
+
+// update keeps the expiration of the key informed by an append
+// record. Must be called with the lock held.
+func (j *journal) update(r *record) {
+	if e, ok := j.index[r.key]; ok {
+		e.expireAt = r.expireAt
+		j.index[r.key] = e
+	}
+}
+
+// delete removes the key from the index. Must be
+// called with the lock held.
+func (j *journal) delete(key string) {
+	if e, ok := j.index[key]; ok {
+		j.live -= e.size
+		delete(j.index, key)
+	}
+}
+
+// append writes the record at the log file. Records that fail to be
+// written are truncated, so that later records can still be read.
+// Must be called with the lock held.
+func (j *journal) append(r *record) error {
+	frame := encodeRecord(r)
+	r.offset, r.size = j.size, int64(len(frame))
+
+	_, err := j.f.Write(frame)
+	if err == nil && j.syncWrites {
+		err = j.f.Sync()
+	}
+	if err != nil {
+		_ = j.f.Truncate(j.size)
+		return err
+	}
+
+	j.size += r.size
+	j.records++
+
+	if j.size >= j.autoCompactSize && j.size-j.live >= j.live {
+		select {
+		case j.compactc <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// needsCompaction returns true when appended changes, along with
+// overwritten, deleted or expired records, take at least as much
+// space as live put records.
+func (j *journal) needsCompaction() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	now := j.now()
+	live := j.live
+	for _, e := range j.index {
+		if !e.expireAt.IsZero() && !now.Before(e.expireAt) {
+			live -= e.size
+		}
+	}
+
+	garbage := j.size - live
+	return garbage > 0 && garbage >= live
+}
+
+// compact rewrites the log file keeping only the latest live record
+// for each key, with the changes appended to it merged. Records
+// appended while the compacted file is being written are copied at
+// its end before it replaces the log file.
+func (j *journal) compact() error {
+	j.mu.Lock()
+	offset, records := j.size, j.records
+	j.mu.Unlock()
+
+	src, err := os.Open(j.path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	live, _, _, err := readRecords(bufio.NewReader(io.LimitReader(src, offset)))
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", j.path, err)
+	}
+
+	dst, err := os.OpenFile(j.compactPath(), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	compacted, err := j.writeCompacted(dst, live)
+	if err != nil {
+		_ = dst.Close()
+		_ = os.Remove(j.compactPath())
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	tail, err := io.Copy(dst, io.NewSectionReader(src, offset, j.size-offset))
+	if err == nil {
+		err = dst.Sync()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(j.compactPath(), j.path)
+	}
+	if err != nil {
+		_ = os.Remove(j.compactPath())
+		return err
+	}
+
+	f, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("reopening %s: %w", j.path, err)
+	}
+	_ = j.f.Close()
+
+	j.f = f
+	j.size = compacted.size + tail
+	j.records = compacted.records + j.records - records
+
+	// Put records written before the compacted offset were rewritten,
+	// and the rest of them were moved along with the tail.
+	now := j.now()
+	j.live = 0
+	for k, e := range j.index {
+		switch c, ok := compacted.index[k]; {
+		case !e.expireAt.IsZero() && !now.Before(e.expireAt):
+			delete(j.index, k)
+			continue
+		case e.offset >= offset:
+			e.offset += compacted.size - offset
+		case ok:
+			e.offset, e.size = c.offset, c.size
+		}
+		j.index[k] = e
+		j.live += e.size
+	}
+
+	return nil
+}
+
+type compactResult struct {
+	size    int64
+	records int
+	// index of the records written, whose size
+	// changes when appended changes are merged.
+	index map[string]indexEntry
+}
+
+// writeCompacted writes the non expired records at w, merging
+// the changes appended to them.
+func (j *journal) writeCompacted(w io.Writer, live map[string]*record) (*compactResult, error) {
+	res := &compactResult{index: make(map[string]indexEntry, len(live))}
+	bw := bufio.NewWriter(w)
+	now := j.now()
+
+	for k, r := range live {
+		if r.expired(now) {
+			continue
+		}
+
+		if len(r.appended) != 0 {
+			data, err := j.merge(r.data, r.appended)
+			if err != nil {
+				return nil, fmt.Errorf("merging changes to key %q: %w", k, err)
+			}
+			r = &record{op: opPut, key: r.key, expireAt: r.expireAt, data: data}
+		}
+
+		n, err := bw.Write(encodeRecord(r))
+		if err != nil {
+			return nil, err
+		}
+		res.index[k] = indexEntry{expireAt: r.expireAt, offset: res.size, size: int64(n)}
+		res.size += int64(n)
+		res.records++
+	}
+
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (j *journal) compactPath() string {
+	return j.path + ".compact"
+}
+
+// readRecords reads records until the end of the stream, returning the
+// latest live put record for each key along with the changes appended
+// to it, the number of records read and the size of the valid records
+// read.
+func readRecords(r *bufio.Reader) (map[string]*record, int, int64, error) {
+	live := make(map[string]*record)
+	var n int
+	var size int64
+
+	header := make([]byte, frameHeaderSize)
+	for {
+		rec, err := readFrame(r, header)
+		if err != nil {
+			if err == io.EOF {
+				return live, n, size, nil
+			}
+			return live, n, size, err
+		}
+
+		rec.offset = size
+		if rec.op == opBatch {
+			batch, err := readBatch(rec.data)
+			if err != nil {
+				return live, n, size, err
+			}
+			for _, b := range batch {
+				b.offset = rec.offset
+				replay(live, b)
+			}
+		} else {
+			replay(live, rec)
+		}
+
+		n++
+		size += rec.size
+	}
+}
+
+// replay applies a put, delete or append record to the
+// latest live records.
+func replay(live map[string]*record, r *record) {
+	switch r.op {
+	case opPut:
+		live[r.key] = r
+	case opDelete:
+		delete(live, r.key)
+	case opAppend:
+		// changes are only appended to live keys
+		if l, ok := live[r.key]; ok {
+			l.appended = append(l.appended, r.data)
+			l.expireAt = r.expireAt
+		}
+	}
+}
+
+// readBatch returns the records held by a batch record.
+func readBatch(data []byte) ([]*record, error) {
+	var batch []*record
+
+	r := bytes.NewReader(data)
+	header := make([]byte, frameHeaderSize)
+	for {
+		rec, err := readFrame(r, header)
+		switch {
+		case err == io.EOF:
+			return batch, nil
+		case err != nil, rec.op == opBatch:
+			return nil, errCorrupted
+		}
+		batch = append(batch, rec)
+	}
+}
+
+// readFrame reads a single record, returning io.EOF only when
+// no bytes are left to read.
+func readFrame(r io.Reader, header []byte) (*record, error) {
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	l := binary.BigEndian.Uint32(header[0:4])
+	if l > maxPayloadSize {
+		return nil, errCorrupted
+	}
+
+	payload := make([]byte, l)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, errCorrupted
+	}
+
+	rec, err := decodeRecord(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.size = int64(frameHeaderSize + l)
+	return rec, nil
+}
+
+// encodeRecord returns the record framed with its length and checksum.
+//
+//	| length (4) | crc32 (4) | op (1) | key length (uvarint) | key |
+//	| expiration unix nanoseconds (varint) | data |
+func encodeRecord(r *record) []byte {
+	var expireAt int64
+	if !r.expireAt.IsZero() {
+		expireAt = r.expireAt.UnixNano()
+	}
+
+	buf := make([]byte, frameHeaderSize+1+2*binary.MaxVarintLen64+len(r.key)+len(r.data))
+	p := frameHeaderSize
+
+	buf[p] = byte(r.op)
+	p++
+	p += binary.PutUvarint(buf[p:], uint64(len(r.key)))
+	p += copy(buf[p:], r.key)
+	p += binary.PutVarint(buf[p:], expireAt)
+	p += copy(buf[p:], r.data)
+
+	buf = buf[:p]
+	payload := buf[frameHeaderSize:]
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+
+	return buf
+}
+
+func decodeRecord(payload []byte) (*record, error) {
+	if len(payload) == 0 {
+		return nil, errCorrupted
+	}
+
+	r := &record{op: op(payload[0])}
+	if r.op < opPut || r.op > opAppend {
+		return nil, errCorrupted
+	}
+	p := 1
+
+	kl, n := binary.Uvarint(payload[p:])
+	if n <= 0 || uint64(len(payload)-p-n) < kl {
+		return nil, errCorrupted
+	}
+	p += n
+	r.key = string(payload[p : p+int(kl)])
+	p += int(kl)
+
+	expireAt, n := binary.Varint(payload[p:])
+	if n <= 0 {
+		return nil, errCorrupted
+	}
+	p += n
+	if expireAt != 0 {
+		r.expireAt = time.Unix(0, expireAt)
+	}
+
+	r.data = payload[p:]
+	return r, nil
+}
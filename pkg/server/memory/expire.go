@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// TTL returns the remaining time to live for the entry at the location.
+func (s *Store) TTL(ctx context.Context, loc *eventstore.LocationType) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.lookup(loc)
+	if e == nil {
+		return 0, errNotFound(loc)
+	}
+
+	if e.ExpireAt.IsZero() {
+		return 0, nil
+	}
+	return e.ExpireAt.Sub(s.now()), nil
+}
+
+// Expire sets a new TTL for the entry at the location. The
+// version of KV entries is kept.
+func (s *Store) Expire(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.lookup(loc)
+	if e == nil {
+		return errNotFound(loc)
+	}
+
+	_, err := s.update(loc, e, &mutation{Op: opExpire, ExpireAt: s.expireAt(ttl)})
+	return err
+}
+
+// Persist removes the TTL of the entry at the location.
+func (s *Store) Persist(ctx context.Context, loc *eventstore.LocationType) error {
+	return s.Expire(ctx, loc, 0)
+}
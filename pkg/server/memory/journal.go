@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// Journal receives every change applied to the store so that
+// it can be persisted and restored later on.
+type Journal interface {
+	// Put records the encoded entry stored at key, replacing
+	// any previous record for the key.
+	Put(key string, data []byte, expireAt time.Time) error
+	// Append records an encoded change to the entry stored at key,
+	// which is restored by applying it on top of the entry recorded
+	// by Put, in the order changes were appended. The expiration
+	// of the entry might change along with it.
+	Append(key string, delta []byte, expireAt time.Time) error
+	// Delete records the removal of key.
+	Delete(key string) error
+	// Commit records a group of changes at once, so that either
+	// all or none of them are restored.
+	Commit(changes []Change) error
+}
+
+// Change is a single change recorded by Journal.Commit.
+type Change struct {
+	Key      string
+	Data     []byte
+	ExpireAt time.Time
+	// Delta informs that Data is a change to the entry,
+	// like the ones recorded by Journal.Append.
+	Delta bool
+	// Delete informs that the key was removed.
+	Delete bool
+}
+
+// WithJournal records all changes to entries at the journal.
+// Locks are not journaled.
+func WithJournal(j Journal) Option {
+	return func(s *Store) {
+		s.journal = j
+	}
+}
+
+// Restore loads an entry previously recorded at the journal, applying
+// the changes appended to it afterwards. It must be called before the
+// store starts serving requests.
+func (s *Store) Restore(key string, data []byte, deltas ...[]byte) error {
+	loc, err := decodeKey(key)
+	if err != nil {
+		return err
+	}
+
+	e, err := decodeEntry(data, deltas)
+	if err != nil {
+		return fmt.Errorf("restoring key %q: %w", loc.GetKey(), err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e.Version > s.version {
+		s.version = e.Version
+	}
+
+	s.store(loc, e)
+	return nil
+}
+
+// Merge returns the encoded entry that results from applying the
+// changes appended to an entry recorded at the journal, which lets
+// journals replace them with a single record.
+func Merge(data []byte, deltas [][]byte) ([]byte, error) {
+	e, err := decodeEntry(data, deltas)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(e); err != nil {
+		return nil, fmt.Errorf("encoding entry: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decodeEntry decodes an entry recorded at the journal
+// and applies the changes appended to it.
+func decodeEntry(data []byte, deltas [][]byte) (*entry, error) {
+	e := &entry{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(e); err != nil {
+		return nil, fmt.Errorf("decoding entry: %w", err)
+	}
+
+	// empty collections are not encoded
+	switch e.Kind {
+	case kindMap:
+		if e.Fields == nil {
+			e.Fields = make(map[string][]byte)
+		}
+	case kindQueue:
+		if e.Items == nil {
+			e.Items = [][]byte{}
+		}
+	case kindSet:
+		if e.Members == nil {
+			e.Members = make(map[string]bool)
+		}
+	case kindSortedSet:
+		if e.Scores == nil {
+			e.Scores = make(map[string]float64)
+		}
+	case kindStream:
+		if e.Offsets == nil {
+			e.Offsets = make(map[string]int64)
+		}
+	}
+
+	for _, data := range deltas {
+		m, err := decodeMutation(data)
+		if err != nil {
+			return nil, err
+		}
+		e.apply(m)
+	}
+
+	return e, nil
+}
+
+// persist records the entry at the journal. Must be called
+// with the lock held.
+func (s *Store) persist(loc *eventstore.LocationType, e *entry) error {
+	if s.journal == nil {
+		return nil
+	}
+
+	data, err := encodeEntry(loc, e)
+	if err != nil {
+		return err
+	}
+
+	if err := s.journal.Put(encodeKey(loc), data, e.ExpireAt); err != nil {
+		return status.Errorf(codes.Internal, "persisting key %q: %v", loc.GetKey(), err)
+	}
+
+	return nil
+}
+
+// forget records the removal of the entry at the journal.
+// Must be called with the lock held.
+func (s *Store) forget(loc *eventstore.LocationType) error {
+	if s.journal == nil {
+		return nil
+	}
+
+	if err := s.journal.Delete(encodeKey(loc)); err != nil {
+		return status.Errorf(codes.Internal, "persisting removal of key %q: %v", loc.GetKey(), err)
+	}
+
+	return nil
+}
+
+// persistAll records at the journal at once the entries, and the
+// removal of the locations whose entry is nil. Must be called with
+// the lock held.
+func (s *Store) persistAll(locs []*eventstore.LocationType, entries []*entry) error {
+	if s.journal == nil || len(locs) == 0 {
+		return nil
+	}
+
+	changes := make([]Change, len(locs))
+	for i, loc := range locs {
+		c, err := putChange(loc, entries[i])
+		if err != nil {
+			return err
+		}
+		changes[i] = c
+	}
+
+	return s.commit(changes)
+}
+
+// commit records the changes at the journal at once. Must
+// be called with the lock held.
+func (s *Store) commit(changes []Change) error {
+	if err := s.journal.Commit(changes); err != nil {
+		return status.Errorf(codes.Internal, "persisting %d keys: %v", len(changes), err)
+	}
+
+	return nil
+}
+
+// putChange returns the change that records the entry, or
+// the removal of the location when the entry is nil.
+func putChange(loc *eventstore.LocationType, e *entry) (Change, error) {
+	if e == nil {
+		return Change{Key: encodeKey(loc), Delete: true}, nil
+	}
+
+	data, err := encodeEntry(loc, e)
+	if err != nil {
+		return Change{}, err
+	}
+
+	return Change{Key: encodeKey(loc), Data: data, ExpireAt: e.ExpireAt}, nil
+}
+
+// mutationChange returns the change that records the mutation of the entry.
+func mutationChange(loc *eventstore.LocationType, e *entry, m *mutation) Change {
+	return Change{Key: encodeKey(loc), Data: encodeMutation(m), ExpireAt: m.expireAt(e), Delta: true}
+}
+
+func encodeEntry(loc *eventstore.LocationType, e *entry) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(e); err != nil {
+		return nil, status.Errorf(codes.Internal, "encoding key %q: %v", loc.GetKey(), err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeKey returns a string that uniquely identifies
+// the location across scopes.
+func encodeKey(loc *eventstore.LocationType) string {
+	sc := loc.GetScope()
+	return fmt.Sprintf("%d %q %q %q", sc.GetType(), sc.GetBridge(), sc.GetInstance(), loc.GetKey())
+}
+
+func decodeKey(key string) (*eventstore.LocationType, error) {
+	var typ int32
+	var bridge, instance, k string
+	if _, err := fmt.Sscanf(key, "%d %q %q %q", &typ, &bridge, &instance, &k); err != nil {
+		return nil, fmt.Errorf("decoding journal key %q: %w", key, err)
+	}
+
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{
+			Type:     eventstore.ScopeChoice(typ),
+			Bridge:   bridge,
+			Instance: instance,
+		},
+		Key: k,
+	}, nil
+}
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Set stores the value at the location, replacing any
+// existing entry.
+func (s *Store) Set(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set(loc, value, ttl)
+}
+
+// SetNX stores the value at the location if the key does not exist.
+func (s *Store) SetNX(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e != nil {
+		return 0, fmt.Errorf("key %q exists: %w", loc.GetKey(), server.ErrConflict)
+	}
+
+	return s.set(loc, value, ttl)
+}
+
+// SetXX stores the value at the location if the key exists.
+func (s *Store) SetXX(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e == nil {
+		return 0, fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+	}
+
+	return s.set(loc, value, ttl)
+}
+
+// CompareAndSwap stores the value at the location if the version
+// of the existing entry matches.
+func (s *Store) CompareAndSwap(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration, version uint64) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.lookup(loc)
+	switch {
+	case e == nil:
+		return 0, fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+	case e.Kind != kindKV:
+		return 0, errWrongKind(loc, e.Kind, kindKV)
+	case e.Version != version:
+		return 0, fmt.Errorf("key %q is at version %d, not %d: %w", loc.GetKey(), e.Version, version, server.ErrConflict)
+	}
+
+	return s.set(loc, value, ttl)
+}
+
+// set stores a new KV entry. Must be called with the lock held.
+func (s *Store) set(loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	e := &entry{
+		Kind:     kindKV,
+		Value:    value,
+		ExpireAt: s.expireAt(ttl),
+	}
+	s.bump(e)
+
+	if err := s.put(loc, e); err != nil {
+		return 0, err
+	}
+
+	return e.Version, nil
+}
+
+// Get returns the value stored at the location and its version.
+func (s *Store) Get(ctx context.Context, loc *eventstore.LocationType) ([]byte, uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindKV)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return e.Value, e.Version, nil
+}
+
+// Del removes the entry at the location, regardless of
+// its kind.
+func (s *Store) Del(ctx context.Context, loc *eventstore.LocationType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e == nil {
+		return errNotFound(loc)
+	}
+
+	if err := s.forget(loc); err != nil {
+		return err
+	}
+
+	s.remove(loc)
+	return nil
+}
+
+// IncrBy adds delta to the integer stored at the location and returns
+// the resulting value. Missing keys are created with a zero value.
+func (s *Store) IncrBy(ctx context.Context, loc *eventstore.LocationType, delta int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.lookup(loc)
+	if e == nil {
+		e = &entry{Kind: kindKV}
+	}
+
+	if e.Kind != kindKV {
+		return 0, errWrongKind(loc, e.Kind, kindKV)
+	}
+
+	v, err := incr(e.Value, delta)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := &entry{
+		Kind:     kindKV,
+		Value:    []byte(strconv.FormatInt(v, 10)),
+		ExpireAt: e.ExpireAt,
+	}
+	s.bump(updated)
+	if err := s.put(loc, updated); err != nil {
+		return 0, err
+	}
+
+	return v, nil
+}
+
+// IncrByFloat adds delta to the number stored at the location and
+// returns the resulting value. Missing keys are created with a zero value.
+func (s *Store) IncrByFloat(ctx context.Context, loc *eventstore.LocationType, delta float64) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.lookup(loc)
+	if e == nil {
+		e = &entry{Kind: kindKV}
+	}
+
+	if e.Kind != kindKV {
+		return 0, errWrongKind(loc, e.Kind, kindKV)
+	}
+
+	v, err := incrFloat(e.Value, delta)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := &entry{
+		Kind:     kindKV,
+		Value:    []byte(strconv.FormatFloat(v, 'f', -1, 64)),
+		ExpireAt: e.ExpireAt,
+	}
+	s.bump(updated)
+	if err := s.put(loc, updated); err != nil {
+		return 0, err
+	}
+
+	return v, nil
+}
+
+// incr parses the integer value and adds delta to it. An empty
+// value is considered zero.
+func incr(value []byte, delta int64) (int64, error) {
+	var v int64
+	if len(value) != 0 {
+		var err error
+		v, err = strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return 0, server.ErrNotInteger
+		}
+	}
+
+	if (delta > 0 && v > math.MaxInt64-delta) || (delta < 0 && v < math.MinInt64-delta) {
+		return 0, fmt.Errorf("increment would overflow: %w", server.ErrOutOfRange)
+	}
+
+	return v + delta, nil
+}
+
+// incrFloat parses the floating point value and adds delta to
+// it. An empty value is considered zero.
+func incrFloat(value []byte, delta float64) (float64, error) {
+	var v float64
+	if len(value) != 0 {
+		var err error
+		v, err = strconv.ParseFloat(string(value), 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, server.ErrNotFloat
+		}
+	}
+
+	v += delta
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("increment would produce NaN or infinity: %w", server.ErrOutOfRange)
+	}
+
+	return v, nil
+}
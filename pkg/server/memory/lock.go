@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Lock acquires exclusive access to the location and returns the
+// lease needed to unlock it. A non zero timeout releases the lock
+// automatically once elapsed. Fencing tokens are taken from the
+// counter of versions, which never goes backwards.
+func (s *Store) Lock(ctx context.Context, loc *eventstore.LocationType, timeout time.Duration, owner string) (server.Lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc := scopeOf(loc.GetScope())
+	ls, ok := s.locks[sc]
+	if !ok {
+		ls = make(map[string]*lock)
+		s.locks[sc] = ls
+	}
+
+	if l, ok := ls[loc.GetKey()]; ok && !l.expired(s.now()) {
+		return server.Lease{}, fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrLocked)
+	}
+	if len(s.holdersAt(s.readers, loc, false)) != 0 {
+		return server.Lease{}, fmt.Errorf("key %q is read locked: %w", loc.GetKey(), server.ErrLocked)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return server.Lease{}, fmt.Errorf("generating unlock token: %w", err)
+	}
+
+	s.version++
+	l := &lock{
+		token:    token,
+		fencing:  s.version,
+		owner:    owner,
+		expireAt: s.expireAt(timeout),
+	}
+	ls[loc.GetKey()] = l
+
+	return s.lease(l), nil
+}
+
+// Unlock releases the lock at the location when the
+// token matches the one returned by Lock.
+func (s *Store) Unlock(ctx context.Context, loc *eventstore.LocationType, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.heldLock(loc, token); err != nil {
+		return err
+	}
+
+	sc := scopeOf(loc.GetScope())
+	ls := s.locks[sc]
+	delete(ls, loc.GetKey())
+	if len(ls) == 0 {
+		delete(s.locks, sc)
+	}
+
+	return nil
+}
+
+// RefreshLock replaces the timeout of the lock at the location
+// when the token matches the one returned by Lock.
+func (s *Store) RefreshLock(ctx context.Context, loc *eventstore.LocationType, token string, timeout time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.heldLock(loc, token)
+	if err != nil {
+		return err
+	}
+
+	l.expireAt = s.expireAt(timeout)
+	return nil
+}
+
+// LockInfo returns the lease of the lock at the location, which
+// only informs the number of readers when it is read locked.
+func (s *Store) LockInfo(ctx context.Context, loc *eventstore.LocationType) (server.Lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if l := s.lookupLock(loc); l != nil {
+		return s.lease(l), nil
+	}
+
+	if n := len(s.holdersAt(s.readers, loc, false)); n != 0 {
+		return server.Lease{Readers: n}, nil
+	}
+
+	return server.Lease{}, errLockNotFound(loc)
+}
+
+// RLock acquires shared access to the location and returns
+// the token needed to unlock it. A non zero timeout releases
+// the shared lock automatically once elapsed.
+func (s *Store) RLock(ctx context.Context, loc *eventstore.LocationType, timeout time.Duration) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lookupLock(loc) != nil {
+		return "", fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrLocked)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return "", fmt.Errorf("generating unlock token: %w", err)
+	}
+
+	s.holdersAt(s.readers, loc, true)[token] = &holder{permits: 1, expireAt: s.expireAt(timeout)}
+
+	return token, nil
+}
+
+// RUnlock releases the shared lock held using the token.
+func (s *Store) RUnlock(ctx context.Context, loc *eventstore.LocationType, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.release(s.readers, loc, token)
+}
+
+// lookupLock returns the live lock at the location, or nil when
+// the key is not locked. Must be called with the lock held.
+func (s *Store) lookupLock(loc *eventstore.LocationType) *lock {
+	l, ok := s.locks[scopeOf(loc.GetScope())][loc.GetKey()]
+	if !ok || l.expired(s.now()) {
+		return nil
+	}
+	return l
+}
+
+// heldLock returns the live lock at the location, failing if the
+// token does not match. Must be called with the lock held.
+func (s *Store) heldLock(loc *eventstore.LocationType, token string) (*lock, error) {
+	l := s.lookupLock(loc)
+	if l == nil {
+		return nil, errLockNotFound(loc)
+	}
+
+	if l.token != token {
+		return nil, fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrUnlockMismatch)
+	}
+
+	return l, nil
+}
+
+// lease describes the lock. Must be called with the lock held.
+func (s *Store) lease(l *lock) server.Lease {
+	lease := server.Lease{
+		Token:        l.token,
+		FencingToken: l.fencing,
+		Owner:        l.owner,
+	}
+	if !l.expireAt.IsZero() {
+		lease.TTL = l.expireAt.Sub(s.now())
+	}
+	return lease
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func errLockNotFound(loc *eventstore.LocationType) error {
+	return fmt.Errorf("lock for key %q: %w", loc.GetKey(), server.ErrNotFound)
+}
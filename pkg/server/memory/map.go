@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// HNew creates an empty map at the location.
+func (s *Store) HNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e != nil {
+		return errAlreadyExists(loc)
+	}
+
+	e := &entry{
+		Kind:     kindMap,
+		Fields:   make(map[string][]byte),
+		ExpireAt: s.expireAt(ttl),
+	}
+
+	return s.put(loc, e)
+}
+
+// HSet stores the value at a map field, which expires after
+// the TTL when it is positive.
+func (s *Store) HSet(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.update(loc, e, &mutation{
+		Op:       opHSet,
+		Now:      s.now(),
+		Field:    field,
+		Value:    value,
+		ExpireAt: s.expireAt(ttl),
+	})
+	return err
+}
+
+// HSetNX stores the value at a map field if the field does not exist.
+func (s *Store) HSetNX(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := e.Fields[field]; ok {
+		return fmt.Errorf("field %q at key %q exists: %w", field, loc.GetKey(), server.ErrConflict)
+	}
+
+	_, err = s.update(loc, e, &mutation{
+		Op:       opHSet,
+		Now:      s.now(),
+		Field:    field,
+		Value:    value,
+		ExpireAt: s.expireAt(ttl),
+	})
+	return err
+}
+
+// HGet returns the value stored at a map field.
+func (s *Store) HGet(ctx context.Context, loc *eventstore.LocationType, field string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := e.Fields[field]
+	if !ok {
+		return nil, errFieldNotFound(loc, field)
+	}
+
+	return v, nil
+}
+
+// HDel removes a map field.
+func (s *Store) HDel(ctx context.Context, loc *eventstore.LocationType, field string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := e.Fields[field]; !ok {
+		return errFieldNotFound(loc, field)
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opHDel, Now: s.now(), Field: field})
+	return err
+}
+
+// HIncrBy adds delta to the integer stored at a map field and returns
+// the resulting value. Missing fields are created with a zero value.
+func (s *Store) HIncrBy(ctx context.Context, loc *eventstore.LocationType, field string, delta int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := incr(e.Fields[field], delta)
+	if err != nil {
+		return 0, err
+	}
+
+	m := &mutation{Op: opHUpdate, Now: s.now(), Field: field, Value: []byte(strconv.FormatInt(v, 10))}
+	if _, err := s.update(loc, e, m); err != nil {
+		return 0, err
+	}
+
+	return v, nil
+}
+
+// HIncrByFloat adds delta to the number stored at a map field and
+// returns the result. Missing fields are created with a zero value.
+func (s *Store) HIncrByFloat(ctx context.Context, loc *eventstore.LocationType, field string, delta float64) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := incrFloat(e.Fields[field], delta)
+	if err != nil {
+		return 0, err
+	}
+
+	m := &mutation{Op: opHUpdate, Now: s.now(), Field: field, Value: []byte(strconv.FormatFloat(v, 'f', -1, 64))}
+	if _, err := s.update(loc, e, m); err != nil {
+		return 0, err
+	}
+
+	return v, nil
+}
+
+// HGetAll returns a copy of all map fields.
+func (s *Store) HGetAll(ctx context.Context, loc *eventstore.LocationType) (map[string][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string][]byte, len(e.Fields))
+	for k, v := range e.Fields {
+		fields[k] = v
+	}
+
+	return fields, nil
+}
+
+// HLen returns the number of map fields.
+func (s *Store) HLen(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupMap(loc)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(e.Fields), nil
+}
+
+// lookupMap works like lookupKind for maps, removing the fields
+// that expired. Must be called with the lock held.
+func (s *Store) lookupMap(loc *eventstore.LocationType) (*entry, error) {
+	e, err := s.lookupKind(loc, kindMap)
+	if err != nil {
+		return nil, err
+	}
+
+	e.pruneFields(s.now())
+	return e, nil
+}
+
+// setField stores the value at a map field, which expires at
+// expireAt unless it is zero.
+func (e *entry) setField(field string, value []byte, expireAt time.Time) {
+	e.Fields[field] = value
+
+	if expireAt.IsZero() {
+		delete(e.FieldExpireAt, field)
+		return
+	}
+
+	if e.FieldExpireAt == nil {
+		e.FieldExpireAt = make(map[string]time.Time)
+	}
+	e.FieldExpireAt[field] = expireAt
+}
+
+// delField removes a map field.
+func (e *entry) delField(field string) {
+	delete(e.Fields, field)
+	delete(e.FieldExpireAt, field)
+}
+
+// pruneFields removes the map fields that expired. Changes are not
+// journaled, expired fields are pruned again when restored.
+func (e *entry) pruneFields(now time.Time) {
+	for f, t := range e.FieldExpireAt {
+		if !now.Before(t) {
+			e.delField(f)
+		}
+	}
+}
+
+func errAlreadyExists(loc *eventstore.LocationType) error {
+	return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrAlreadyExists)
+}
+
+func errFieldNotFound(loc *eventstore.LocationType, field string) error {
+	return fmt.Errorf("field %q at key %q: %w", field, loc.GetKey(), server.ErrNotFound)
+}
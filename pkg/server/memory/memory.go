@@ -0,0 +1,454 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package memory implements an EventStore that keeps all
+// data in process memory.
+package memory
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+const defaultSweepInterval = time.Second
+
+type kind int
+
+const (
+	kindKV kind = iota
+	kindMap
+	kindQueue
+	kindSet
+	kindSortedSet
+	kindStream
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindKV:
+		return "kv"
+	case kindMap:
+		return "map"
+	case kindQueue:
+		return "queue"
+	case kindSet:
+		return "set"
+	case kindSortedSet:
+		return "sorted set"
+	case kindStream:
+		return "stream"
+	}
+	return "unknown"
+}
+
+// entry is a value stored at a key. Only the fields
+// that match the entry kind are populated.
+type entry struct {
+	Kind     kind
+	Version  uint64
+	Value    []byte
+	Fields   map[string][]byte
+	Members  map[string]bool
+	Scores   map[string]float64
+	Items    [][]byte
+	Reserved []*reservation
+	// Scheduled queue items that are still hidden, the buckets
+	// of visible items pushed with a priority, and the sequence
+	// that keeps the push order of scheduled items.
+	Scheduled schedule
+	Buckets   []*bucket
+	Seq       uint64
+	// Limit of queues, enforced by pushes.
+	Limit server.QueueLimit
+	// FieldExpireAt keeps the expiration of map fields
+	// that do not live as long as the map.
+	FieldExpireAt map[string]time.Time
+	// Stream entries, their retention, the offset of the last
+	// appended entry and the offsets committed by consumer groups.
+	Entries    []server.StreamEntry
+	Retention  server.StreamRetention
+	LastOffset int64
+	Offsets    map[string]int64
+	ExpireAt   time.Time
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return !e.ExpireAt.IsZero() && !now.Before(e.ExpireAt)
+}
+
+type lock struct {
+	token    string
+	fencing  uint64
+	owner    string
+	expireAt time.Time
+}
+
+func (l *lock) expired(now time.Time) bool {
+	return !l.expireAt.IsZero() && !now.Before(l.expireAt)
+}
+
+// holders of shared locks or semaphore permits by token.
+type holders map[string]*holder
+
+type holder struct {
+	permits  int
+	expireAt time.Time
+}
+
+// prune removes expired holders and returns the
+// number of permits held by the rest.
+func (hs holders) prune(now time.Time) int {
+	n := 0
+	for token, h := range hs {
+		if !h.expireAt.IsZero() && !now.Before(h.expireAt) {
+			delete(hs, token)
+			continue
+		}
+		n += h.permits
+	}
+	return n
+}
+
+// scope isolates keys stored at each of the
+// global, bridge and instance levels.
+type scope struct {
+	typ      eventstore.ScopeChoice
+	bridge   string
+	instance string
+}
+
+func (sc scope) location(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{
+			Type:     sc.typ,
+			Bridge:   sc.bridge,
+			Instance: sc.instance,
+		},
+		Key: key,
+	}
+}
+
+func scopeOf(s *eventstore.ScopeType) scope {
+	return scope{
+		typ:      s.GetType(),
+		bridge:   s.GetBridge(),
+		instance: s.GetInstance(),
+	}
+}
+
+// Store keeps KV entries, maps, queues, sets, sorted sets, streams,
+// locks and semaphores in memory.
+type Store struct {
+	mu    sync.Mutex
+	data  map[scope]map[string]*entry
+	locks map[scope]map[string]*lock
+	// readers hold shared locks, which share
+	// the namespace of exclusive locks.
+	readers    map[scope]map[string]holders
+	semaphores map[scope]map[string]holders
+	// version is the last version assigned to a KV entry,
+	// also used for the fencing tokens of locks.
+	version uint64
+
+	journal       Journal
+	sweepInterval time.Duration
+	now           func() time.Time
+	onExpire      func(loc *eventstore.LocationType)
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+var (
+	_ server.Storage        = (*Store)(nil)
+	_ server.ExpiryNotifier = (*Store)(nil)
+)
+
+// Option customizes the in-memory store.
+type Option func(*Store)
+
+// WithSweepInterval sets how often expired keys are removed
+// from memory. Expired keys are never returned regardless of
+// this setting.
+func WithSweepInterval(d time.Duration) Option {
+	return func(s *Store) {
+		s.sweepInterval = d
+	}
+}
+
+// New creates an in-memory store.
+func New(opts ...Option) *Store {
+	s := &Store{
+		data:          make(map[scope]map[string]*entry),
+		locks:         make(map[scope]map[string]*lock),
+		readers:       make(map[scope]map[string]holders),
+		semaphores:    make(map[scope]map[string]holders),
+		version:       uint64(time.Now().UnixNano()),
+		sweepInterval: defaultSweepInterval,
+		now:           time.Now,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	for _, f := range opts {
+		f(s)
+	}
+
+	go s.sweep()
+
+	return s
+}
+
+// Close stops background processing for the store.
+func (s *Store) Close() error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// NotifyExpired implements server.ExpiryNotifier.
+func (s *Store) NotifyExpired(f func(loc *eventstore.LocationType)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onExpire = f
+}
+
+// expired notifies that the entry at the location expired.
+// Must be called with the lock held.
+func (s *Store) expired(loc *eventstore.LocationType) {
+	if s.onExpire != nil {
+		s.onExpire(loc)
+	}
+}
+
+// sweep periodically removes expired entries, locks
+// and semaphore permits.
+func (s *Store) sweep() {
+	defer close(s.done)
+
+	t := time.NewTicker(s.sweepInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-t.C:
+			s.removeExpired()
+		}
+	}
+}
+
+func (s *Store) removeExpired() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for sc, ns := range s.data {
+		for k, e := range ns {
+			if e.expired(now) {
+				delete(ns, k)
+				s.expired(sc.location(k))
+			}
+		}
+		if len(ns) == 0 {
+			delete(s.data, sc)
+		}
+	}
+
+	for sc, ls := range s.locks {
+		for k, l := range ls {
+			if l.expired(now) {
+				delete(ls, k)
+			}
+		}
+		if len(ls) == 0 {
+			delete(s.locks, sc)
+		}
+	}
+
+	pruneHolders(s.readers, now)
+	pruneHolders(s.semaphores, now)
+}
+
+// pruneHolders removes expired holders, along with the
+// locations and scopes that are left without any.
+func pruneHolders(all map[scope]map[string]holders, now time.Time) {
+	for sc, ns := range all {
+		for k, hs := range ns {
+			if hs.prune(now) == 0 {
+				delete(ns, k)
+			}
+		}
+		if len(ns) == 0 {
+			delete(all, sc)
+		}
+	}
+}
+
+// holdersAt returns the live holders at the location, creating them
+// when missing if create is true. Must be called with the lock held.
+func (s *Store) holdersAt(all map[scope]map[string]holders, loc *eventstore.LocationType, create bool) holders {
+	sc := scopeOf(loc.GetScope())
+	ns, ok := all[sc]
+	if !ok {
+		if !create {
+			return nil
+		}
+		ns = make(map[string]holders)
+		all[sc] = ns
+	}
+
+	hs, ok := ns[loc.GetKey()]
+	if !ok {
+		if !create {
+			return nil
+		}
+		hs = make(holders)
+		ns[loc.GetKey()] = hs
+	}
+
+	hs.prune(s.now())
+	return hs
+}
+
+// release removes the holder with the token at the location, failing
+// with ErrNotFound when there is none. Must be called with the lock held.
+func (s *Store) release(all map[scope]map[string]holders, loc *eventstore.LocationType, token string) error {
+	hs := s.holdersAt(all, loc, false)
+	if _, ok := hs[token]; !ok {
+		return fmt.Errorf("holder %q for key %q: %w", token, loc.GetKey(), server.ErrNotFound)
+	}
+	delete(hs, token)
+
+	if len(hs) == 0 {
+		sc := scopeOf(loc.GetScope())
+		delete(all[sc], loc.GetKey())
+		if len(all[sc]) == 0 {
+			delete(all, sc)
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the live entry at the location, or nil when
+// the key does not exist. Must be called with the lock held.
+func (s *Store) lookup(loc *eventstore.LocationType) *entry {
+	sc := scopeOf(loc.GetScope())
+	ns, ok := s.data[sc]
+	if !ok {
+		return nil
+	}
+
+	e, ok := ns[loc.GetKey()]
+	if !ok {
+		return nil
+	}
+
+	if e.expired(s.now()) {
+		s.remove(loc)
+		s.expired(loc)
+		return nil
+	}
+
+	return e
+}
+
+// lookupKind returns the live entry at the location, failing if the
+// key does not exist or holds a different kind of value. Must be
+// called with the lock held.
+func (s *Store) lookupKind(loc *eventstore.LocationType, k kind) (*entry, error) {
+	e := s.lookup(loc)
+	if e == nil {
+		return nil, errNotFound(loc)
+	}
+
+	if e.Kind != k {
+		return nil, errWrongKind(loc, e.Kind, k)
+	}
+
+	return e, nil
+}
+
+// store writes the entry at the location. Must be called
+// with the lock held.
+func (s *Store) store(loc *eventstore.LocationType, e *entry) {
+	sc := scopeOf(loc.GetScope())
+	ns, ok := s.data[sc]
+	if !ok {
+		ns = make(map[string]*entry)
+		s.data[sc] = ns
+	}
+
+	ns[loc.GetKey()] = e
+}
+
+// put journals the entry and stores it at the location, leaving the
+// location untouched when the entry cannot be journaled. Must be
+// called with the lock held.
+func (s *Store) put(loc *eventstore.LocationType, e *entry) error {
+	if err := s.persist(loc, e); err != nil {
+		return err
+	}
+
+	s.store(loc, e)
+	return nil
+}
+
+// bump assigns a new version to the entry. Versions start at the
+// creation time of the store, which avoids reusing versions of deleted
+// keys when the store is restored. Must be called with the lock held.
+func (s *Store) bump(e *entry) {
+	s.version++
+	e.Version = s.version
+}
+
+// remove deletes the entry at the location. Must be called
+// with the lock held.
+func (s *Store) remove(loc *eventstore.LocationType) {
+	sc := scopeOf(loc.GetScope())
+	ns, ok := s.data[sc]
+	if !ok {
+		return
+	}
+
+	delete(ns, loc.GetKey())
+	if len(ns) == 0 {
+		delete(s.data, sc)
+	}
+}
+
+// expireAt returns the expiration time for a TTL,
+// zero meaning the entry never expires.
+func (s *Store) expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return s.now().Add(ttl)
+}
+
+func errNotFound(loc *eventstore.LocationType) error {
+	return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrNotFound)
+}
+
+func errWrongKind(loc *eventstore.LocationType, got, expected kind) error {
+	return fmt.Errorf("key %q holds a %s, not a %s: %w", loc.GetKey(), got, expected, server.ErrWrongKind)
+}
@@ -0,0 +1,1412 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+const (
+	tBridge   = "test-bridge"
+	tInstance = "test-instance"
+	tKey      = "test-key"
+)
+
+var tValue = []byte("test-value")
+
+type fakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+func withNow(f func() time.Time) Option {
+	return func(s *Store) {
+		s.now = f
+	}
+}
+
+func newTestStore(t *testing.T) (*Store, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := New(WithSweepInterval(time.Hour), withNow(clock.now))
+	t.Cleanup(func() { _ = s.Close() })
+	return s, clock
+}
+
+func globalLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Global},
+		Key:   key,
+	}
+}
+
+func bridgeLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Bridge, Bridge: tBridge},
+		Key:   key,
+	}
+}
+
+func instanceLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Instance, Bridge: tBridge, Instance: tInstance},
+		Key:   key,
+	}
+}
+
+func TestKV(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	_, _, err := s.Get(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+
+	require.NoError(t, err)
+	v, _, err := s.Get(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+
+	require.NoError(t, s.Del(ctx, loc))
+	_, _, err = s.Get(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	assert.ErrorIs(t, s.Del(ctx, loc), server.ErrNotFound)
+}
+
+func TestScopeIsolation(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+
+	locations := []*eventstore.LocationType{
+		globalLocation(tKey),
+		bridgeLocation(tKey),
+		instanceLocation(tKey),
+		{
+			Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Instance, Bridge: tBridge, Instance: "other-instance"},
+			Key:   tKey,
+		},
+	}
+
+	for i, loc := range locations {
+		_, err := s.Set(ctx, loc, []byte{byte(i)}, 0)
+		require.NoError(t, err)
+	}
+
+	for i, loc := range locations {
+		v, _, err := s.Get(ctx, loc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, v, "unexpected value at %s scope", loc.Scope.Type)
+	}
+
+	require.NoError(t, s.Del(ctx, locations[1]))
+	_, _, err := s.Get(ctx, locations[2])
+	assert.NoError(t, err, "deleting a bridge key should not affect instance keys")
+}
+
+func TestExpiry(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+
+	var expired []string
+	s.NotifyExpired(func(loc *eventstore.LocationType) {
+		expired = append(expired, loc.Key)
+	})
+
+	kv, m, q := bridgeLocation("kv"), bridgeLocation("map"), bridgeLocation("queue")
+	_, err := s.Set(ctx, kv, tValue, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, m, 20*time.Second))
+	require.NoError(t, s.LNew(ctx, q, 0, server.QueueLimit{}))
+	_, err = s.Lock(ctx, bridgeLocation("lock"), 10*time.Second, "")
+	require.NoError(t, err)
+
+	clock.advance(10 * time.Second)
+
+	_, _, err = s.Get(ctx, kv)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, err = s.HLen(ctx, m)
+	assert.NoError(t, err)
+	_, err = s.Lock(ctx, bridgeLocation("lock"), 0, "")
+	assert.NoError(t, err, "expired lock should be acquirable")
+
+	clock.advance(10 * time.Second)
+	s.removeExpired()
+
+	_, err = s.HLen(ctx, m)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, err = s.LLen(ctx, q)
+	assert.NoError(t, err, "entries without TTL should never expire")
+
+	s.mu.Lock()
+	assert.Len(t, s.data[scopeOf(kv.Scope)], 1, "expired entries should be swept")
+	s.mu.Unlock()
+
+	assert.Equal(t, []string{"kv", "map"}, expired)
+}
+
+func TestScan(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+
+	_, err := s.Set(ctx, bridgeLocation("a/kv"), tValue, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, bridgeLocation("a/map"), 0))
+	require.NoError(t, s.LNew(ctx, bridgeLocation("a/queue"), 0, server.QueueLimit{}))
+	_, err = s.Set(ctx, bridgeLocation("b/kv"), tValue, 0)
+	require.NoError(t, err)
+	_, err = s.Set(ctx, instanceLocation("a/other"), tValue, 0)
+	require.NoError(t, err)
+
+	clock.advance(time.Second)
+
+	keys, cursor, err := s.Scan(ctx, bridgeLocation("").Scope, "a/", "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []server.KeyInfo{
+		{Key: "a/kv", Kind: server.KindKV, TTL: 9 * time.Second},
+		{Key: "a/map", Kind: server.KindMap},
+	}, keys)
+	assert.Equal(t, "a/map", cursor)
+
+	keys, cursor, err = s.Scan(ctx, bridgeLocation("").Scope, "a/", cursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []server.KeyInfo{{Key: "a/queue", Kind: server.KindQueue}}, keys)
+	assert.Empty(t, cursor)
+
+	clock.advance(10 * time.Second)
+
+	keys, _, err = s.Scan(ctx, bridgeLocation("").Scope, "", "", 10)
+	require.NoError(t, err)
+	assert.Len(t, keys, 3, "expired and instance keys should not be returned")
+}
+
+func TestDropScope(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+
+	_, err := s.Set(ctx, bridgeLocation("kv"), tValue, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, instanceLocation("map"), 0))
+	_, err = s.Lock(ctx, instanceLocation("lock"), 0, "")
+	require.NoError(t, err)
+	_, err = s.Set(ctx, globalLocation("kv"), tValue, 0)
+	require.NoError(t, err)
+	other := &eventstore.ScopeType{Type: eventstore.ScopeChoice_Bridge, Bridge: "other"}
+	_, err = s.Set(ctx, &eventstore.LocationType{Scope: other, Key: "kv"}, tValue, 0)
+	require.NoError(t, err)
+
+	dropped, err := s.DropScope(ctx, instanceLocation("").Scope)
+	require.NoError(t, err)
+	assert.Equal(t, []*eventstore.LocationType{instanceLocation("map")}, dropped)
+	_, _, err = s.Get(ctx, bridgeLocation("kv"))
+	assert.NoError(t, err, "dropping an instance should not affect its bridge")
+	_, err = s.Lock(ctx, instanceLocation("lock"), 0, "")
+	assert.NoError(t, err, "locks should be dropped")
+
+	require.NoError(t, s.HNew(ctx, instanceLocation("map"), 0))
+	dropped, err = s.DropScope(ctx, bridgeLocation("").Scope)
+	require.NoError(t, err)
+	assert.Len(t, dropped, 2, "dropping a bridge should drop its instances")
+
+	_, err = s.HLen(ctx, instanceLocation("map"))
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, _, err = s.Get(ctx, globalLocation("kv"))
+	assert.NoError(t, err)
+	_, _, err = s.Get(ctx, &eventstore.LocationType{Scope: other, Key: "kv"})
+	assert.NoError(t, err)
+}
+
+func TestTTL(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	kv, m := bridgeLocation("kv"), bridgeLocation("map")
+
+	_, err := s.TTL(ctx, kv)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	assert.ErrorIs(t, s.Expire(ctx, kv, time.Second), server.ErrNotFound)
+	assert.ErrorIs(t, s.Persist(ctx, kv), server.ErrNotFound)
+
+	version, err := s.Set(ctx, kv, tValue, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, m, 0))
+
+	clock.advance(4 * time.Second)
+	ttl, err := s.TTL(ctx, kv)
+	require.NoError(t, err)
+	assert.Equal(t, 6*time.Second, ttl)
+	ttl, err = s.TTL(ctx, m)
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+
+	require.NoError(t, s.Expire(ctx, kv, 20*time.Second))
+	require.NoError(t, s.Expire(ctx, m, 5*time.Second))
+	clock.advance(10 * time.Second)
+
+	_, err = s.HLen(ctx, m)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, v, err := s.Get(ctx, kv)
+	require.NoError(t, err)
+	assert.Equal(t, version, v, "expiring should not change the version")
+
+	require.NoError(t, s.Persist(ctx, kv))
+	clock.advance(time.Hour)
+	ttl, err = s.TTL(ctx, kv)
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+}
+
+func TestIncr(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	v, err := s.IncrBy(ctx, loc, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), v)
+
+	v, err = s.IncrBy(ctx, loc, -7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), v)
+
+	b, _, err := s.Get(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("-2"), b)
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+
+	require.NoError(t, err)
+	_, err = s.IncrBy(ctx, loc, 1)
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+}
+
+func TestIncrFloat(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	f, err := s.IncrByFloat(ctx, loc, 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, f)
+
+	_, err = s.IncrBy(ctx, loc, 1)
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+
+	f, err = s.IncrByFloat(ctx, loc, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, f)
+
+	n, err := s.IncrBy(ctx, loc, 1)
+	require.NoError(t, err, "integral results should be incremented as integers")
+	assert.Equal(t, int64(3), n)
+
+	_, err = s.IncrByFloat(ctx, loc, math.MaxFloat64)
+	require.NoError(t, err)
+	_, err = s.IncrByFloat(ctx, loc, math.MaxFloat64)
+	assert.ErrorIs(t, err, server.ErrOutOfRange)
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+	require.NoError(t, err)
+	_, err = s.IncrByFloat(ctx, loc, 1)
+	assert.ErrorIs(t, err, server.ErrNotFloat)
+
+	m := bridgeLocation(tKey)
+	require.NoError(t, s.HNew(ctx, m, 0))
+	f, err = s.HIncrByFloat(ctx, m, "counter", -0.25)
+	require.NoError(t, err)
+	assert.Equal(t, -0.25, f)
+	v, err := s.HGet(ctx, m, "counter")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("-0.25"), v)
+
+	require.NoError(t, s.HSet(ctx, m, "field", tValue, 0))
+	_, err = s.HIncrByFloat(ctx, m, "field", 1)
+	assert.ErrorIs(t, err, server.ErrNotFloat)
+}
+
+func TestConditionalSet(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	_, err := s.SetXX(ctx, loc, tValue, 0)
+	assert.ErrorIs(t, err, server.ErrConflict)
+	_, err = s.CompareAndSwap(ctx, loc, tValue, 0, 1)
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	v1, err := s.SetNX(ctx, loc, tValue, 0)
+	require.NoError(t, err)
+	assert.NotZero(t, v1)
+	_, err = s.SetNX(ctx, loc, tValue, 0)
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	_, version, err := s.Get(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, v1, version)
+
+	v2, err := s.SetXX(ctx, loc, []byte("2"), 0)
+	require.NoError(t, err)
+	assert.Greater(t, v2, v1)
+
+	_, err = s.CompareAndSwap(ctx, loc, []byte("3"), 0, v1)
+	assert.ErrorIs(t, err, server.ErrConflict)
+	v3, err := s.CompareAndSwap(ctx, loc, []byte("3"), 0, v2)
+	require.NoError(t, err)
+	assert.Greater(t, v3, v2)
+
+	n, err := s.IncrBy(ctx, loc, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+	_, err = s.CompareAndSwap(ctx, loc, tValue, 0, v3)
+	assert.ErrorIs(t, err, server.ErrConflict, "increments should change the version")
+
+	require.NoError(t, s.Del(ctx, loc))
+	v4, err := s.Set(ctx, loc, tValue, 0)
+	require.NoError(t, err)
+	assert.Greater(t, v4, v3, "versions should not be reused after deleting a key")
+
+	require.NoError(t, s.HNew(ctx, globalLocation(tKey), 0))
+	_, err = s.CompareAndSwap(ctx, globalLocation(tKey), tValue, 0, 1)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func TestTxn(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	queue := bridgeLocation("queue")
+	counts := bridgeLocation("counts")
+	total := bridgeLocation("total")
+
+	require.NoError(t, s.LNew(ctx, queue, 0, server.QueueLimit{}))
+	require.NoError(t, s.RPush(ctx, queue, tValue, server.PushOptions{}))
+	require.NoError(t, s.HNew(ctx, counts, 0))
+	version, err := s.Set(ctx, total, []byte("1"), 0)
+	require.NoError(t, err)
+
+	res, err := s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardVersion, Location: total, Version: version},
+		{Kind: server.GuardNotExists, Location: bridgeLocation(tKey)},
+	}, []server.Op{
+		{Kind: server.OpLPop, Location: queue},
+		{Kind: server.OpHIncrBy, Location: counts, Field: "items", Delta: 2},
+		{Kind: server.OpIncrBy, Location: total, Delta: 1},
+		{Kind: server.OpSet, Location: bridgeLocation(tKey), Value: tValue},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+	assert.Equal(t, tValue, res[0].Value)
+	assert.Equal(t, int64(2), res[1].Number)
+	assert.Equal(t, int64(2), res[2].Number)
+	assert.Greater(t, res[2].Version, version)
+	assert.Greater(t, res[3].Version, res[2].Version)
+
+	v, current, err := s.Get(ctx, total)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), v)
+	assert.Equal(t, res[2].Version, current)
+
+	_, err = s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardField, Location: counts, Field: "items", Value: []byte("1")},
+	}, []server.Op{
+		{Kind: server.OpDel, Location: total},
+	})
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	// The second pop fails, nothing is applied.
+	_, err = s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardField, Location: counts, Field: "items", Value: []byte("2")},
+		{Kind: server.GuardExists, Location: queue},
+	}, []server.Op{
+		{Kind: server.OpRPush, Location: queue, Value: tValue},
+		{Kind: server.OpHDel, Location: counts, Field: "items"},
+		{Kind: server.OpDel, Location: total},
+		{Kind: server.OpLPop, Location: queue},
+		{Kind: server.OpLPop, Location: queue},
+	})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	n, err := s.LLen(ctx, queue)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	v, err = s.HGet(ctx, counts, "items")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), v)
+	_, _, err = s.Get(ctx, total)
+	assert.NoError(t, err)
+
+	res, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpDel, Location: counts},
+		{Kind: server.OpHNew, Location: counts},
+		{Kind: server.OpHIncrBy, Location: counts, Field: "items", Delta: 1},
+		{Kind: server.OpRPush, Location: queue, Value: tValue},
+		{Kind: server.OpLPop, Location: queue},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), res[2].Number, "fields of deleted maps should not be kept")
+	assert.Equal(t, tValue, res[4].Value)
+
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpIncrBy, Location: bridgeLocation(tKey), Delta: 1},
+	})
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpHSet, Location: total, Field: "f", Value: tValue},
+	})
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+// failingJournal fails to record changes once err is set.
+type failingJournal struct {
+	err error
+}
+
+func (j *failingJournal) Put(key string, data []byte, expireAt time.Time) error {
+	return j.err
+}
+
+func (j *failingJournal) Append(key string, delta []byte, expireAt time.Time) error {
+	return j.err
+}
+
+func (j *failingJournal) Delete(key string) error {
+	return j.err
+}
+
+func (j *failingJournal) Commit(changes []Change) error {
+	return j.err
+}
+
+func TestTxnJournalFailure(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	j := &failingJournal{}
+	s := New(WithSweepInterval(time.Hour), withNow(clock.now), WithJournal(j))
+	t.Cleanup(func() { _ = s.Close() })
+	ctx := context.Background()
+	counts, total := bridgeLocation("counts"), bridgeLocation("total")
+
+	require.NoError(t, s.HNew(ctx, counts, 0))
+	require.NoError(t, s.HSet(ctx, counts, "items", []byte("1"), 0))
+	require.NoError(t, s.HSet(ctx, counts, "expiring", tValue, time.Second))
+	_, err := s.Set(ctx, total, []byte("1"), 0)
+	require.NoError(t, err)
+
+	clock.advance(time.Minute)
+	j.err = assert.AnError
+
+	_, err = s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardField, Location: counts, Field: "items", Value: []byte("1")},
+	}, []server.Op{
+		{Kind: server.OpHIncrBy, Location: counts, Field: "items", Delta: 1},
+		{Kind: server.OpDel, Location: total},
+	})
+	require.Error(t, err)
+
+	e := s.lookup(counts)
+	assert.Equal(t, []byte("1"), e.Fields["items"], "failed transactions should not be applied")
+	assert.Contains(t, e.Fields, "expiring", "checking guards should not modify entries")
+	_, _, err = s.Get(ctx, total)
+	assert.NoError(t, err, "failed transactions should not be applied")
+}
+
+func TestJournalFailure(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	j := &failingJournal{}
+	s := New(WithSweepInterval(time.Hour), withNow(clock.now), WithJournal(j))
+	t.Cleanup(func() { _ = s.Close() })
+	ctx := context.Background()
+	counts, queue, total := bridgeLocation("counts"), bridgeLocation("queue"), bridgeLocation("total")
+
+	require.NoError(t, s.HNew(ctx, counts, 0))
+	require.NoError(t, s.HSet(ctx, counts, "items", []byte("1"), 0))
+	require.NoError(t, s.LNew(ctx, queue, 0, server.QueueLimit{}))
+	require.NoError(t, s.RPush(ctx, queue, tValue, server.PushOptions{}))
+	_, err := s.Set(ctx, total, []byte("1"), 0)
+	require.NoError(t, err)
+
+	j.err = assert.AnError
+
+	assert.Error(t, s.HSet(ctx, counts, "items", []byte("2"), 0))
+	assert.Error(t, s.HDel(ctx, counts, "items"))
+	_, err = s.HIncrBy(ctx, counts, "items", 1)
+	assert.Error(t, err)
+	assert.Error(t, s.RPush(ctx, queue, []byte("other"), server.PushOptions{}))
+	_, err = s.LPop(ctx, queue)
+	assert.Error(t, err)
+	_, err = s.LReserve(ctx, queue, server.ReserveOptions{Visibility: time.Minute})
+	assert.Error(t, err)
+	_, err = s.Set(ctx, total, []byte("2"), 0)
+	assert.Error(t, err)
+	_, err = s.IncrBy(ctx, total, 1)
+	assert.Error(t, err)
+	assert.Error(t, s.Del(ctx, total))
+	assert.Error(t, s.HNew(ctx, bridgeLocation("created"), 0))
+
+	j.err = nil
+
+	v, err := s.HGet(ctx, counts, "items")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), v, "changes that fail to be journaled should not be applied")
+	items, err := s.LRange(ctx, queue, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{tValue}, items, "changes that fail to be journaled should not be applied")
+	v, _, err = s.Get(ctx, total)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), v, "changes that fail to be journaled should not be applied")
+	_, err = s.HLen(ctx, bridgeLocation("created"))
+	assert.ErrorIs(t, err, server.ErrNotFound, "entries that fail to be journaled should not be created")
+}
+
+// recordingJournal keeps the latest entry recorded for
+// every key, along with the changes appended to it.
+type recordingJournal struct {
+	entries  map[string][]byte
+	appended map[string][][]byte
+}
+
+func newRecordingJournal() *recordingJournal {
+	return &recordingJournal{
+		entries:  make(map[string][]byte),
+		appended: make(map[string][][]byte),
+	}
+}
+
+func (j *recordingJournal) Put(key string, data []byte, expireAt time.Time) error {
+	j.entries[key] = data
+	delete(j.appended, key)
+	return nil
+}
+
+func (j *recordingJournal) Append(key string, delta []byte, expireAt time.Time) error {
+	j.appended[key] = append(j.appended[key], delta)
+	return nil
+}
+
+func (j *recordingJournal) Delete(key string) error {
+	delete(j.entries, key)
+	delete(j.appended, key)
+	return nil
+}
+
+func (j *recordingJournal) Commit(changes []Change) error {
+	for _, c := range changes {
+		switch {
+		case c.Delete:
+			_ = j.Delete(c.Key)
+		case c.Delta:
+			_ = j.Append(c.Key, c.Data, c.ExpireAt)
+		default:
+			_ = j.Put(c.Key, c.Data, c.ExpireAt)
+		}
+	}
+	return nil
+}
+
+func TestRestore(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	j := newRecordingJournal()
+	s := New(WithSweepInterval(time.Hour), withNow(clock.now), WithJournal(j))
+	t.Cleanup(func() { _ = s.Close() })
+	ctx := context.Background()
+	m, q, dl := bridgeLocation("map"), bridgeLocation("queue"), bridgeLocation("dead-letter")
+	set, zset, stream := bridgeLocation("set"), bridgeLocation("zset"), bridgeLocation("stream")
+
+	require.NoError(t, s.HNew(ctx, m, 0))
+	require.NoError(t, s.HSet(ctx, m, "a", []byte("1"), 0))
+	require.NoError(t, s.HSet(ctx, m, "expiring", []byte("1"), time.Second))
+	_, err := s.HIncrBy(ctx, m, "b", 2)
+	require.NoError(t, err)
+	require.NoError(t, s.HDel(ctx, m, "a"))
+	require.NoError(t, s.Expire(ctx, m, time.Hour))
+
+	require.NoError(t, s.LNew(ctx, q, 0, server.QueueLimit{MaxLen: 4, Overflow: server.OverflowDropOldest}))
+	require.NoError(t, s.LNew(ctx, dl, 0, server.QueueLimit{}))
+	require.NoError(t, s.RPush(ctx, q, []byte("a"), server.PushOptions{}))
+	require.NoError(t, s.RPush(ctx, q, []byte("urgent"), server.PushOptions{Priority: 1}))
+	require.NoError(t, s.RPush(ctx, q, []byte("later"), server.PushOptions{Delay: time.Minute}))
+	require.NoError(t, s.LPush(ctx, q, []byte("first")))
+	_, err = s.LPop(ctx, q)
+	require.NoError(t, err)
+	opts := server.ReserveOptions{Visibility: time.Second, MaxDeliveries: 1, DeadLetter: dl}
+	_, err = s.LReserve(ctx, q, opts)
+	require.NoError(t, err)
+	clock.advance(2 * time.Second)
+	require.NoError(t, s.RPush(ctx, q, []byte("b"), server.PushOptions{}))
+	require.NoError(t, s.RPush(ctx, q, []byte("c"), server.PushOptions{}))
+	res, err := s.LReserve(ctx, q, opts)
+	require.NoError(t, err)
+	require.Len(t, res.DeadLetters, 1)
+	require.NoError(t, s.LNack(ctx, q, res.ID))
+	require.NoError(t, s.RPush(ctx, q, []byte("d"), server.PushOptions{}))
+
+	require.NoError(t, s.SNew(ctx, set, 0))
+	_, err = s.SAdd(ctx, set, []string{"a", "b", "c"})
+	require.NoError(t, err)
+	_, err = s.SRem(ctx, set, []string{"a"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.ZNew(ctx, zset, 0))
+	_, err = s.ZAdd(ctx, zset, []server.ScoredMember{{Member: "a", Score: 1}, {Member: "b", Score: 2}})
+	require.NoError(t, err)
+	_, err = s.ZIncrBy(ctx, zset, "a", 2)
+	require.NoError(t, err)
+	_, err = s.ZRemRangeByScore(ctx, zset, 2, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, s.XNew(ctx, stream, 0, server.StreamRetention{MaxLen: 2}))
+	for _, v := range []string{"e1", "e2", "e3"} {
+		_, err = s.XAdd(ctx, stream, []byte(v))
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.XCommit(ctx, stream, "g", 3))
+
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpHSet, Location: m, Field: "txn", Value: tValue},
+		{Kind: server.OpLPop, Location: q},
+	})
+	require.NoError(t, err)
+
+	// Entries are restored by applying the changes appended to them,
+	// and by journals merging them before restoring the entries.
+	restored := New(WithSweepInterval(time.Hour), withNow(clock.now))
+	t.Cleanup(func() { _ = restored.Close() })
+	merged := New(WithSweepInterval(time.Hour), withNow(clock.now))
+	t.Cleanup(func() { _ = merged.Close() })
+	for k, data := range j.entries {
+		require.NoError(t, restored.Restore(k, data, j.appended[k]...))
+
+		data, err := Merge(data, j.appended[k])
+		require.NoError(t, err)
+		require.NoError(t, merged.Restore(k, data))
+	}
+
+	state := func(s *Store) []interface{} {
+		fields, err := s.HGetAll(ctx, m)
+		require.NoError(t, err)
+		ttl, err := s.TTL(ctx, m)
+		require.NoError(t, err)
+		items, err := s.LRange(ctx, q, 0, -1)
+		require.NoError(t, err)
+		dead, err := s.LRange(ctx, dl, 0, -1)
+		require.NoError(t, err)
+		members, err := s.SCard(ctx, set)
+		require.NoError(t, err)
+		scored, err := s.ZRange(ctx, zset, 0, -1)
+		require.NoError(t, err)
+		entries, err := s.XRange(ctx, stream, 0, 0)
+		require.NoError(t, err)
+		offset, err := s.XOffset(ctx, stream, "g")
+		require.NoError(t, err)
+		return []interface{}{fields, ttl, items, dead, members, scored, len(entries), entries[0].Value, offset}
+	}
+
+	expected := state(s)
+	assert.Equal(t, expected, state(restored))
+	assert.Equal(t, expected, state(merged))
+
+	// hidden items and reservations are restored too
+	clock.advance(time.Minute)
+	expected = state(s)
+	assert.Equal(t, expected, state(restored))
+	assert.Equal(t, expected, state(merged))
+
+	for _, s := range []*Store{s, restored, merged} {
+		res, err := s.LReserve(ctx, q, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("c"), res.Value)
+		assert.Equal(t, [][]byte{[]byte("a")}, res.DeadLetters)
+	}
+}
+
+func TestMap(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	assert.ErrorIs(t, s.HSet(ctx, loc, "field", tValue, 0), server.ErrNotFound)
+
+	require.NoError(t, s.HNew(ctx, loc, 0))
+	assert.ErrorIs(t, s.HNew(ctx, loc, 0), server.ErrAlreadyExists)
+
+	require.NoError(t, s.HSet(ctx, loc, "field", tValue, 0))
+	v, err := s.HGet(ctx, loc, "field")
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+
+	_, err = s.HGet(ctx, loc, "missing")
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	n, err := s.HIncrBy(ctx, loc, "counter", 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	l, err := s.HLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+
+	all, err := s.HGetAll(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"field": tValue, "counter": []byte("3")}, all)
+
+	require.NoError(t, s.HDel(ctx, loc, "field"))
+	assert.ErrorIs(t, s.HDel(ctx, loc, "field"), server.ErrNotFound)
+
+	_, _, err = s.Get(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+
+	require.NoError(t, s.Del(ctx, loc))
+	_, err = s.HLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+}
+
+func TestMapFieldTTL(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	require.NoError(t, s.HNew(ctx, loc, time.Hour))
+	require.NoError(t, s.HSet(ctx, loc, "short", tValue, time.Second))
+	require.NoError(t, s.HSet(ctx, loc, "long", tValue, time.Minute))
+	require.NoError(t, s.HSet(ctx, loc, "kept", tValue, 0))
+
+	err := s.HSetNX(ctx, loc, "short", []byte("other"), 0)
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	clock.advance(2 * time.Second)
+
+	_, err = s.HGet(ctx, loc, "short")
+	assert.ErrorIs(t, err, server.ErrNotFound, "expired fields should not be found")
+	assert.ErrorIs(t, s.HDel(ctx, loc, "short"), server.ErrNotFound)
+
+	l, err := s.HLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+
+	// expired fields can be set again, and increments start from zero.
+	require.NoError(t, s.HSetNX(ctx, loc, "short", []byte("1"), time.Second))
+	clock.advance(2 * time.Second)
+	n, err := s.HIncrBy(ctx, loc, "short", 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	// setting a field without TTL keeps it as long as the map.
+	require.NoError(t, s.HSet(ctx, loc, "long", tValue, 0))
+
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpHSet, Location: loc, Field: "txn", Value: tValue, TTL: time.Second},
+	})
+	require.NoError(t, err)
+
+	clock.advance(2 * time.Minute)
+
+	all, err := s.HGetAll(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"short": []byte("3"), "long": tValue, "kept": tValue}, all)
+}
+
+func TestQueue(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	assert.ErrorIs(t, s.RPush(ctx, loc, tValue, server.PushOptions{}), server.ErrNotFound)
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	_, err := s.LPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	for _, v := range []string{"a", "b", "c"} {
+		require.NoError(t, s.RPush(ctx, loc, []byte(v), server.PushOptions{}))
+	}
+
+	v, err := s.LIndex(ctx, loc, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("b"), v)
+
+	_, err = s.LIndex(ctx, loc, 3)
+	assert.ErrorIs(t, err, server.ErrOutOfRange)
+
+	items, err := s.LRange(ctx, loc, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("c")}, items)
+
+	v, err = s.LPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), v)
+
+	l, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+}
+
+func TestDoubleEndedQueue(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	_, err := s.RPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.RPush(ctx, loc, []byte("b"), server.PushOptions{}))
+	require.NoError(t, s.LPush(ctx, loc, []byte("a")))
+	require.NoError(t, s.RPush(ctx, loc, []byte("c"), server.PushOptions{}))
+	require.NoError(t, s.RPush(ctx, loc, []byte("d"), server.PushOptions{}))
+
+	items, err := s.LRange(ctx, loc, 1, -2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	v, err := s.RPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("d"), v)
+
+	require.NoError(t, s.LTrim(ctx, loc, 1, 5))
+	items, err = s.LRange(ctx, loc, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	require.NoError(t, s.LTrim(ctx, loc, 2, 1))
+	l, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Zero(t, l)
+
+	require.NoError(t, s.LPush(ctx, loc, tValue))
+	v, err = s.LPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+}
+
+func TestBoundedQueue(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	reject, drop := instanceLocation("reject"), instanceLocation("drop")
+
+	require.NoError(t, s.LNew(ctx, reject, 0, server.QueueLimit{MaxLen: 2}))
+	require.NoError(t, s.LNew(ctx, drop, 0, server.QueueLimit{MaxLen: 2, Overflow: server.OverflowDropOldest}))
+
+	for _, v := range []string{"a", "b"} {
+		require.NoError(t, s.RPush(ctx, reject, []byte(v), server.PushOptions{}))
+		require.NoError(t, s.RPush(ctx, drop, []byte(v), server.PushOptions{}))
+	}
+
+	assert.ErrorIs(t, s.RPush(ctx, reject, []byte("c"), server.PushOptions{}), server.ErrQueueFull)
+	assert.ErrorIs(t, s.LPush(ctx, reject, []byte("c")), server.ErrQueueFull)
+
+	require.NoError(t, s.RPush(ctx, drop, []byte("c"), server.PushOptions{}))
+	items, err := s.LRange(ctx, drop, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	require.NoError(t, s.LPush(ctx, drop, []byte("a")))
+	items, err = s.LRange(ctx, drop, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, items)
+
+	_, err = s.Txn(ctx, nil, []server.Op{{Kind: server.OpRPush, Location: reject, Value: tValue}})
+	assert.ErrorIs(t, err, server.ErrQueueFull)
+
+	// The limit is kept when the queue is trimmed.
+	require.NoError(t, s.LTrim(ctx, reject, 0, 0))
+	require.NoError(t, s.RPush(ctx, reject, tValue, server.PushOptions{}))
+	assert.ErrorIs(t, s.RPush(ctx, reject, tValue, server.PushOptions{}), server.ErrQueueFull)
+}
+
+func TestScheduledQueue(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc, bounded := instanceLocation("queue"), instanceLocation("bounded")
+
+	push := func(loc *eventstore.LocationType, v string, priority int, delay time.Duration) error {
+		return s.RPush(ctx, loc, []byte(v), server.PushOptions{Priority: priority, Delay: delay})
+	}
+	all := func() []string {
+		items, err := s.LRange(ctx, loc, 0, -1)
+		require.NoError(t, err)
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, string(item))
+		}
+		return values
+	}
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	require.NoError(t, push(loc, "a", 0, 0))
+	require.NoError(t, push(loc, "low", -1, 0))
+	require.NoError(t, push(loc, "b", 0, 0))
+	require.NoError(t, push(loc, "high", 5, 0))
+	require.NoError(t, push(loc, "later", 0, 10*time.Second))
+	require.NoError(t, push(loc, "urgent", 10, time.Minute))
+	assert.Equal(t, []string{"high", "a", "b", "low"}, all())
+
+	n, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n, "hidden items should not be counted")
+
+	// Items pushed once a delayed item is visible are popped after it.
+	clock.advance(10 * time.Second)
+	require.NoError(t, push(loc, "c", 0, 0))
+	assert.Equal(t, []string{"high", "a", "b", "later", "c", "low"}, all())
+
+	v, err := s.LIndex(ctx, loc, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("later"), v)
+
+	clock.advance(time.Minute)
+	for _, expected := range []string{"urgent", "high"} {
+		v, err = s.LPop(ctx, loc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(expected), v)
+	}
+	v, err = s.RPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("low"), v)
+
+	require.NoError(t, s.LPush(ctx, loc, []byte("first")))
+	require.NoError(t, s.LTrim(ctx, loc, 1, -2))
+	assert.Equal(t, []string{"a", "b", "later"}, all())
+
+	res, err := s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpRPush, Location: loc, Value: []byte("top"), Push: server.PushOptions{Priority: 1}},
+		{Kind: server.OpLPop, Location: loc},
+		{Kind: server.OpLPop, Location: loc},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("top"), res[1].Value)
+	assert.Equal(t, []byte("a"), res[2].Value)
+	assert.Equal(t, []string{"b", "later"}, all())
+
+	// Delayed items are visible in the order of their delays.
+	require.NoError(t, push(loc, "slow", 0, 2*time.Second))
+	require.NoError(t, push(loc, "fast", 0, time.Second))
+	clock.advance(2 * time.Second)
+	assert.Equal(t, []string{"b", "later", "fast", "slow"}, all())
+
+	// Hidden items count towards the limit, but are never dropped.
+	require.NoError(t, s.LNew(ctx, bounded, 0, server.QueueLimit{MaxLen: 1, Overflow: server.OverflowDropOldest}))
+	require.NoError(t, push(bounded, "x", 0, time.Minute))
+	assert.ErrorIs(t, push(bounded, "y", 0, 0), server.ErrQueueFull)
+}
+
+func TestReserve(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc, dl := instanceLocation("queue"), instanceLocation("dead-letter")
+	opts := server.ReserveOptions{Visibility: 10 * time.Second, MaxDeliveries: 2, DeadLetter: dl}
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	_, err := s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound, "dead-letter queue should exist")
+
+	require.NoError(t, s.LNew(ctx, dl, 0, server.QueueLimit{}))
+	_, err = s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	for _, v := range []string{"a", "b"} {
+		require.NoError(t, s.RPush(ctx, loc, []byte(v), server.PushOptions{}))
+	}
+
+	a, err := s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), a.Value)
+	assert.Equal(t, 1, a.Deliveries)
+
+	l, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l, "reserved items should not be counted")
+
+	b, err := s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("b"), b.Value)
+	require.NoError(t, s.LAck(ctx, loc, b.ID))
+	assert.ErrorIs(t, s.LAck(ctx, loc, b.ID), server.ErrNotFound)
+
+	_, err = s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound, "reserved items should be hidden")
+
+	clock.advance(10 * time.Second)
+
+	a, err = s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), a.Value)
+	assert.Equal(t, 2, a.Deliveries)
+
+	require.NoError(t, s.LNack(ctx, loc, a.ID))
+	assert.ErrorIs(t, s.LNack(ctx, loc, "unknown"), server.ErrNotFound)
+
+	res, err := s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	assert.Equal(t, [][]byte{[]byte("a")}, res.DeadLetters)
+
+	items, err := s.LRange(ctx, dl, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a")}, items)
+
+	require.NoError(t, s.RPush(ctx, loc, []byte("c"), server.PushOptions{}))
+	c, err := s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	require.NoError(t, s.Del(ctx, loc))
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	assert.ErrorIs(t, s.LAck(ctx, loc, c.ID), server.ErrNotFound, "reservations should be removed with the queue")
+}
+
+func TestReserveDeadLetterLimit(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc, reject, drop := instanceLocation("queue"), instanceLocation("reject"), instanceLocation("drop")
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	require.NoError(t, s.LNew(ctx, reject, 0, server.QueueLimit{MaxLen: 1}))
+	require.NoError(t, s.LNew(ctx, drop, 0, server.QueueLimit{MaxLen: 1, Overflow: server.OverflowDropOldest}))
+	for _, dl := range []*eventstore.LocationType{reject, drop} {
+		require.NoError(t, s.RPush(ctx, dl, []byte("x"), server.PushOptions{}))
+	}
+
+	require.NoError(t, s.RPush(ctx, loc, []byte("a"), server.PushOptions{}))
+	a, err := s.LReserve(ctx, loc, server.ReserveOptions{Visibility: time.Minute})
+	require.NoError(t, err)
+	require.NoError(t, s.LNack(ctx, loc, a.ID))
+
+	// Dead letters are pushed like any other item.
+	_, err = s.LReserve(ctx, loc, server.ReserveOptions{Visibility: time.Minute, MaxDeliveries: 1, DeadLetter: reject})
+	assert.ErrorIs(t, err, server.ErrQueueFull)
+
+	res, err := s.LReserve(ctx, loc, server.ReserveOptions{Visibility: time.Minute, MaxDeliveries: 1, DeadLetter: drop})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	assert.Equal(t, [][]byte{[]byte("a")}, res.DeadLetters)
+
+	for dl, want := range map[*eventstore.LocationType]string{reject: "x", drop: "a"} {
+		items, err := s.LRange(ctx, dl, 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(want)}, items)
+	}
+}
+
+func TestSet(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	_, err := s.SAdd(ctx, loc, []string{"a"})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.SNew(ctx, loc, 0))
+	assert.ErrorIs(t, s.SNew(ctx, loc, 0), server.ErrAlreadyExists)
+
+	_, err = s.SPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	n, err := s.SAdd(ctx, loc, []string{"a", "b", "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = s.SAdd(ctx, loc, []string{"b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	ok, err := s.SIsMember(ctx, loc, "c")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	n, err = s.SRem(ctx, loc, []string{"c", "d"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	ok, err = s.SIsMember(ctx, loc, "c")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	members, err := s.SMembers(ctx, loc)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b"}, members)
+
+	m, err := s.SPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Contains(t, []string{"a", "b"}, m)
+
+	l, err := s.SCard(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l)
+
+	_, err = s.LLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+
+	keys, _, err := s.Scan(ctx, loc.Scope, "", "", 10)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, server.KindSet, keys[0].Kind)
+}
+
+func TestSortedSet(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	_, err := s.ZAdd(ctx, loc, []server.ScoredMember{{Member: "a", Score: 1}})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.ZNew(ctx, loc, 0))
+	assert.ErrorIs(t, s.ZNew(ctx, loc, 0), server.ErrAlreadyExists)
+
+	n, err := s.ZAdd(ctx, loc, []server.ScoredMember{
+		{Member: "c", Score: 3},
+		{Member: "a", Score: 1},
+		{Member: "b", Score: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	n, err = s.ZAdd(ctx, loc, []server.ScoredMember{{Member: "c", Score: 2}, {Member: "d", Score: 4}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "existing members should be updated")
+
+	score, err := s.ZIncrBy(ctx, loc, "e", 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, score)
+
+	members, err := s.ZRange(ctx, loc, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{
+		{Member: "a", Score: 1},
+		{Member: "b", Score: 1},
+		{Member: "e", Score: 1.5},
+		{Member: "c", Score: 2},
+		{Member: "d", Score: 4},
+	}, members)
+
+	members, err = s.ZRange(ctx, loc, -2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{{Member: "c", Score: 2}, {Member: "d", Score: 4}}, members)
+
+	members, err = s.ZRangeByScore(ctx, loc, 1.5, math.Inf(1), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{{Member: "e", Score: 1.5}, {Member: "c", Score: 2}}, members)
+
+	removed, err := s.ZRemRangeByScore(ctx, loc, math.Inf(-1), 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "e"}, removed)
+
+	l, err := s.ZCard(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+
+	_, err = s.ZIncrBy(ctx, loc, "d", math.MaxFloat64)
+	require.NoError(t, err)
+	_, err = s.ZIncrBy(ctx, loc, "d", math.MaxFloat64)
+	assert.ErrorIs(t, err, server.ErrOutOfRange)
+
+	_, err = s.SCard(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func TestStream(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	_, err := s.XAdd(ctx, loc, tValue)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.XNew(ctx, loc, 0, server.StreamRetention{MaxLen: 3, MaxAge: time.Minute}))
+	assert.ErrorIs(t, s.XNew(ctx, loc, 0, server.StreamRetention{}), server.ErrAlreadyExists)
+
+	for i := int64(1); i <= 4; i++ {
+		offset, err := s.XAdd(ctx, loc, []byte{byte(i)})
+		require.NoError(t, err)
+		assert.Equal(t, i, offset)
+		clock.advance(20 * time.Second)
+	}
+
+	entries, err := s.XRange(ctx, loc, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, offsets(entries), "entries out of the retention should be skipped")
+	assert.Equal(t, []byte{3}, entries[0].Value)
+
+	entries, err = s.XRange(ctx, loc, 4, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4}, offsets(entries))
+
+	clock.advance(time.Minute)
+	offset, err := s.XAdd(ctx, loc, tValue)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), offset, "offsets should not be reused")
+
+	entries, err = s.XRange(ctx, loc, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5}, offsets(entries))
+
+	o, err := s.XOffset(ctx, loc, "indexer")
+	require.NoError(t, err)
+	assert.Zero(t, o)
+
+	require.NoError(t, s.XCommit(ctx, loc, "indexer", 6))
+	assert.ErrorIs(t, s.XCommit(ctx, loc, "indexer", 7), server.ErrOutOfRange)
+
+	o, err = s.XOffset(ctx, loc, "indexer")
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), o)
+
+	_, err = s.LLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func offsets(entries []server.StreamEntry) []int64 {
+	res := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, e.Offset)
+	}
+	return res
+}
+
+func TestLock(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	lease, err := s.Lock(ctx, loc, 0, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, lease.Token)
+
+	_, err = s.Lock(ctx, loc, 0, "")
+	assert.ErrorIs(t, err, server.ErrLocked)
+
+	_, err = s.Lock(ctx, bridgeLocation(tKey), 0, "")
+	assert.NoError(t, err, "locks should be isolated by scope")
+
+	assert.ErrorIs(t, s.Unlock(ctx, loc, "wrong"), server.ErrUnlockMismatch)
+	require.NoError(t, s.Unlock(ctx, loc, lease.Token))
+	assert.ErrorIs(t, s.Unlock(ctx, loc, lease.Token), server.ErrNotFound)
+}
+
+func TestLockLease(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	_, err := s.LockInfo(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	first, err := s.Lock(ctx, loc, 10*time.Second, "worker-1")
+	require.NoError(t, err)
+
+	info, err := s.LockInfo(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, "worker-1", info.Owner)
+	assert.Equal(t, first.FencingToken, info.FencingToken)
+	assert.Equal(t, first.Token, info.Token)
+	assert.InDelta(t, 10*time.Second, info.TTL, float64(time.Second))
+
+	assert.ErrorIs(t, s.RefreshLock(ctx, loc, "wrong", time.Minute), server.ErrUnlockMismatch)
+	require.NoError(t, s.RefreshLock(ctx, loc, first.Token, time.Minute))
+
+	clock.advance(30 * time.Second)
+	_, err = s.Lock(ctx, loc, 0, "worker-2")
+	assert.ErrorIs(t, err, server.ErrLocked, "refreshed locks should be held")
+
+	clock.advance(time.Minute)
+	assert.ErrorIs(t, s.RefreshLock(ctx, loc, first.Token, time.Minute), server.ErrNotFound)
+
+	second, err := s.Lock(ctx, loc, 0, "worker-2")
+	require.NoError(t, err)
+	assert.Greater(t, second.FencingToken, first.FencingToken, "fencing tokens should increase")
+
+	info, err = s.LockInfo(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), info.TTL)
+}
+
+func TestReadLock(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	first, err := s.RLock(ctx, loc, 10*time.Second)
+	require.NoError(t, err)
+	second, err := s.RLock(ctx, loc, 0)
+	require.NoError(t, err)
+
+	info, err := s.LockInfo(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, server.Lease{Readers: 2}, info)
+
+	_, err = s.Lock(ctx, loc, 0, "writer")
+	assert.ErrorIs(t, err, server.ErrLocked, "read locked keys should not be locked exclusively")
+
+	clock.advance(time.Minute)
+	assert.ErrorIs(t, s.RUnlock(ctx, loc, first), server.ErrNotFound, "shared locks should time out")
+	require.NoError(t, s.RUnlock(ctx, loc, second))
+	assert.ErrorIs(t, s.RUnlock(ctx, loc, second), server.ErrNotFound)
+
+	lease, err := s.Lock(ctx, loc, 0, "writer")
+	require.NoError(t, err)
+
+	_, err = s.RLock(ctx, loc, 0)
+	assert.ErrorIs(t, err, server.ErrLocked, "locked keys should not be read locked")
+
+	require.NoError(t, s.Unlock(ctx, loc, lease.Token))
+	_, err = s.LockInfo(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+}
+
+func TestSemaphore(t *testing.T) {
+	s, clock := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	first, err := s.Acquire(ctx, loc, 2, 3, 10*time.Second)
+	require.NoError(t, err)
+	second, err := s.Acquire(ctx, loc, 1, 3, 0)
+	require.NoError(t, err)
+
+	_, err = s.Acquire(ctx, loc, 1, 3, 0)
+	assert.ErrorIs(t, err, server.ErrNoPermits)
+
+	// Semaphores do not share the namespace of locks.
+	_, err = s.Lock(ctx, loc, 0, "")
+	require.NoError(t, err)
+
+	clock.advance(time.Minute)
+	assert.ErrorIs(t, s.Release(ctx, loc, first), server.ErrNotFound, "permits should time out")
+
+	third, err := s.Acquire(ctx, loc, 2, 3, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Release(ctx, loc, second))
+	require.NoError(t, s.Release(ctx, loc, third))
+	assert.ErrorIs(t, s.Release(ctx, loc, third), server.ErrNotFound)
+}
@@ -0,0 +1,350 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+type mutationOp int
+
+const (
+	opExpire mutationOp = iota + 1
+	opHSet
+	// opHUpdate replaces the value of a map
+	// field, keeping its expiration.
+	opHUpdate
+	opHDel
+	opSAdd
+	opSRem
+	opZAdd
+	opZRem
+	opPush
+	opPopFront
+	opPopBack
+	opTrim
+	opReserve
+	opAck
+	opNack
+	opXAdd
+	opXCommit
+)
+
+// mutation is a change to an entry, which is journaled instead of the
+// whole entry and applied on top of it when the entry is restored.
+// Mutations hold the time they are applied at, along with any other
+// input that does not come from the entry, so that applying them to
+// the same entry always produces the same result.
+type mutation struct {
+	Op  mutationOp
+	Now time.Time
+	// Field of maps, reservation ID or consumer group of streams.
+	Field string
+	Value []byte
+	// ExpireAt of the entry or map field, or the time
+	// reserved queue items are visible again.
+	ExpireAt time.Time
+	Members  []string
+	Scores   []server.ScoredMember
+	Push     server.PushOptions
+	Front    bool
+	// Start and Stop indexes of trimmed queues.
+	Start int
+	Stop  int
+	// Offset committed by a stream consumer group.
+	Offset        int64
+	MaxDeliveries int
+}
+
+// apply changes the entry, returning the item popped from queues.
+// Mutations are journaled before they are applied, which means
+// that applying them must not fail.
+func (e *entry) apply(m *mutation) []byte {
+	switch m.Op {
+	case opExpire:
+		e.ExpireAt = m.ExpireAt
+
+	case opHSet:
+		e.pruneFields(m.Now)
+		e.setField(m.Field, m.Value, m.ExpireAt)
+
+	case opHUpdate:
+		e.pruneFields(m.Now)
+		e.Fields[m.Field] = m.Value
+
+	case opHDel:
+		e.pruneFields(m.Now)
+		e.delField(m.Field)
+
+	case opSAdd:
+		for _, member := range m.Members {
+			e.Members[member] = true
+		}
+
+	case opSRem:
+		for _, member := range m.Members {
+			delete(e.Members, member)
+		}
+
+	case opZAdd:
+		for _, sm := range m.Scores {
+			e.Scores[sm.Member] = sm.Score
+		}
+
+	case opZRem:
+		for _, member := range m.Members {
+			delete(e.Scores, member)
+		}
+
+	case opPush:
+		e.push(m.Value, m.Push, m.Front, m.Now)
+
+	case opPopFront:
+		e.promote(m.Now)
+		return e.popFront()
+
+	case opPopBack:
+		e.promote(m.Now)
+		return e.popBack()
+
+	case opTrim:
+		e.promote(m.Now)
+		start, stop := span(e.len(), m.Start, m.Stop)
+		e.trim(start, stop)
+
+	case opReserve:
+		e.reserve(m.Field, m.Now, m.ExpireAt, m.MaxDeliveries)
+
+	case opAck:
+		if i := e.reservation(m.Field); i != -1 {
+			e.Reserved = append(e.Reserved[:i], e.Reserved[i+1:]...)
+		}
+
+	case opNack:
+		if i := e.reservation(m.Field); i != -1 {
+			e.Reserved[i].VisibleAt = m.Now
+		}
+
+	case opXAdd:
+		e.xadd(m.Value, m.Now)
+
+	case opXCommit:
+		e.Offsets[m.Field] = m.Offset
+	}
+
+	return nil
+}
+
+// update journals the mutation and applies it to the entry stored at
+// the location, returning the item popped from queues. The entry is
+// left untouched when the mutation cannot be journaled. Must be called
+// with the lock held.
+func (s *Store) update(loc *eventstore.LocationType, e *entry, m *mutation) ([]byte, error) {
+	if s.journal != nil {
+		if err := s.journal.Append(encodeKey(loc), encodeMutation(m), m.expireAt(e)); err != nil {
+			return nil, status.Errorf(codes.Internal, "persisting key %q: %v", loc.GetKey(), err)
+		}
+	}
+
+	return e.apply(m), nil
+}
+
+// pending is a mutation of the entry stored at a location.
+type pending struct {
+	loc *eventstore.LocationType
+	e   *entry
+	m   *mutation
+}
+
+// updateAll works like update for mutations of several entries, which
+// are journaled at once so that either all or none of them are applied.
+// Must be called with the lock held.
+func (s *Store) updateAll(updates []pending) error {
+	if s.journal != nil {
+		changes := make([]Change, len(updates))
+		for i, u := range updates {
+			changes[i] = mutationChange(u.loc, u.e, u.m)
+		}
+
+		if err := s.commit(changes); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range updates {
+		u.e.apply(u.m)
+	}
+
+	return nil
+}
+
+// expireAt returns the expiration of the entry once
+// the mutation is applied.
+func (m *mutation) expireAt(e *entry) time.Time {
+	if m.Op == opExpire {
+		return m.ExpireAt
+	}
+	return e.ExpireAt
+}
+
+// encodeMutation encodes the mutation as a sequence of varints, and
+// strings prefixed by their length, which unlike gob does not record
+// the type of the mutation along with every one of them.
+func encodeMutation(m *mutation) []byte {
+	var w mutationWriter
+	w.int(int64(m.Op))
+	w.time(m.Now)
+	w.bytes([]byte(m.Field))
+	w.bytes(m.Value)
+	w.time(m.ExpireAt)
+	w.int(int64(len(m.Members)))
+	for _, member := range m.Members {
+		w.bytes([]byte(member))
+	}
+	w.int(int64(len(m.Scores)))
+	for _, sm := range m.Scores {
+		w.bytes([]byte(sm.Member))
+		w.int(int64(math.Float64bits(sm.Score)))
+	}
+	w.int(int64(m.Push.Priority))
+	w.int(int64(m.Push.Delay))
+	if m.Front {
+		w.int(1)
+	} else {
+		w.int(0)
+	}
+	w.int(int64(m.Start))
+	w.int(int64(m.Stop))
+	w.int(m.Offset)
+	w.int(int64(m.MaxDeliveries))
+
+	return w.buf
+}
+
+func decodeMutation(data []byte) (*mutation, error) {
+	r := mutationReader{buf: data}
+	m := &mutation{}
+	m.Op = mutationOp(r.int())
+	m.Now = r.time()
+	m.Field = string(r.bytes())
+	m.Value = r.bytes()
+	m.ExpireAt = r.time()
+	for n := r.len(); n > 0; n-- {
+		m.Members = append(m.Members, string(r.bytes()))
+	}
+	for n := r.len(); n > 0; n-- {
+		member := string(r.bytes())
+		score := math.Float64frombits(uint64(r.int()))
+		m.Scores = append(m.Scores, server.ScoredMember{Member: member, Score: score})
+	}
+	m.Push.Priority = int(r.int())
+	m.Push.Delay = time.Duration(r.int())
+	m.Front = r.int() == 1
+	m.Start = int(r.int())
+	m.Stop = int(r.int())
+	m.Offset = r.int()
+	m.MaxDeliveries = int(r.int())
+
+	if r.err != nil || len(r.buf) != 0 {
+		return nil, errors.New("decoding change: malformed data")
+	}
+	return m, nil
+}
+
+type mutationWriter struct {
+	buf []byte
+}
+
+func (w *mutationWriter) int(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	w.buf = append(w.buf, b[:binary.PutVarint(b[:], v)]...)
+}
+
+func (w *mutationWriter) bytes(b []byte) {
+	w.int(int64(len(b)))
+	w.buf = append(w.buf, b...)
+}
+
+// time encodes t as Unix nanoseconds, zero meaning the zero time.
+func (w *mutationWriter) time(t time.Time) {
+	if t.IsZero() {
+		w.int(0)
+		return
+	}
+	w.int(t.UnixNano())
+}
+
+// mutationReader decodes the values written by mutationWriter,
+// keeping the first error found.
+type mutationReader struct {
+	buf []byte
+	err error
+}
+
+func (r *mutationReader) int() int64 {
+	if r.err != nil {
+		return 0
+	}
+
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = errors.New("malformed varint")
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+// len reads a length, which must not exceed the remaining data.
+func (r *mutationReader) len() int {
+	n := r.int()
+	if n < 0 || n > int64(len(r.buf)) {
+		if r.err == nil {
+			r.err = errors.New("malformed length")
+		}
+		return 0
+	}
+	return int(n)
+}
+
+func (r *mutationReader) bytes() []byte {
+	n := r.len()
+	if r.err != nil {
+		return nil
+	}
+
+	b := r.buf[:n:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *mutationReader) time() time.Time {
+	v := r.int()
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, v)
+}
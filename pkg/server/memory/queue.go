@@ -0,0 +1,271 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// LNew creates an empty queue at the location.
+func (s *Store) LNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration, limit server.QueueLimit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e != nil {
+		return errAlreadyExists(loc)
+	}
+
+	e := &entry{
+		Kind:     kindQueue,
+		Items:    [][]byte{},
+		Limit:    limit,
+		ExpireAt: s.expireAt(ttl),
+	}
+
+	return s.put(loc, e)
+}
+
+// RPush appends the value at the tail of the queue, behind the
+// rest of the items with the same priority.
+func (s *Store) RPush(ctx context.Context, loc *eventstore.LocationType, value []byte, opts server.PushOptions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	if e.full(now) {
+		return errQueueFull(loc)
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opPush, Now: now, Value: value, Push: opts})
+	return err
+}
+
+// LPush inserts the value at the head of the queue.
+func (s *Store) LPush(ctx context.Context, loc *eventstore.LocationType, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	if e.full(now) {
+		return errQueueFull(loc)
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opPush, Now: now, Value: value, Front: true})
+	return err
+}
+
+// full reports whether pushing to the queue fails because it reached
+// its limit. Hidden items count towards the limit, but are never
+// dropped to make room for new items.
+func (e *entry) full(now time.Time) bool {
+	return !e.fits(1, now)
+}
+
+// fits returns whether n items can be pushed to the queue without
+// exceeding its limit, dropping the oldest ones if allowed.
+func (e *entry) fits(n int, now time.Time) bool {
+	e.promote(now)
+
+	max := e.Limit.MaxLen
+	if max <= 0 || e.len()+len(e.Scheduled)+n <= max {
+		return true
+	}
+
+	return e.Limit.Overflow == server.OverflowDropOldest && len(e.Scheduled) < max
+}
+
+// push adds the value at the tail of the queue, or at the head when
+// front is true, dropping items at the other end of the queue when
+// the limit is reached. The queue must not be full.
+//
+// Items pushed at the tail with a delay are scheduled until they
+// become visible, and the ones with a priority are kept in buckets
+// that are popped before or after the list items.
+func (e *entry) push(value []byte, opts server.PushOptions, front bool, now time.Time) {
+	e.promote(now)
+
+	if max := e.Limit.MaxLen; max > 0 && e.Limit.Overflow == server.OverflowDropOldest {
+		for e.len() != 0 && e.len()+len(e.Scheduled) >= max {
+			if front {
+				e.popBack()
+			} else {
+				e.popFront()
+			}
+		}
+	}
+
+	switch {
+	case front:
+		e.Items = append([][]byte{value}, e.Items...)
+	case opts.Delay > 0:
+		e.schedule(value, opts.Priority, now.Add(opts.Delay))
+	default:
+		e.add(value, opts.Priority)
+	}
+}
+
+// LIndex returns the queue item at the index, counting from the head.
+func (s *Store) LIndex(ctx context.Context, loc *eventstore.LocationType, index int) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	e.promote(s.now())
+	if index < 0 || index >= e.len() {
+		return nil, fmt.Errorf("index %d for queue %q: %w", index, loc.GetKey(), server.ErrOutOfRange)
+	}
+
+	return e.at(index), nil
+}
+
+// LPop removes and returns the item at the head of the queue.
+func (s *Store) LPop(ctx context.Context, loc *eventstore.LocationType) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	e.promote(now)
+	if e.len() == 0 {
+		return nil, errEmptyQueue(loc)
+	}
+
+	return s.update(loc, e, &mutation{Op: opPopFront, Now: now})
+}
+
+// RPop removes and returns the item at the tail of the queue.
+func (s *Store) RPop(ctx context.Context, loc *eventstore.LocationType) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	e.promote(now)
+	if e.len() == 0 {
+		return nil, errEmptyQueue(loc)
+	}
+
+	return s.update(loc, e, &mutation{Op: opPopBack, Now: now})
+}
+
+// LRange returns the queue items between the start and stop indexes,
+// both inclusive. Negative indexes count from the tail of the queue.
+func (s *Store) LRange(ctx context.Context, loc *eventstore.LocationType, start, stop int) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	e.promote(s.now())
+	start, stop = span(e.len(), start, stop)
+	if start > stop {
+		return [][]byte{}, nil
+	}
+
+	items := make([][]byte, 0, stop-start+1)
+	for i := start; i <= stop; i++ {
+		items = append(items, e.at(i))
+	}
+
+	return items, nil
+}
+
+// LTrim removes the queue items out of the start and stop indexes,
+// both inclusive. Negative indexes count from the tail of the queue.
+func (s *Store) LTrim(ctx context.Context, loc *eventstore.LocationType, start, stop int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opTrim, Now: s.now(), Start: start, Stop: stop})
+	return err
+}
+
+// LLen returns the number of visible items in the queue.
+func (s *Store) LLen(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return 0, err
+	}
+
+	e.promote(s.now())
+	return e.len(), nil
+}
+
+// span resolves negative start and stop indexes for a queue or sorted
+// set of length n and clamps them to its elements. Start is greater
+// than stop when there are no elements in between.
+func span(n, start, stop int) (int, int) {
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	return start, stop
+}
+
+func errEmptyQueue(loc *eventstore.LocationType) error {
+	return fmt.Errorf("queue %q is empty: %w", loc.GetKey(), server.ErrNotFound)
+}
+
+func errQueueFull(loc *eventstore.LocationType) error {
+	return fmt.Errorf("queue %q: %w", loc.GetKey(), server.ErrQueueFull)
+}
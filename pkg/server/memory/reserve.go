@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// reservation is a queue item delivered to a consumer.
+type reservation struct {
+	ID         string
+	Value      []byte
+	Deliveries int
+	// VisibleAt is when the item can be delivered again.
+	VisibleAt time.Time
+}
+
+// LReserve implements server.List.
+func (s *Store) LReserve(ctx context.Context, loc *eventstore.LocationType, opts server.ReserveOptions) (server.Reservation, error) {
+	id, err := newToken()
+	if err != nil {
+		return server.Reservation{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return server.Reservation{}, err
+	}
+
+	var dl *entry
+	if opts.DeadLetter != nil {
+		if dl, err = s.lookupKind(opts.DeadLetter, kindQueue); err != nil {
+			return server.Reservation{}, err
+		}
+	}
+
+	now := s.now()
+	e.promote(now)
+	dead, ok := e.deadLetters(now, opts.MaxDeliveries)
+	if !ok && len(dead) == 0 {
+		return server.Reservation{}, errEmptyQueue(loc)
+	}
+
+	// Dead letters are pushed to the dead-letter queue like any
+	// other item, along with the reservation or not at all.
+	if dl != nil && !dl.fits(len(dead), now) {
+		return server.Reservation{}, errQueueFull(opts.DeadLetter)
+	}
+
+	updates := []pending{{loc: loc, e: e, m: &mutation{
+		Op:            opReserve,
+		Now:           now,
+		Field:         id,
+		ExpireAt:      now.Add(opts.Visibility),
+		MaxDeliveries: opts.MaxDeliveries,
+	}}}
+	if dl != nil {
+		for _, v := range dead {
+			updates = append(updates, pending{loc: opts.DeadLetter, e: dl, m: &mutation{Op: opPush, Now: now, Value: v}})
+		}
+	}
+
+	if err := s.updateAll(updates); err != nil {
+		return server.Reservation{}, err
+	}
+
+	if !ok {
+		return server.Reservation{DeadLetters: dead}, errEmptyQueue(loc)
+	}
+
+	r := e.Reserved[len(e.Reserved)-1]
+	return server.Reservation{
+		ID:          id,
+		Value:       r.Value,
+		Deliveries:  r.Deliveries,
+		DeadLetters: dead,
+	}, nil
+}
+
+// LAck implements server.List.
+func (s *Store) LAck(ctx context.Context, loc *eventstore.LocationType, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupReservation(loc, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opAck, Field: id})
+	return err
+}
+
+// LNack implements server.List.
+func (s *Store) LNack(ctx context.Context, loc *eventstore.LocationType, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupReservation(loc, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opNack, Now: s.now(), Field: id})
+	return err
+}
+
+// lookupReservation returns the queue at the location, failing when
+// it does not hold the reservation. Must be called with the lock held.
+func (s *Store) lookupReservation(loc *eventstore.LocationType, id string) (*entry, error) {
+	e, err := s.lookupKind(loc, kindQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.reservation(id) == -1 {
+		return nil, fmt.Errorf("reservation %q for queue %q: %w", id, loc.GetKey(), server.ErrNotFound)
+	}
+
+	return e, nil
+}
+
+// reservation returns the index of the reservation
+// with the ID, or -1 when there is none.
+func (e *entry) reservation(id string) int {
+	for i, r := range e.Reserved {
+		if r.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// reserve delivers the next visible item of the queue, which is hidden
+// until visibleAt. Items that were delivered max times are dropped, in
+// the order they are returned by deadLetters, zero max meaning that
+// items can be delivered any number of times.
+func (e *entry) reserve(id string, now, visibleAt time.Time, max int) {
+	e.promote(now)
+
+	for {
+		var next *reservation
+		switch i := e.redeliverable(now); {
+		case i != -1:
+			next = e.Reserved[i]
+			e.Reserved = append(e.Reserved[:i], e.Reserved[i+1:]...)
+		case e.len() != 0:
+			next = &reservation{Value: e.popFront()}
+		default:
+			return
+		}
+
+		if max == 0 || next.Deliveries < max {
+			e.Reserved = append(e.Reserved, &reservation{
+				ID:         id,
+				Value:      next.Value,
+				Deliveries: next.Deliveries + 1,
+				VisibleAt:  visibleAt,
+			})
+			return
+		}
+	}
+}
+
+// deadLetters returns the items that reserve drops for being delivered
+// max times, and whether there is an item to deliver after them.
+// Scheduled items must have been promoted.
+func (e *entry) deadLetters(now time.Time, max int) ([][]byte, bool) {
+	visible := make([]*reservation, 0, len(e.Reserved))
+	for _, r := range e.Reserved {
+		if !now.Before(r.VisibleAt) {
+			visible = append(visible, r)
+		}
+	}
+
+	// same order as redeliverable
+	sort.SliceStable(visible, func(i, j int) bool {
+		return visible[i].VisibleAt.Before(visible[j].VisibleAt)
+	})
+
+	var dead [][]byte
+	for _, r := range visible {
+		if max == 0 || r.Deliveries < max {
+			return dead, true
+		}
+		dead = append(dead, r.Value)
+	}
+
+	return dead, e.len() != 0
+}
+
+// redeliverable returns the index of the reservation that has been
+// visible for longer, or -1 when every reservation is hidden.
+func (e *entry) redeliverable(now time.Time) int {
+	next := -1
+	for i, r := range e.Reserved {
+		if now.Before(r.VisibleAt) {
+			continue
+		}
+		if next == -1 || r.VisibleAt.Before(e.Reserved[next].VisibleAt) {
+			next = i
+		}
+	}
+	return next
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"container/heap"
+	"sort"
+	"time"
+)
+
+// scheduled is a queue item pushed with a delay,
+// which is hidden until it becomes visible.
+type scheduled struct {
+	Value     []byte
+	Priority  int
+	VisibleAt time.Time
+	// Seq keeps the push order of items that
+	// become visible at the same time.
+	Seq uint64
+}
+
+// schedule is a heap of hidden queue items, ordered by
+// the time they become visible and their push order.
+type schedule []*scheduled
+
+func (s schedule) Len() int { return len(s) }
+
+func (s schedule) Less(i, j int) bool {
+	if !s[i].VisibleAt.Equal(s[j].VisibleAt) {
+		return s[i].VisibleAt.Before(s[j].VisibleAt)
+	}
+	return s[i].Seq < s[j].Seq
+}
+
+func (s schedule) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *schedule) Push(x interface{}) { *s = append(*s, x.(*scheduled)) }
+
+func (s *schedule) Pop() interface{} {
+	old := *s
+	it := old[len(old)-1]
+	old[len(old)-1] = nil
+	*s = old[:len(old)-1]
+	return it
+}
+
+// bucket holds the visible queue items with the same
+// non zero priority, in the order they are popped.
+type bucket struct {
+	Priority int
+	Items    [][]byte
+}
+
+// schedule hides the item until the delay elapses.
+func (e *entry) schedule(value []byte, priority int, visibleAt time.Time) {
+	heap.Push(&e.Scheduled, &scheduled{
+		Value:     value,
+		Priority:  priority,
+		VisibleAt: visibleAt,
+		Seq:       e.Seq,
+	})
+	e.Seq++
+}
+
+// promote moves the scheduled items that are visible to the list
+// items, or to the bucket of their priority, in the order they became
+// visible. It must be called before reading or modifying queues so
+// that items pushed afterwards are placed behind them.
+func (e *entry) promote(now time.Time) {
+	for len(e.Scheduled) != 0 && !now.Before(e.Scheduled[0].VisibleAt) {
+		it := heap.Pop(&e.Scheduled).(*scheduled)
+		e.add(it.Value, it.Priority)
+	}
+}
+
+// add appends the visible item behind the items with its priority.
+func (e *entry) add(value []byte, priority int) {
+	if priority == 0 {
+		e.Items = append(e.Items, value)
+		return
+	}
+
+	i := sort.Search(len(e.Buckets), func(i int) bool {
+		return e.Buckets[i].Priority <= priority
+	})
+	if i == len(e.Buckets) || e.Buckets[i].Priority != priority {
+		e.Buckets = append(e.Buckets, nil)
+		copy(e.Buckets[i+1:], e.Buckets[i:])
+		e.Buckets[i] = &bucket{Priority: priority}
+	}
+	e.Buckets[i].Items = append(e.Buckets[i].Items, value)
+}
+
+// head returns the number of buckets with a positive
+// priority, which are popped before the list items.
+func (e *entry) head() int {
+	return sort.Search(len(e.Buckets), func(i int) bool {
+		return e.Buckets[i].Priority < 0
+	})
+}
+
+// lists returns the visible items of the queue in the order they are
+// popped, split in the buckets with positive priorities, the list
+// items, and the buckets with negative priorities.
+func (e *entry) lists() [][][]byte {
+	lists := make([][][]byte, 0, len(e.Buckets)+1)
+	n := e.head()
+	for _, b := range e.Buckets[:n] {
+		lists = append(lists, b.Items)
+	}
+	lists = append(lists, e.Items)
+	for _, b := range e.Buckets[n:] {
+		lists = append(lists, b.Items)
+	}
+	return lists
+}
+
+// len returns the number of visible items of the queue.
+func (e *entry) len() int {
+	n := len(e.Items)
+	for _, b := range e.Buckets {
+		n += len(b.Items)
+	}
+	return n
+}
+
+// at returns the visible item at the index, which must exist.
+func (e *entry) at(i int) []byte {
+	for _, l := range e.lists() {
+		if i < len(l) {
+			return l[i]
+		}
+		i -= len(l)
+	}
+	return nil
+}
+
+// popFront removes and returns the first visible item, which must exist.
+func (e *entry) popFront() []byte {
+	n := e.head()
+	for i := 0; i < n; i++ {
+		if b := e.Buckets[i]; len(b.Items) != 0 {
+			return e.popBucket(i, true)
+		}
+	}
+
+	if len(e.Items) != 0 {
+		item := e.Items[0]
+		e.Items[0] = nil
+		e.Items = e.Items[1:]
+		return item
+	}
+
+	return e.popBucket(n, true)
+}
+
+// popBack removes and returns the last visible item, which must exist.
+func (e *entry) popBack() []byte {
+	n := e.head()
+	if len(e.Buckets) > n {
+		return e.popBucket(len(e.Buckets)-1, false)
+	}
+
+	if m := len(e.Items); m != 0 {
+		item := e.Items[m-1]
+		e.Items[m-1] = nil
+		e.Items = e.Items[:m-1]
+		return item
+	}
+
+	return e.popBucket(n-1, false)
+}
+
+// popBucket removes the first or last item of the bucket at the
+// index, removing the bucket once it is empty. Buckets are never
+// kept empty, so that they always hold the item being popped.
+func (e *entry) popBucket(i int, front bool) []byte {
+	b := e.Buckets[i]
+
+	var item []byte
+	if n := len(b.Items); front {
+		item = b.Items[0]
+		b.Items[0] = nil
+		b.Items = b.Items[1:]
+	} else {
+		item = b.Items[n-1]
+		b.Items[n-1] = nil
+		b.Items = b.Items[:n-1]
+	}
+
+	if len(b.Items) == 0 {
+		e.Buckets = append(e.Buckets[:i], e.Buckets[i+1:]...)
+	}
+	return item
+}
+
+// trim removes the visible items out of the start and stop
+// indexes, which have already been resolved.
+func (e *entry) trim(start, stop int) {
+	keep := func(l [][]byte, offset int) [][]byte {
+		kept := [][]byte{}
+		for i, item := range l {
+			if offset+i >= start && offset+i <= stop {
+				kept = append(kept, item)
+			}
+		}
+		return kept
+	}
+
+	offset := 0
+	n := e.head()
+	buckets := e.Buckets[:0]
+	for i, b := range e.Buckets {
+		if i == n {
+			items := keep(e.Items, offset)
+			offset += len(e.Items)
+			e.Items = items
+		}
+
+		items := keep(b.Items, offset)
+		offset += len(b.Items)
+		if len(items) != 0 {
+			b.Items = items
+			buckets = append(buckets, b)
+		}
+	}
+	if n == len(e.Buckets) {
+		e.Items = keep(e.Items, offset)
+	}
+	e.Buckets = buckets
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Scan returns the keys at the scope that start with the prefix in
+// lexicographical order, the cursor being the last key returned.
+func (s *Store) Scan(ctx context.Context, sc *eventstore.ScopeType, prefix, cursor string, count int) ([]server.KeyInfo, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ns := s.data[scopeOf(sc)]
+
+	keys := make([]string, 0, len(ns))
+	for k := range ns {
+		if strings.HasPrefix(k, prefix) && k > cursor {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	now := s.now()
+	res := make([]server.KeyInfo, 0, count)
+	for i, k := range keys {
+		if len(res) == count {
+			return res, keys[i-1], nil
+		}
+
+		e := ns[k]
+		if e.expired(now) {
+			continue
+		}
+
+		info := server.KeyInfo{
+			Key:  k,
+			Kind: e.Kind.server(),
+		}
+		if !e.ExpireAt.IsZero() {
+			info.TTL = e.ExpireAt.Sub(now)
+		}
+		res = append(res, info)
+	}
+
+	return res, "", nil
+}
+
+// DropScope removes the entries, locks and semaphores at the
+// scope atomically.
+func (s *Store) DropScope(ctx context.Context, sc *eventstore.ScopeType) ([]*eventstore.LocationType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target := scopeOf(sc)
+	now := s.now()
+
+	// Removals are journaled before changing any entry, so that
+	// failing to record them leaves the scope untouched.
+	var dropped, removed []*eventstore.LocationType
+	for ks, ns := range s.data {
+		if !target.contains(ks) {
+			continue
+		}
+
+		for k, e := range ns {
+			loc := ks.location(k)
+			if !e.expired(now) {
+				dropped = append(dropped, loc)
+			}
+			removed = append(removed, loc)
+		}
+	}
+
+	if err := s.persistAll(removed, make([]*entry, len(removed))); err != nil {
+		return nil, err
+	}
+
+	for ks := range s.data {
+		if target.contains(ks) {
+			delete(s.data, ks)
+		}
+	}
+	for ks := range s.locks {
+		if target.contains(ks) {
+			delete(s.locks, ks)
+		}
+	}
+	for _, all := range []map[scope]map[string]holders{s.readers, s.semaphores} {
+		for ks := range all {
+			if target.contains(ks) {
+				delete(all, ks)
+			}
+		}
+	}
+
+	return dropped, nil
+}
+
+// contains returns whether the scope is the same or, for
+// bridges, an instance of the bridge.
+func (sc scope) contains(other scope) bool {
+	if sc == other {
+		return true
+	}
+
+	return sc.typ == eventstore.ScopeChoice_Bridge &&
+		other.typ == eventstore.ScopeChoice_Instance &&
+		other.bridge == sc.bridge
+}
+
+func (k kind) server() server.Kind {
+	switch k {
+	case kindMap:
+		return server.KindMap
+	case kindQueue:
+		return server.KindQueue
+	case kindSet:
+		return server.KindSet
+	case kindSortedSet:
+		return server.KindSortedSet
+	case kindStream:
+		return server.KindStream
+	}
+	return server.KindKV
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Acquire takes permits from the semaphore at the location as long
+// as no more than limit permits are held, and returns the token
+// needed to release them. A non zero timeout releases the permits
+// automatically once elapsed.
+func (s *Store) Acquire(ctx context.Context, loc *eventstore.LocationType, permits, limit int, timeout time.Duration) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hs := s.holdersAt(s.semaphores, loc, false)
+	if held := hs.prune(s.now()); held+permits > limit {
+		return "", fmt.Errorf("semaphore %q holds %d of %d permits: %w", loc.GetKey(), held, limit, server.ErrNoPermits)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return "", fmt.Errorf("generating release token: %w", err)
+	}
+
+	s.holdersAt(s.semaphores, loc, true)[token] = &holder{permits: permits, expireAt: s.expireAt(timeout)}
+
+	return token, nil
+}
+
+// Release returns the permits taken using the token.
+func (s *Store) Release(ctx context.Context, loc *eventstore.LocationType, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.release(s.semaphores, loc, token)
+}
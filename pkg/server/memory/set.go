@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// SNew creates an empty set at the location.
+func (s *Store) SNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e != nil {
+		return errAlreadyExists(loc)
+	}
+
+	e := &entry{
+		Kind:     kindSet,
+		Members:  make(map[string]bool),
+		ExpireAt: s.expireAt(ttl),
+	}
+
+	return s.put(loc, e)
+}
+
+// SAdd adds the members to the set and returns the
+// number of them that were not in the set.
+func (s *Store) SAdd(ctx context.Context, loc *eventstore.LocationType, members []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSet)
+	if err != nil {
+		return 0, err
+	}
+
+	added := distinct(members, func(m string) bool { return !e.Members[m] })
+	if len(added) == 0 {
+		return 0, nil
+	}
+
+	if _, err := s.update(loc, e, &mutation{Op: opSAdd, Members: added}); err != nil {
+		return 0, err
+	}
+
+	return len(added), nil
+}
+
+// SRem removes the members from the set and returns the
+// number of them that were in the set.
+func (s *Store) SRem(ctx context.Context, loc *eventstore.LocationType, members []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSet)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := distinct(members, func(m string) bool { return e.Members[m] })
+	if len(removed) == 0 {
+		return 0, nil
+	}
+
+	if _, err := s.update(loc, e, &mutation{Op: opSRem, Members: removed}); err != nil {
+		return 0, err
+	}
+
+	return len(removed), nil
+}
+
+// SIsMember reports whether the member is in the set.
+func (s *Store) SIsMember(ctx context.Context, loc *eventstore.LocationType, member string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSet)
+	if err != nil {
+		return false, err
+	}
+
+	return e.Members[member], nil
+}
+
+// SMembers returns all members of the set.
+func (s *Store) SMembers(ctx context.Context, loc *eventstore.LocationType) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSet)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]string, 0, len(e.Members))
+	for m := range e.Members {
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// SCard returns the number of members of the set.
+func (s *Store) SCard(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSet)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(e.Members), nil
+}
+
+// SPop removes and returns a random member of the set. Members
+// are picked in the unspecified order of map iteration.
+func (s *Store) SPop(ctx context.Context, loc *eventstore.LocationType) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSet)
+	if err != nil {
+		return "", err
+	}
+
+	for m := range e.Members {
+		if _, err := s.update(loc, e, &mutation{Op: opSRem, Members: []string{m}}); err != nil {
+			return "", err
+		}
+		return m, nil
+	}
+
+	return "", errEmptySet(loc)
+}
+
+// distinct returns the members that match, once each.
+func distinct(members []string, match func(m string) bool) []string {
+	seen := make(map[string]bool, len(members))
+	matched := []string{}
+	for _, m := range members {
+		if !seen[m] && match(m) {
+			matched = append(matched, m)
+		}
+		seen[m] = true
+	}
+	return matched
+}
+
+func errEmptySet(loc *eventstore.LocationType) error {
+	return fmt.Errorf("set %q is empty: %w", loc.GetKey(), server.ErrNotFound)
+}
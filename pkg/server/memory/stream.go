@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// XNew creates an empty stream at the location.
+func (s *Store) XNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration, retention server.StreamRetention) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e != nil {
+		return errAlreadyExists(loc)
+	}
+
+	e := &entry{
+		Kind:      kindStream,
+		Retention: retention,
+		Offsets:   make(map[string]int64),
+		ExpireAt:  s.expireAt(ttl),
+	}
+
+	return s.put(loc, e)
+}
+
+// XAdd appends the value to the stream, removing the entries
+// out of its retention, and returns its offset.
+func (s *Store) XAdd(ctx context.Context, loc *eventstore.LocationType, value []byte) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindStream)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := s.update(loc, e, &mutation{Op: opXAdd, Now: s.now(), Value: value}); err != nil {
+		return 0, err
+	}
+
+	return e.LastOffset, nil
+}
+
+// XRange returns up to limit entries starting at the offset,
+// zero limit meaning no limit.
+func (s *Store) XRange(ctx context.Context, loc *eventstore.LocationType, offset int64, limit int) ([]server.StreamEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindStream)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	entries := []server.StreamEntry{}
+	for _, se := range e.Entries {
+		if limit > 0 && len(entries) == limit {
+			break
+		}
+		if se.Offset >= offset && !e.retired(se, now) {
+			entries = append(entries, se)
+		}
+	}
+
+	return entries, nil
+}
+
+// XCommit stores the offset committed by the consumer group.
+func (s *Store) XCommit(ctx context.Context, loc *eventstore.LocationType, group string, offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindStream)
+	if err != nil {
+		return err
+	}
+
+	if offset > e.LastOffset+1 {
+		return errOffsetOutOfRange(loc, offset)
+	}
+
+	_, err = s.update(loc, e, &mutation{Op: opXCommit, Field: group, Offset: offset})
+	return err
+}
+
+// XOffset returns the offset committed by the consumer
+// group, zero if it has not committed any.
+func (s *Store) XOffset(ctx context.Context, loc *eventstore.LocationType, group string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindStream)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.Offsets[group], nil
+}
+
+// xadd appends the value to the stream, removing the
+// entries out of its retention.
+func (e *entry) xadd(value []byte, now time.Time) {
+	e.LastOffset++
+	e.Entries = append(e.Entries, server.StreamEntry{Offset: e.LastOffset, Value: value, Time: now})
+
+	// Entries are sorted by offset and time, which
+	// means that the ones to remove are at the head.
+	n := 0
+	if max := e.Retention.MaxLen; max > 0 && len(e.Entries) > max {
+		n = len(e.Entries) - max
+	}
+	for n < len(e.Entries) && e.retired(e.Entries[n], now) {
+		n++
+	}
+	if n > 0 {
+		e.Entries = append([]server.StreamEntry(nil), e.Entries[n:]...)
+	}
+}
+
+// retired reports whether the stream entry is older
+// than the maximum age of the stream.
+func (e *entry) retired(se server.StreamEntry, now time.Time) bool {
+	return e.Retention.MaxAge > 0 && now.Sub(se.Time) >= e.Retention.MaxAge
+}
+
+func errOffsetOutOfRange(loc *eventstore.LocationType, offset int64) error {
+	return fmt.Errorf("offset %d for stream %q is past the end of the stream: %w", offset, loc.GetKey(), server.ErrOutOfRange)
+}
@@ -0,0 +1,433 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Txn implements server.Storage. Operations are applied to copies of
+// the entries they touch, which replace the stored entries only when
+// all operations succeed.
+func (s *Store) Txn(ctx context.Context, guards []server.Guard, ops []server.Op) ([]server.OpResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, g := range guards {
+		if err := s.check(g); err != nil {
+			return nil, err
+		}
+	}
+
+	tx := &txn{
+		s:      s,
+		staged: make(map[string]*staged),
+	}
+
+	res := make([]server.OpResult, 0, len(ops))
+	for i, op := range ops {
+		r, err := tx.apply(op)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		res = append(res, r)
+	}
+
+	if err := tx.commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// check fails with server.ErrConflict if the guard does not
+// hold. Must be called with the lock held.
+func (s *Store) check(g server.Guard) error {
+	loc := g.Location
+	e := s.lookup(loc)
+
+	switch g.Kind {
+	case server.GuardExists:
+		if e == nil {
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		}
+
+	case server.GuardNotExists:
+		if e != nil {
+			return fmt.Errorf("key %q exists: %w", loc.GetKey(), server.ErrConflict)
+		}
+
+	case server.GuardVersion:
+		switch {
+		case e == nil:
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		case e.Kind != kindKV:
+			return errWrongKind(loc, e.Kind, kindKV)
+		case e.Version != g.Version:
+			return fmt.Errorf("key %q is at version %d, not %d: %w", loc.GetKey(), e.Version, g.Version, server.ErrConflict)
+		}
+
+	case server.GuardField:
+		switch {
+		case e == nil:
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		case e.Kind != kindMap:
+			return errWrongKind(loc, e.Kind, kindMap)
+		}
+		// expired fields are pruned from a copy, since the
+		// entry is not journaled when checking guards
+		c := e.clone()
+		c.pruneFields(s.now())
+		if v, ok := c.Fields[g.Field]; !ok || !bytes.Equal(v, g.Value) {
+			return fmt.Errorf("field %q at key %q does not hold the value: %w", g.Field, loc.GetKey(), server.ErrConflict)
+		}
+
+	default:
+		return fmt.Errorf("unknown guard %d", g.Kind)
+	}
+
+	return nil
+}
+
+// staged is the state of a key modified by a transaction.
+type staged struct {
+	loc *eventstore.LocationType
+	// e is nil when the key is removed.
+	e       *entry
+	existed bool
+	// replaced informs that the entry is journaled as a whole,
+	// instead of the mutations applied to it.
+	replaced  bool
+	mutations []*mutation
+}
+
+// txn keeps the changes of a transaction until they are committed.
+type txn struct {
+	s      *Store
+	keys   []string
+	staged map[string]*staged
+}
+
+// get returns the staged state for the location, copying the
+// stored entry the first time the location is accessed.
+func (tx *txn) get(loc *eventstore.LocationType) *staged {
+	k := encodeKey(loc)
+	if st, ok := tx.staged[k]; ok {
+		return st
+	}
+
+	st := &staged{loc: loc}
+	if e := tx.s.lookup(loc); e != nil {
+		st.e = e.clone()
+		st.existed = true
+	}
+
+	tx.staged[k] = st
+	tx.keys = append(tx.keys, k)
+
+	return st
+}
+
+// lookupKind works like Store.lookupKind for staged entries.
+func (tx *txn) lookupKind(loc *eventstore.LocationType, k kind) (*entry, error) {
+	st := tx.get(loc)
+	if st.e == nil {
+		return nil, errNotFound(loc)
+	}
+
+	if st.e.Kind != k {
+		return nil, errWrongKind(loc, st.e.Kind, k)
+	}
+
+	return st.e, nil
+}
+
+// lookupMap works like Store.lookupMap for staged entries.
+func (tx *txn) lookupMap(loc *eventstore.LocationType) (*entry, error) {
+	e, err := tx.lookupKind(loc, kindMap)
+	if err != nil {
+		return nil, err
+	}
+
+	e.pruneFields(tx.s.now())
+	return e, nil
+}
+
+// replace stages the entry, nil meaning that the key is removed.
+func (tx *txn) replace(loc *eventstore.LocationType, e *entry) {
+	st := tx.get(loc)
+	st.e, st.replaced, st.mutations = e, true, nil
+}
+
+// update applies the mutation to the staged entry, which must exist,
+// returning the item popped from queues.
+func (tx *txn) update(loc *eventstore.LocationType, m *mutation) []byte {
+	st := tx.get(loc)
+	if !st.replaced {
+		st.mutations = append(st.mutations, m)
+	}
+	return st.e.apply(m)
+}
+
+func (tx *txn) apply(op server.Op) (server.OpResult, error) {
+	loc := op.Location
+
+	now := tx.s.now()
+
+	switch op.Kind {
+	case server.OpSet:
+		e := &entry{
+			Kind:     kindKV,
+			Value:    op.Value,
+			ExpireAt: tx.s.expireAt(op.TTL),
+		}
+		tx.s.bump(e)
+		tx.replace(loc, e)
+
+		return server.OpResult{Version: e.Version}, nil
+
+	case server.OpIncrBy:
+		e := tx.get(loc).e
+		if e == nil {
+			e = &entry{Kind: kindKV}
+		}
+		if e.Kind != kindKV {
+			return server.OpResult{}, errWrongKind(loc, e.Kind, kindKV)
+		}
+
+		v, err := incr(e.Value, op.Delta)
+		if err != nil {
+			return server.OpResult{}, err
+		}
+		updated := &entry{
+			Kind:     kindKV,
+			Value:    []byte(strconv.FormatInt(v, 10)),
+			ExpireAt: e.ExpireAt,
+		}
+		tx.s.bump(updated)
+		tx.replace(loc, updated)
+
+		return server.OpResult{Version: updated.Version, Number: v}, nil
+
+	case server.OpDel:
+		if tx.get(loc).e == nil {
+			return server.OpResult{}, errNotFound(loc)
+		}
+		tx.replace(loc, nil)
+
+	case server.OpHNew:
+		if tx.get(loc).e != nil {
+			return server.OpResult{}, errAlreadyExists(loc)
+		}
+		tx.replace(loc, &entry{
+			Kind:     kindMap,
+			Fields:   make(map[string][]byte),
+			ExpireAt: tx.s.expireAt(op.TTL),
+		})
+
+	case server.OpHSet:
+		if _, err := tx.lookupMap(loc); err != nil {
+			return server.OpResult{}, err
+		}
+		tx.update(loc, &mutation{Op: opHSet, Now: now, Field: op.Field, Value: op.Value, ExpireAt: tx.s.expireAt(op.TTL)})
+
+	case server.OpHDel:
+		e, err := tx.lookupMap(loc)
+		if err != nil {
+			return server.OpResult{}, err
+		}
+		if _, ok := e.Fields[op.Field]; !ok {
+			return server.OpResult{}, errFieldNotFound(loc, op.Field)
+		}
+		tx.update(loc, &mutation{Op: opHDel, Now: now, Field: op.Field})
+
+	case server.OpHIncrBy:
+		e, err := tx.lookupMap(loc)
+		if err != nil {
+			return server.OpResult{}, err
+		}
+
+		v, err := incr(e.Fields[op.Field], op.Delta)
+		if err != nil {
+			return server.OpResult{}, err
+		}
+		tx.update(loc, &mutation{Op: opHUpdate, Now: now, Field: op.Field, Value: []byte(strconv.FormatInt(v, 10))})
+
+		return server.OpResult{Number: v}, nil
+
+	case server.OpLNew:
+		if tx.get(loc).e != nil {
+			return server.OpResult{}, errAlreadyExists(loc)
+		}
+		tx.replace(loc, &entry{
+			Kind:     kindQueue,
+			Items:    [][]byte{},
+			Limit:    op.Limit,
+			ExpireAt: tx.s.expireAt(op.TTL),
+		})
+
+	case server.OpRPush:
+		e, err := tx.lookupKind(loc, kindQueue)
+		if err != nil {
+			return server.OpResult{}, err
+		}
+		if e.full(now) {
+			return server.OpResult{}, errQueueFull(loc)
+		}
+		tx.update(loc, &mutation{Op: opPush, Now: now, Value: op.Value, Push: op.Push})
+
+	case server.OpLPop:
+		e, err := tx.lookupKind(loc, kindQueue)
+		if err != nil {
+			return server.OpResult{}, err
+		}
+		e.promote(now)
+		if e.len() == 0 {
+			return server.OpResult{}, errEmptyQueue(loc)
+		}
+
+		return server.OpResult{Value: tx.update(loc, &mutation{Op: opPopFront, Now: now})}, nil
+
+	default:
+		return server.OpResult{}, fmt.Errorf("unknown operation %d", op.Kind)
+	}
+
+	return server.OpResult{}, nil
+}
+
+// commit replaces the stored entries with the staged ones. Changes
+// are journaled at once before any entry is replaced, so that either
+// all of them or none are applied.
+func (tx *txn) commit() error {
+	var changes []Change
+	var modified []*staged
+	for _, k := range tx.keys {
+		st := tx.staged[k]
+		switch {
+		case st.replaced && (st.e != nil || st.existed):
+			c, err := putChange(st.loc, st.e)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, c)
+
+		case len(st.mutations) != 0:
+			for _, m := range st.mutations {
+				changes = append(changes, mutationChange(st.loc, st.e, m))
+			}
+
+		default:
+			continue
+		}
+		modified = append(modified, st)
+	}
+
+	if tx.s.journal != nil && len(changes) != 0 {
+		if err := tx.s.commit(changes); err != nil {
+			return err
+		}
+	}
+
+	for _, st := range modified {
+		if st.e != nil {
+			tx.s.store(st.loc, st.e)
+			continue
+		}
+		tx.s.remove(st.loc)
+	}
+
+	return nil
+}
+
+// clone returns a copy of the entry that can be
+// modified without affecting the original one.
+func (e *entry) clone() *entry {
+	c := *e
+
+	if e.Fields != nil {
+		c.Fields = make(map[string][]byte, len(e.Fields))
+		for k, v := range e.Fields {
+			c.Fields[k] = v
+		}
+	}
+
+	if e.FieldExpireAt != nil {
+		c.FieldExpireAt = make(map[string]time.Time, len(e.FieldExpireAt))
+		for f, t := range e.FieldExpireAt {
+			c.FieldExpireAt[f] = t
+		}
+	}
+
+	if e.Members != nil {
+		c.Members = make(map[string]bool, len(e.Members))
+		for m := range e.Members {
+			c.Members[m] = true
+		}
+	}
+
+	if e.Scores != nil {
+		c.Scores = make(map[string]float64, len(e.Scores))
+		for m, score := range e.Scores {
+			c.Scores[m] = score
+		}
+	}
+
+	if e.Entries != nil {
+		c.Entries = make([]server.StreamEntry, len(e.Entries))
+		copy(c.Entries, e.Entries)
+	}
+
+	if e.Offsets != nil {
+		c.Offsets = make(map[string]int64, len(e.Offsets))
+		for g, offset := range e.Offsets {
+			c.Offsets[g] = offset
+		}
+	}
+
+	if e.Items != nil {
+		c.Items = make([][]byte, len(e.Items))
+		copy(c.Items, e.Items)
+	}
+
+	if e.Reserved != nil {
+		c.Reserved = make([]*reservation, len(e.Reserved))
+		copy(c.Reserved, e.Reserved)
+	}
+
+	if e.Scheduled != nil {
+		c.Scheduled = make(schedule, len(e.Scheduled))
+		copy(c.Scheduled, e.Scheduled)
+	}
+
+	if e.Buckets != nil {
+		c.Buckets = make([]*bucket, len(e.Buckets))
+		for i, b := range e.Buckets {
+			items := make([][]byte, len(b.Items))
+			copy(items, b.Items)
+			c.Buckets[i] = &bucket{Priority: b.Priority, Items: items}
+		}
+	}
+
+	return &c
+}
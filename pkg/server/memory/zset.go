@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// ZNew creates an empty sorted set at the location.
+func (s *Store) ZNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.lookup(loc); e != nil {
+		return errAlreadyExists(loc)
+	}
+
+	e := &entry{
+		Kind:     kindSortedSet,
+		Scores:   make(map[string]float64),
+		ExpireAt: s.expireAt(ttl),
+	}
+
+	return s.put(loc, e)
+}
+
+// ZAdd adds the members to the sorted set, updating the score of
+// existing ones, and returns the number of members added.
+func (s *Store) ZAdd(ctx context.Context, loc *eventstore.LocationType, members []server.ScoredMember) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSortedSet)
+	if err != nil {
+		return 0, err
+	}
+
+	added := make(map[string]bool)
+	for _, m := range members {
+		if _, ok := e.Scores[m.Member]; !ok {
+			added[m.Member] = true
+		}
+	}
+
+	if _, err := s.update(loc, e, &mutation{Op: opZAdd, Scores: members}); err != nil {
+		return 0, err
+	}
+
+	return len(added), nil
+}
+
+// ZIncrBy adds delta to the score of the member and returns
+// the result. Missing members are added with a zero score.
+func (s *Store) ZIncrBy(ctx context.Context, loc *eventstore.LocationType, member string, delta float64) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSortedSet)
+	if err != nil {
+		return 0, err
+	}
+
+	score := e.Scores[member] + delta
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return 0, fmt.Errorf("increment would produce NaN or infinity: %w", server.ErrOutOfRange)
+	}
+
+	m := &mutation{Op: opZAdd, Scores: []server.ScoredMember{{Member: member, Score: score}}}
+	if _, err := s.update(loc, e, m); err != nil {
+		return 0, err
+	}
+
+	return score, nil
+}
+
+// ZRangeByScore returns up to limit members whose score is
+// between min and max, zero limit meaning no limit.
+func (s *Store) ZRangeByScore(ctx context.Context, loc *eventstore.LocationType, min, max float64, limit int) ([]server.ScoredMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSortedSet)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []server.ScoredMember{}
+	for _, m := range e.sorted() {
+		if limit > 0 && len(members) == limit {
+			break
+		}
+		if m.Score >= min && m.Score <= max {
+			members = append(members, m)
+		}
+	}
+
+	return members, nil
+}
+
+// ZRange returns the members between the start and stop ranks,
+// both inclusive. Negative ranks count from the highest score.
+func (s *Store) ZRange(ctx context.Context, loc *eventstore.LocationType, start, stop int) ([]server.ScoredMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSortedSet)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := e.sorted()
+	start, stop = span(len(sorted), start, stop)
+	if start > stop {
+		return []server.ScoredMember{}, nil
+	}
+
+	return sorted[start : stop+1], nil
+}
+
+// ZRemRangeByScore removes the members whose score is
+// between min and max, and returns them.
+func (s *Store) ZRemRangeByScore(ctx context.Context, loc *eventstore.LocationType, min, max float64) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSortedSet)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+	for _, m := range e.sorted() {
+		if m.Score >= min && m.Score <= max {
+			removed = append(removed, m.Member)
+		}
+	}
+
+	if len(removed) == 0 {
+		return removed, nil
+	}
+
+	if _, err := s.update(loc, e, &mutation{Op: opZRem, Members: removed}); err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
+
+// ZCard returns the number of members of the sorted set.
+func (s *Store) ZCard(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.lookupKind(loc, kindSortedSet)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(e.Scores), nil
+}
+
+// sorted returns the members of a sorted set ordered by
+// score, and lexicographically for equal scores.
+func (e *entry) sorted() []server.ScoredMember {
+	members := make([]server.ScoredMember, 0, len(e.Scores))
+	for m, score := range e.Scores {
+		members = append(members, server.ScoredMember{Member: m, Score: score})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return members[i].Member < members[j].Member
+	})
+
+	return members
+}
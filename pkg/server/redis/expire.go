@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// TTL implements server.Storage.
+func (s *Store) TTL(ctx context.Context, loc *eventstore.LocationType) (time.Duration, error) {
+	r, err := s.do(ctx, "PTTL", s.key(loc))
+	if err != nil {
+		return 0, err
+	}
+
+	switch ms, _ := r.(int64); {
+	case ms == -2:
+		return 0, errNotFound(loc)
+	case ms < 0:
+		return 0, nil
+	default:
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+}
+
+// Expire implements server.Storage. The version of KV entries, the
+// expiration of map fields, the reserved and scheduled items of
+// queues and the offsets committed to streams expire along with them.
+func (s *Store) Expire(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	res, err := s.multi(ctx,
+		cmd("PEXPIRE", s.key(loc), milliseconds(ttl)),
+		cmd("PEXPIRE", s.versionKey(loc), milliseconds(ttl)),
+		cmd("PEXPIRE", s.reservedKey(loc), milliseconds(ttl)),
+		cmd("PEXPIRE", s.scheduledKey(loc), milliseconds(ttl)),
+		cmd("PEXPIRE", s.offsetsKey(loc), milliseconds(ttl)),
+		cmd("PEXPIRE", s.fieldsKey(loc), milliseconds(ttl)))
+	if err != nil {
+		return err
+	}
+
+	if n, _ := res[0].(int64); n == 0 {
+		return errNotFound(loc)
+	}
+
+	return nil
+}
+
+// Persist implements server.Storage.
+func (s *Store) Persist(ctx context.Context, loc *eventstore.LocationType) error {
+	res, err := s.multi(ctx,
+		cmd("EXISTS", s.key(loc)),
+		cmd("PERSIST", s.key(loc)),
+		cmd("PERSIST", s.versionKey(loc)),
+		cmd("PERSIST", s.reservedKey(loc)),
+		cmd("PERSIST", s.scheduledKey(loc)),
+		cmd("PERSIST", s.offsetsKey(loc)),
+		cmd("PERSIST", s.fieldsKey(loc)))
+	if err != nil {
+		return err
+	}
+
+	if n, _ := res[0].(int64); n == 0 {
+		return errNotFound(loc)
+	}
+
+	return nil
+}
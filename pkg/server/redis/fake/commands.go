@@ -0,0 +1,1386 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// command describes a supported command. Arity is the minimum number of
+// arguments including the command name, and pairs informs that the
+// arguments after the minimum come in pairs.
+type command struct {
+	arity int
+	pairs bool
+	fn    func(s *Server, ss *session, args [][]byte) interface{}
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"PING":   {1, false, ping},
+		"AUTH":   {2, false, ok},
+		"SELECT": {2, false, selectDB},
+		"TIME":   {1, false, timeOf},
+
+		"SET":         {3, false, set},
+		"GET":         {2, false, get},
+		"DEL":         {2, false, del},
+		"EXISTS":      {2, false, exists},
+		"TYPE":        {2, false, typeOf},
+		"INCR":        {2, false, incr},
+		"INCRBY":      {3, false, incrBy},
+		"INCRBYFLOAT": {3, false, incrByFloat},
+		"PEXPIRE":     {3, false, pexpire},
+		"PERSIST":     {2, false, persist},
+		"PTTL":        {2, false, pttl},
+		"SCAN":        {2, true, scan},
+
+		"HSET":         {4, true, hset},
+		"HSETNX":       {4, false, hsetnx},
+		"HGET":         {3, false, hget},
+		"HDEL":         {3, false, hdel},
+		"HEXISTS":      {3, false, hexists},
+		"HINCRBY":      {4, false, hincrBy},
+		"HINCRBYFLOAT": {4, false, hincrByFloat},
+		"HGETALL":      {2, false, hgetall},
+		"HLEN":         {2, false, hlen},
+
+		"RPUSH":  {3, false, rpush},
+		"RPUSHX": {3, false, rpushx},
+		"LPUSH":  {3, false, lpush},
+		"LINDEX": {3, false, lindex},
+		"LSET":   {4, false, lset},
+		"LPOP":   {2, false, lpop},
+		"RPOP":   {2, false, rpop},
+		"LREM":   {4, false, lrem},
+		"LRANGE": {4, false, lrange},
+		"LTRIM":  {4, false, ltrim},
+		"LLEN":   {2, false, llen},
+
+		"SADD":        {3, false, sadd},
+		"SREM":        {3, false, srem},
+		"SISMEMBER":   {3, false, sismember},
+		"SMEMBERS":    {2, false, smembers},
+		"SCARD":       {2, false, scard},
+		"SRANDMEMBER": {3, false, srandmember},
+
+		"ZADD":          {4, true, zadd},
+		"ZREM":          {3, false, zrem},
+		"ZSCORE":        {3, false, zscore},
+		"ZRANGE":        {4, false, zrange},
+		"ZRANGEBYSCORE": {4, false, zrangeByScore},
+		"ZCARD":         {2, false, zcard},
+
+		"XADD":      {5, true, xadd},
+		"XRANGE":    {4, false, xrange},
+		"XREVRANGE": {4, false, xrevrange},
+		"XDEL":      {3, false, xdel},
+		"XLEN":      {2, false, xlen},
+	}
+}
+
+func ping(s *Server, ss *session, args [][]byte) interface{} {
+	return status("PONG")
+}
+
+func ok(s *Server, ss *session, args [][]byte) interface{} {
+	return status("OK")
+}
+
+func timeOf(s *Server, ss *session, args [][]byte) interface{} {
+	now := s.now()
+	return []interface{}{
+		[]byte(strconv.FormatInt(now.Unix(), 10)),
+		[]byte(strconv.Itoa(now.Nanosecond() / 1000)),
+	}
+}
+
+func selectDB(s *Server, ss *session, args [][]byte) interface{} {
+	db, err := strconv.Atoi(string(args[0]))
+	if err != nil || db < 0 {
+		return errorReply("ERR DB index is out of range")
+	}
+	ss.db = db
+	return status("OK")
+}
+
+func set(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v := &value{typ: typeString, str: args[1]}
+
+	nx := false
+	for i := 2; i < len(args); i++ {
+		switch strings.ToUpper(string(args[i])) {
+		case "NX":
+			nx = true
+		case "PX":
+			if i+1 >= len(args) {
+				return errorReply(errSyntax)
+			}
+			i++
+			ms, err := strconv.ParseInt(string(args[i]), 10, 64)
+			if err != nil || ms <= 0 {
+				return errorReply("ERR invalid expire time in 'set' command")
+			}
+			v.expireAt = s.now().Add(time.Duration(ms) * time.Millisecond)
+		default:
+			return errorReply(errSyntax)
+		}
+	}
+
+	if nx && s.lookup(ss.db, key) != nil {
+		return []byte(nil)
+	}
+
+	s.store(ss.db, key, v)
+	return status("OK")
+}
+
+func get(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeString)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return []byte(nil)
+	}
+	return v.str
+}
+
+func del(s *Server, ss *session, args [][]byte) interface{} {
+	var n int64
+	for _, k := range args {
+		if s.lookup(ss.db, string(k)) != nil {
+			s.remove(ss.db, string(k))
+			n++
+		}
+	}
+	return n
+}
+
+func exists(s *Server, ss *session, args [][]byte) interface{} {
+	var n int64
+	for _, k := range args {
+		if s.lookup(ss.db, string(k)) != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func typeOf(s *Server, ss *session, args [][]byte) interface{} {
+	v := s.lookup(ss.db, string(args[0]))
+	if v == nil {
+		return status("none")
+	}
+	return status(v.typ)
+}
+
+func incr(s *Server, ss *session, args [][]byte) interface{} {
+	return incrBy(s, ss, [][]byte{args[0], []byte("1")})
+}
+
+func incrBy(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	delta, err := strconv.ParseInt(string(args[1]), 10, 64)
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeString)
+	if rerr != nil {
+		return rerr
+	}
+
+	var current int64
+	if v != nil {
+		if current, err = strconv.ParseInt(string(v.str), 10, 64); err != nil {
+			return errorReply(errNotInt)
+		}
+	} else {
+		v = &value{typ: typeString}
+	}
+
+	n, overflow := add(current, delta)
+	if overflow {
+		return errorReply(errOverflow)
+	}
+
+	v.str = []byte(strconv.FormatInt(n, 10))
+	s.store(ss.db, key, v)
+	return n
+}
+
+func incrByFloat(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	delta, err := parseFloat(args[1])
+	if err != nil {
+		return errorReply(errNotFloat)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeString)
+	if rerr != nil {
+		return rerr
+	}
+
+	var current float64
+	if v != nil {
+		if current, err = parseFloat(v.str); err != nil {
+			return errorReply(errNotFloat)
+		}
+	} else {
+		v = &value{typ: typeString}
+	}
+
+	f := current + delta
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return errorReply(errNaN)
+	}
+
+	v.str = []byte(strconv.FormatFloat(f, 'f', -1, 64))
+	s.store(ss.db, key, v)
+	return v.str
+}
+
+func pexpire(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	ms, err := strconv.ParseInt(string(args[1]), 10, 64)
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v := s.lookup(ss.db, key)
+	if v == nil {
+		return int64(0)
+	}
+
+	if ms <= 0 {
+		s.remove(ss.db, key)
+		return int64(1)
+	}
+
+	v.expireAt = s.now().Add(time.Duration(ms) * time.Millisecond)
+	s.touch(ss.db, key)
+	return int64(1)
+}
+
+func persist(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v := s.lookup(ss.db, key)
+	if v == nil || v.expireAt.IsZero() {
+		return int64(0)
+	}
+
+	v.expireAt = time.Time{}
+	s.touch(ss.db, key)
+	return int64(1)
+}
+
+func pttl(s *Server, ss *session, args [][]byte) interface{} {
+	v := s.lookup(ss.db, string(args[0]))
+	switch {
+	case v == nil:
+		return int64(-2)
+	case v.expireAt.IsZero():
+		return int64(-1)
+	}
+	return int64(v.expireAt.Sub(s.now()) / time.Millisecond)
+}
+
+func hset(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeHash)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeHash, hash: make(map[string][]byte)}
+	}
+
+	var n int64
+	for i := 1; i+1 < len(args); i += 2 {
+		if _, ok := v.hash[string(args[i])]; !ok {
+			n++
+		}
+		v.hash[string(args[i])] = args[i+1]
+	}
+
+	s.store(ss.db, key, v)
+	return n
+}
+
+func hsetnx(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeHash)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeHash, hash: make(map[string][]byte)}
+	}
+
+	if _, ok := v.hash[string(args[1])]; ok {
+		return int64(0)
+	}
+
+	v.hash[string(args[1])] = args[2]
+	s.store(ss.db, key, v)
+	return int64(1)
+}
+
+func hget(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeHash)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return []byte(nil)
+	}
+
+	f, ok := v.hash[string(args[1])]
+	if !ok {
+		return []byte(nil)
+	}
+	return f
+}
+
+func hdel(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeHash)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+
+	var n int64
+	for _, f := range args[1:] {
+		if _, ok := v.hash[string(f)]; ok {
+			delete(v.hash, string(f))
+			n++
+		}
+	}
+
+	if n > 0 {
+		s.touch(ss.db, key)
+		s.removeEmpty(ss.db, key, v)
+	}
+	return n
+}
+
+func hexists(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeHash)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+
+	if _, ok := v.hash[string(args[1])]; ok {
+		return int64(1)
+	}
+	return int64(0)
+}
+
+func hincrBy(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	delta, err := strconv.ParseInt(string(args[2]), 10, 64)
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeHash)
+	if rerr != nil {
+		return rerr
+	}
+	if v == nil {
+		v = &value{typ: typeHash, hash: make(map[string][]byte)}
+	}
+
+	var current int64
+	if f, ok := v.hash[string(args[1])]; ok {
+		if current, err = strconv.ParseInt(string(f), 10, 64); err != nil {
+			return errorReply(errHashInt)
+		}
+	}
+
+	n, overflow := add(current, delta)
+	if overflow {
+		return errorReply(errOverflow)
+	}
+
+	v.hash[string(args[1])] = []byte(strconv.FormatInt(n, 10))
+	s.store(ss.db, key, v)
+	return n
+}
+
+func hincrByFloat(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	delta, err := parseFloat(args[2])
+	if err != nil {
+		return errorReply(errNotFloat)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeHash)
+	if rerr != nil {
+		return rerr
+	}
+	if v == nil {
+		v = &value{typ: typeHash, hash: make(map[string][]byte)}
+	}
+
+	var current float64
+	if f, ok := v.hash[string(args[1])]; ok {
+		if current, err = parseFloat(f); err != nil {
+			return errorReply(errHashFloat)
+		}
+	}
+
+	f := current + delta
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return errorReply(errNaN)
+	}
+
+	b := []byte(strconv.FormatFloat(f, 'f', -1, 64))
+	v.hash[string(args[1])] = b
+	s.store(ss.db, key, v)
+	return b
+}
+
+func hgetall(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeHash)
+	if err != nil {
+		return err
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	for k, f := range v.hash {
+		res = append(res, []byte(k), f)
+	}
+	return res
+}
+
+func hlen(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeHash)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+	return int64(len(v.hash))
+}
+
+func rpush(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeList)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeList}
+	}
+
+	v.list = append(v.list, args[1:]...)
+	s.store(ss.db, key, v)
+	return int64(len(v.list))
+}
+
+func rpushx(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeList)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+	return rpush(s, ss, args)
+}
+
+func lpush(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeList)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeList}
+	}
+
+	list := make([][]byte, 0, len(args)-1+len(v.list))
+	for i := len(args) - 1; i > 0; i-- {
+		list = append(list, args[i])
+	}
+	v.list = append(list, v.list...)
+	s.store(ss.db, key, v)
+	return int64(len(v.list))
+}
+
+func lindex(s *Server, ss *session, args [][]byte) interface{} {
+	index, err := strconv.Atoi(string(args[1]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, string(args[0]), typeList)
+	if rerr != nil {
+		return rerr
+	}
+	if v == nil {
+		return []byte(nil)
+	}
+
+	if index < 0 {
+		index += len(v.list)
+	}
+	if index < 0 || index >= len(v.list) {
+		return []byte(nil)
+	}
+	return v.list[index]
+}
+
+func lset(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	index, err := strconv.Atoi(string(args[1]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeList)
+	if rerr != nil {
+		return rerr
+	}
+	if v == nil {
+		return errorReply("ERR no such key")
+	}
+
+	if index < 0 {
+		index += len(v.list)
+	}
+	if index < 0 || index >= len(v.list) {
+		return errorReply("ERR index out of range")
+	}
+
+	v.list[index] = args[2]
+	s.touch(ss.db, key)
+	return status("OK")
+}
+
+func lpop(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeList)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return []byte(nil)
+	}
+
+	item := v.list[0]
+	v.list = v.list[1:]
+	s.touch(ss.db, key)
+	s.removeEmpty(ss.db, key, v)
+	return item
+}
+
+func rpop(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeList)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return []byte(nil)
+	}
+
+	item := v.list[len(v.list)-1]
+	v.list = v.list[:len(v.list)-1]
+	s.touch(ss.db, key)
+	s.removeEmpty(ss.db, key, v)
+	return item
+}
+
+func lrem(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	count, err := strconv.Atoi(string(args[1]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeList)
+	if rerr != nil {
+		return rerr
+	}
+	if v == nil {
+		return int64(0)
+	}
+
+	// Negative counts remove from the tail, which is
+	// implemented by walking the reversed list.
+	list, fromTail := v.list, count < 0
+	if fromTail {
+		list = reverse(list)
+		count = -count
+	}
+
+	var n int64
+	kept := make([][]byte, 0, len(list))
+	for _, item := range list {
+		if (count == 0 || n < int64(count)) && string(item) == string(args[2]) {
+			n++
+			continue
+		}
+		kept = append(kept, item)
+	}
+
+	if fromTail {
+		kept = reverse(kept)
+	}
+
+	if n > 0 {
+		v.list = kept
+		s.touch(ss.db, key)
+		s.removeEmpty(ss.db, key, v)
+	}
+	return n
+}
+
+func reverse(list [][]byte) [][]byte {
+	r := make([][]byte, len(list))
+	for i, item := range list {
+		r[len(list)-1-i] = item
+	}
+	return r
+}
+
+func lrange(s *Server, ss *session, args [][]byte) interface{} {
+	start, err := strconv.Atoi(string(args[1]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+	stop, err := strconv.Atoi(string(args[2]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, string(args[0]), typeList)
+	if rerr != nil {
+		return rerr
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	n := len(v.list)
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+
+	for i := start; i <= stop; i++ {
+		res = append(res, v.list[i])
+	}
+	return res
+}
+
+func ltrim(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	start, err := strconv.Atoi(string(args[1]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+	stop, err := strconv.Atoi(string(args[2]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, key, typeList)
+	if rerr != nil {
+		return rerr
+	}
+	if v == nil {
+		return status("OK")
+	}
+
+	n := len(v.list)
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+
+	if start > stop {
+		v.list = nil
+	} else {
+		v.list = v.list[start : stop+1]
+	}
+	s.touch(ss.db, key)
+	s.removeEmpty(ss.db, key, v)
+	return status("OK")
+}
+
+func llen(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeList)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+	return int64(len(v.list))
+}
+
+func sadd(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeSet, set: make(map[string]bool)}
+	}
+
+	var n int64
+	for _, m := range args[1:] {
+		if !v.set[string(m)] {
+			v.set[string(m)] = true
+			n++
+		}
+	}
+
+	// Like Redis, transactions watching the key are
+	// only aborted when members are added.
+	if n > 0 {
+		s.store(ss.db, key, v)
+	}
+	return n
+}
+
+func srem(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+
+	var n int64
+	for _, m := range args[1:] {
+		if v.set[string(m)] {
+			delete(v.set, string(m))
+			n++
+		}
+	}
+
+	if n > 0 {
+		s.touch(ss.db, key)
+		s.removeEmpty(ss.db, key, v)
+	}
+	return n
+}
+
+func sismember(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeSet)
+	if err != nil {
+		return err
+	}
+	if v == nil || !v.set[string(args[1])] {
+		return int64(0)
+	}
+	return int64(1)
+}
+
+func smembers(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeSet)
+	if err != nil {
+		return err
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	for m := range v.set {
+		res = append(res, []byte(m))
+	}
+	return res
+}
+
+func scard(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+	return int64(len(v.set))
+}
+
+// srandmember supports positive counts only, which
+// return distinct members.
+func srandmember(s *Server, ss *session, args [][]byte) interface{} {
+	count, err := strconv.Atoi(string(args[1]))
+	if err != nil || count < 0 {
+		return errorReply(errNotInt)
+	}
+
+	v, rerr := s.lookupType(ss.db, string(args[0]), typeSet)
+	if rerr != nil {
+		return rerr
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	for m := range v.set {
+		if len(res) == count {
+			break
+		}
+		res = append(res, []byte(m))
+	}
+	return res
+}
+
+func zadd(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeZSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeZSet, zset: make(map[string]float64)}
+	}
+
+	var n int64
+	for i := 1; i+1 < len(args); i += 2 {
+		score, err := parseScore(args[i])
+		if err != nil {
+			return errorReply(errNotFloat)
+		}
+		if _, ok := v.zset[string(args[i+1])]; !ok {
+			n++
+		}
+		v.zset[string(args[i+1])] = score
+	}
+
+	s.store(ss.db, key, v)
+	return n
+}
+
+func zrem(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeZSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+
+	var n int64
+	for _, m := range args[1:] {
+		if _, ok := v.zset[string(m)]; ok {
+			delete(v.zset, string(m))
+			n++
+		}
+	}
+
+	if n > 0 {
+		s.touch(ss.db, key)
+		s.removeEmpty(ss.db, key, v)
+	}
+	return n
+}
+
+// zrange supports ranges by index, optionally with scores.
+func zrange(s *Server, ss *session, args [][]byte) interface{} {
+	start, err := strconv.Atoi(string(args[1]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+	stop, err := strconv.Atoi(string(args[2]))
+	if err != nil {
+		return errorReply(errNotInt)
+	}
+
+	withScores := false
+	for _, opt := range args[3:] {
+		if strings.ToUpper(string(opt)) != "WITHSCORES" {
+			return errorReply(errSyntax)
+		}
+		withScores = true
+	}
+
+	v, rerr := s.lookupType(ss.db, string(args[0]), typeZSet)
+	if rerr != nil {
+		return rerr
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	members := sortedMembers(v.zset)
+	n := len(members)
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+
+	for i := start; i <= stop; i++ {
+		res = append(res, []byte(members[i]))
+		if withScores {
+			res = append(res, formatScore(v.zset[members[i]]))
+		}
+	}
+	return res
+}
+
+// zrangeByScore supports inclusive ranges, optionally
+// with scores and limited.
+func zrangeByScore(s *Server, ss *session, args [][]byte) interface{} {
+	min, err := parseScore(args[1])
+	if err != nil {
+		return errorReply(errMinMax)
+	}
+	max, err := parseScore(args[2])
+	if err != nil {
+		return errorReply(errMinMax)
+	}
+
+	withScores := false
+	offset, count := 0, -1
+	for i := 3; i < len(args); i++ {
+		switch strings.ToUpper(string(args[i])) {
+		case "WITHSCORES":
+			withScores = true
+		case "LIMIT":
+			if i+2 >= len(args) {
+				return errorReply(errSyntax)
+			}
+			if offset, err = strconv.Atoi(string(args[i+1])); err != nil {
+				return errorReply(errNotInt)
+			}
+			if count, err = strconv.Atoi(string(args[i+2])); err != nil {
+				return errorReply(errNotInt)
+			}
+			i += 2
+		default:
+			return errorReply(errSyntax)
+		}
+	}
+
+	v, rerr := s.lookupType(ss.db, string(args[0]), typeZSet)
+	if rerr != nil {
+		return rerr
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	for _, m := range sortedMembers(v.zset) {
+		score := v.zset[m]
+		if score < min || score > max {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if count == 0 {
+			break
+		}
+		count--
+
+		res = append(res, []byte(m))
+		if withScores {
+			res = append(res, formatScore(score))
+		}
+	}
+	return res
+}
+
+func zscore(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeZSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return []byte(nil)
+	}
+
+	score, ok := v.zset[string(args[1])]
+	if !ok {
+		return []byte(nil)
+	}
+	return formatScore(score)
+}
+
+func zcard(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeZSet)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+	return int64(len(v.zset))
+}
+
+// sortedMembers returns the members of a sorted set ordered
+// by score, and lexicographically for equal scores.
+func sortedMembers(zset map[string]float64) []string {
+	members := make([]string, 0, len(zset))
+	for m := range zset {
+		members = append(members, m)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		if zset[a] != zset[b] {
+			return zset[a] < zset[b]
+		}
+		return a < b
+	})
+
+	return members
+}
+
+// xadd supports explicit entry IDs only.
+func xadd(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	id, ok := parseStreamID(args[1], 0)
+	if !ok {
+		return errorReply(errStreamID)
+	}
+	if id == (streamID{}) {
+		return errorReply("ERR The ID specified in XADD must be greater than 0-0")
+	}
+
+	v, err := s.lookupType(ss.db, key, typeStream)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		v = &value{typ: typeStream}
+	}
+	if !v.lastID.less(id) {
+		return errorReply("ERR The ID specified in XADD is equal or smaller than the target stream top item")
+	}
+
+	fields := make([][]byte, len(args)-2)
+	copy(fields, args[2:])
+	v.stream = append(v.stream, streamEntry{id: id, fields: fields})
+	v.lastID = id
+
+	s.store(ss.db, key, v)
+	return []byte(id.String())
+}
+
+func xrange(s *Server, ss *session, args [][]byte) interface{} {
+	return streamRange(s, ss, args[0], args[1], args[2], args[3:], false)
+}
+
+func xrevrange(s *Server, ss *session, args [][]byte) interface{} {
+	return streamRange(s, ss, args[0], args[2], args[1], args[3:], true)
+}
+
+// streamRange returns the entries between the start and end IDs, both
+// inclusive, which can be - and + for the first and last entries.
+func streamRange(s *Server, ss *session, key, start, end []byte, opts [][]byte, rev bool) interface{} {
+	first, ok := parseStreamID(start, 0)
+	if string(start) == "-" {
+		first, ok = streamID{}, true
+	}
+	if !ok {
+		return errorReply(errStreamID)
+	}
+	last, ok := parseStreamID(end, math.MaxUint64)
+	if string(end) == "+" {
+		last, ok = streamID{math.MaxUint64, math.MaxUint64}, true
+	}
+	if !ok {
+		return errorReply(errStreamID)
+	}
+
+	count := -1
+	if len(opts) > 0 {
+		if len(opts) != 2 || strings.ToUpper(string(opts[0])) != "COUNT" {
+			return errorReply(errSyntax)
+		}
+		var err error
+		if count, err = strconv.Atoi(string(opts[1])); err != nil {
+			return errorReply(errNotInt)
+		}
+	}
+
+	v, err := s.lookupType(ss.db, string(key), typeStream)
+	if err != nil {
+		return err
+	}
+
+	res := []interface{}{}
+	if v == nil {
+		return res
+	}
+
+	for i := range v.stream {
+		e := v.stream[i]
+		if rev {
+			e = v.stream[len(v.stream)-1-i]
+		}
+		if e.id.less(first) || last.less(e.id) {
+			continue
+		}
+		if count == 0 {
+			break
+		}
+		count--
+
+		fields := make([]interface{}, 0, len(e.fields))
+		for _, f := range e.fields {
+			fields = append(fields, f)
+		}
+		res = append(res, []interface{}{[]byte(e.id.String()), fields})
+	}
+	return res
+}
+
+// xdel removes entries without removing the stream, which
+// is kept even when empty.
+func xdel(s *Server, ss *session, args [][]byte) interface{} {
+	key := string(args[0])
+	v, err := s.lookupType(ss.db, key, typeStream)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+
+	ids := make(map[streamID]bool, len(args)-1)
+	for _, a := range args[1:] {
+		id, ok := parseStreamID(a, 0)
+		if !ok {
+			return errorReply(errStreamID)
+		}
+		ids[id] = true
+	}
+
+	var n int64
+	kept := v.stream[:0]
+	for _, e := range v.stream {
+		if ids[e.id] {
+			n++
+			continue
+		}
+		kept = append(kept, e)
+	}
+	v.stream = kept
+
+	if n > 0 {
+		s.touch(ss.db, key)
+	}
+	return n
+}
+
+func xlen(s *Server, ss *session, args [][]byte) interface{} {
+	v, err := s.lookupType(ss.db, string(args[0]), typeStream)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return int64(0)
+	}
+	return int64(len(v.stream))
+}
+
+// parseStreamID parses IDs in the ms-seq form, using
+// the default sequence number when it is missing.
+func parseStreamID(b []byte, seq uint64) (streamID, bool) {
+	parts := strings.SplitN(string(b), "-", 2)
+	ms, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return streamID{}, false
+	}
+	if len(parts) == 2 {
+		if seq, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+			return streamID{}, false
+		}
+	}
+	return streamID{ms, seq}, true
+}
+
+// scan iterates the sorted live keys, using the
+// position of the next key as the cursor.
+func scan(s *Server, ss *session, args [][]byte) interface{} {
+	cursor, err := strconv.Atoi(string(args[0]))
+	if err != nil || cursor < 0 {
+		return errorReply("ERR invalid cursor")
+	}
+
+	pattern, count := "*", 10
+	for i := 1; i < len(args); i += 2 {
+		switch strings.ToUpper(string(args[i])) {
+		case "MATCH":
+			pattern = string(args[i+1])
+		case "COUNT":
+			count, err = strconv.Atoi(string(args[i+1]))
+			if err != nil || count < 1 {
+				return errorReply(errSyntax)
+			}
+		default:
+			return errorReply(errSyntax)
+		}
+	}
+
+	var keys []string
+	for k := range s.dbs[ss.db] {
+		if s.lookup(ss.db, k) != nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	res := []interface{}{}
+	next := 0
+	for i := cursor; i < len(keys); i++ {
+		if i-cursor == count {
+			next = i
+			break
+		}
+		if match(pattern, keys[i]) {
+			res = append(res, []byte(keys[i]))
+		}
+	}
+
+	return []interface{}{[]byte(strconv.Itoa(next)), res}
+}
+
+// match reports whether the key matches the glob pattern. Only
+// *, ? and backslash escapes are supported.
+func match(pattern, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(key); i >= 0; i-- {
+				if match(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(key) == 0 || key[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, key = pattern[1:], key[1:]
+	}
+	return len(key) == 0
+}
+
+// parseScore parses sorted set scores, which might be infinite.
+func parseScore(b []byte) (float64, error) {
+	f, err := strconv.ParseFloat(string(b), 64)
+	if err == nil && math.IsNaN(f) {
+		err = strconv.ErrSyntax
+	}
+	return f, err
+}
+
+// formatScore formats sorted set scores the way Redis does.
+func formatScore(f float64) []byte {
+	switch {
+	case math.IsInf(f, 1):
+		return []byte("inf")
+	case math.IsInf(f, -1):
+		return []byte("-inf")
+	}
+	return []byte(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// parseFloat parses finite floating point numbers.
+func parseFloat(b []byte) (float64, error) {
+	f, err := strconv.ParseFloat(string(b), 64)
+	if err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+		err = strconv.ErrRange
+	}
+	return f, err
+}
+
+// add returns a+b, informing whether the operation overflows.
+func add(a, b int64) (int64, bool) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, true
+	}
+	return a + b, false
+}
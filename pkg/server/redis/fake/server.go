@@ -0,0 +1,455 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-process server that speaks the Redis
+// protocol, implementing the subset of commands used by the
+// EventStore Redis storage driver.
+package fake
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redis types.
+const (
+	typeString = "string"
+	typeHash   = "hash"
+	typeList   = "list"
+	typeSet    = "set"
+	typeZSet   = "zset"
+	typeStream = "stream"
+)
+
+const (
+	errWrongType = "WRONGTYPE Operation against a key holding the wrong kind of value"
+	errNotInt    = "ERR value is not an integer or out of range"
+	errHashInt   = "ERR hash value is not an integer"
+	errOverflow  = "ERR increment or decrement would overflow"
+	errNotFloat  = "ERR value is not a valid float"
+	errHashFloat = "ERR hash value is not a float"
+	errNaN       = "ERR increment would produce NaN or Infinity"
+	errSyntax    = "ERR syntax error"
+	errMinMax    = "ERR min or max is not a float"
+	errStreamID  = "ERR Invalid stream ID specified as stream command argument"
+)
+
+// status is a simple string reply.
+type status string
+
+// errorReply is an error reply.
+type errorReply string
+
+type value struct {
+	typ      string
+	str      []byte
+	hash     map[string][]byte
+	list     [][]byte
+	set      map[string]bool
+	zset     map[string]float64
+	stream   []streamEntry
+	lastID   streamID
+	expireAt time.Time
+}
+
+// streamID identifies stream entries.
+type streamID struct {
+	ms, seq uint64
+}
+
+func (id streamID) less(other streamID) bool {
+	return id.ms < other.ms || (id.ms == other.ms && id.seq < other.seq)
+}
+
+func (id streamID) String() string {
+	return fmt.Sprintf("%d-%d", id.ms, id.seq)
+}
+
+type streamEntry struct {
+	id     streamID
+	fields [][]byte
+}
+
+// Server is an in-process Redis protocol server.
+type Server struct {
+	ln net.Listener
+
+	mu       sync.Mutex
+	dbs      map[int]map[string]*value
+	versions map[int]map[string]uint64
+	conns    map[net.Conn]struct{}
+	offset   time.Duration
+
+	wg sync.WaitGroup
+}
+
+// NewServer starts a server listening at a random local port.
+func NewServer() (*Server, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		ln:       ln,
+		dbs:      make(map[int]map[string]*value),
+		versions: make(map[int]map[string]uint64),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns the address the server listens at.
+func (s *Server) Addr() string {
+	return s.ln.Addr().String()
+}
+
+// Close stops the server and closes all client connections.
+func (s *Server) Close() error {
+	err := s.ln.Close()
+
+	s.mu.Lock()
+	for c := range s.conns {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+// FastForward moves the server clock forward, expiring keys
+// whose TTL is shorter than the duration.
+func (s *Server) FastForward(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.offset += d
+}
+
+func (s *Server) now() time.Time {
+	return time.Now().Add(s.offset)
+}
+
+// Keys returns the live keys at the database, which
+// helps to inspect how data is laid out.
+func (s *Server) Keys(db int) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var keys []string
+	for k := range s.dbs[db] {
+		if s.lookup(db, k) != nil {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		nc, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns[nc] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.handle(nc)
+	}
+}
+
+// session holds the state of a client connection.
+type session struct {
+	db      int
+	watched map[string]uint64
+	queued  [][][]byte
+	inMulti bool
+	dirty   bool
+}
+
+func (s *Server) handle(nc net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, nc)
+		s.mu.Unlock()
+		_ = nc.Close()
+	}()
+
+	br := bufio.NewReader(nc)
+	bw := bufio.NewWriter(nc)
+	ss := &session{watched: make(map[string]uint64)}
+
+	for {
+		args, err := readCommand(br)
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		r := s.dispatch(ss, args)
+		s.mu.Unlock()
+
+		writeReply(bw, r)
+		if err := bw.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// dispatch handles transaction commands and queues or
+// runs the rest. Must be called with the lock held.
+func (s *Server) dispatch(ss *session, args [][]byte) interface{} {
+	name := strings.ToUpper(string(args[0]))
+
+	switch name {
+	case "MULTI":
+		if ss.inMulti {
+			return errorReply("ERR MULTI calls can not be nested")
+		}
+		ss.inMulti = true
+		return status("OK")
+
+	case "DISCARD":
+		if !ss.inMulti {
+			return errorReply("ERR DISCARD without MULTI")
+		}
+		ss.reset()
+		return status("OK")
+
+	case "EXEC":
+		if !ss.inMulti {
+			return errorReply("ERR EXEC without MULTI")
+		}
+		defer ss.reset()
+
+		if ss.dirty {
+			return errorReply("EXECABORT Transaction discarded because of previous errors.")
+		}
+		for k, v := range ss.watched {
+			s.lookup(ss.db, k)
+			if s.versions[ss.db][k] != v {
+				return []interface{}(nil)
+			}
+		}
+
+		res := make([]interface{}, len(ss.queued))
+		for i, q := range ss.queued {
+			res[i] = s.run(ss, q)
+		}
+		return res
+
+	case "WATCH":
+		if ss.inMulti {
+			return errorReply("ERR WATCH inside MULTI is not allowed")
+		}
+		for _, k := range args[1:] {
+			s.lookup(ss.db, string(k))
+			ss.watched[string(k)] = s.versions[ss.db][string(k)]
+		}
+		return status("OK")
+
+	case "UNWATCH":
+		ss.watched = make(map[string]uint64)
+		return status("OK")
+	}
+
+	if ss.inMulti {
+		if _, ok := commands[name]; !ok {
+			ss.dirty = true
+			return unknownCommand(name)
+		}
+		ss.queued = append(ss.queued, args)
+		return status("QUEUED")
+	}
+
+	return s.run(ss, args)
+}
+
+func (ss *session) reset() {
+	ss.inMulti = false
+	ss.dirty = false
+	ss.queued = nil
+	ss.watched = make(map[string]uint64)
+}
+
+func (s *Server) run(ss *session, args [][]byte) interface{} {
+	name := strings.ToUpper(string(args[0]))
+	c, ok := commands[name]
+	if !ok {
+		return unknownCommand(name)
+	}
+
+	if len(args) < c.arity || (c.pairs && (len(args)-c.arity)%2 != 0) {
+		return errorReply(fmt.Sprintf("ERR wrong number of arguments for '%s' command", strings.ToLower(name)))
+	}
+
+	return c.fn(s, ss, args[1:])
+}
+
+func unknownCommand(name string) errorReply {
+	return errorReply(fmt.Sprintf("ERR unknown command '%s'", name))
+}
+
+// lookup returns the live value for the key, removing it
+// if expired. Must be called with the lock held.
+func (s *Server) lookup(db int, key string) *value {
+	v, ok := s.dbs[db][key]
+	if !ok {
+		return nil
+	}
+
+	if !v.expireAt.IsZero() && !s.now().Before(v.expireAt) {
+		s.remove(db, key)
+		return nil
+	}
+
+	return v
+}
+
+// lookupType returns the value for the key, failing if it holds
+// a different type. Missing keys return a nil value.
+func (s *Server) lookupType(db int, key, typ string) (*value, interface{}) {
+	v := s.lookup(db, key)
+	if v != nil && v.typ != typ {
+		return nil, errorReply(errWrongType)
+	}
+	return v, nil
+}
+
+func (s *Server) store(db int, key string, v *value) {
+	if s.dbs[db] == nil {
+		s.dbs[db] = make(map[string]*value)
+	}
+	s.dbs[db][key] = v
+	s.touch(db, key)
+}
+
+func (s *Server) remove(db int, key string) {
+	delete(s.dbs[db], key)
+	s.touch(db, key)
+}
+
+// touch signals a key modification to transactions watching it.
+func (s *Server) touch(db int, key string) {
+	if s.versions[db] == nil {
+		s.versions[db] = make(map[string]uint64)
+	}
+	s.versions[db][key]++
+}
+
+// removeEmpty deletes hashes, lists, sets and sorted sets left without elements.
+func (s *Server) removeEmpty(db int, key string, v *value) {
+	if len(v.hash) == 0 && len(v.list) == 0 && len(v.set) == 0 && len(v.zset) == 0 {
+		s.remove(db, key)
+	}
+}
+
+func readCommand(r *bufio.Reader) ([][]byte, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 || line[0] != '*' {
+		return nil, errors.New("expected array")
+	}
+
+	n, err := strconv.Atoi(string(line[1:]))
+	if err != nil || n < 1 {
+		return nil, errors.New("invalid array length")
+	}
+
+	args := make([][]byte, n)
+	for i := range args {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(line) == 0 || line[0] != '$' {
+			return nil, errors.New("expected bulk string")
+		}
+
+		l, err := strconv.Atoi(string(line[1:]))
+		if err != nil || l < 0 {
+			return nil, errors.New("invalid bulk string length")
+		}
+
+		b := make([]byte, l+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		args[i] = b[:l]
+	}
+
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, errors.New("malformed line")
+	}
+	return line[:len(line)-2], nil
+}
+
+func writeReply(w *bufio.Writer, r interface{}) {
+	switch v := r.(type) {
+	case status:
+		fmt.Fprintf(w, "+%s\r\n", v)
+	case errorReply:
+		fmt.Fprintf(w, "-%s\r\n", v)
+	case int64:
+		fmt.Fprintf(w, ":%d\r\n", v)
+	case []byte:
+		if v == nil {
+			_, _ = w.WriteString("$-1\r\n")
+			return
+		}
+		fmt.Fprintf(w, "$%d\r\n", len(v))
+		_, _ = w.Write(v)
+		_, _ = w.WriteString("\r\n")
+	case []interface{}:
+		if v == nil {
+			_, _ = w.WriteString("*-1\r\n")
+			return
+		}
+		fmt.Fprintf(w, "*%d\r\n", len(v))
+		for _, e := range v {
+			writeReply(w, e)
+		}
+	default:
+		fmt.Fprintf(w, "-ERR unexpected reply %T\r\n", r)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Set implements server.KV.
+func (s *Store) Set(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	version, err := s.nextVersion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := s.multi(ctx, s.setCmds(loc, value, ttl, version)...); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// SetNX implements server.KV.
+func (s *Store) SetNX(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	return s.setIf(ctx, loc, value, ttl, func(typ string, _ uint64) error {
+		if typ != typeNone {
+			return fmt.Errorf("key %q exists: %w", loc.GetKey(), server.ErrConflict)
+		}
+		return nil
+	})
+}
+
+// SetXX implements server.KV.
+func (s *Store) SetXX(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error) {
+	return s.setIf(ctx, loc, value, ttl, func(typ string, _ uint64) error {
+		if typ == typeNone {
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		}
+		return nil
+	})
+}
+
+// CompareAndSwap implements server.KV.
+func (s *Store) CompareAndSwap(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration, version uint64) (uint64, error) {
+	return s.setIf(ctx, loc, value, ttl, func(typ string, current uint64) error {
+		switch {
+		case typ == typeNone:
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		case typ != typeKV:
+			return checkType(loc, typ, typeKV)
+		case current != version:
+			return fmt.Errorf("key %q is at version %d, not %d: %w", loc.GetKey(), current, version, server.ErrConflict)
+		}
+		return nil
+	})
+}
+
+// setIf stores the value when the check for the current type and
+// version of the key succeeds.
+func (s *Store) setIf(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration,
+	check func(typ string, version uint64) error) (uint64, error) {
+	version, err := s.nextVersion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	key := s.key(loc)
+	_, err = s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		typ, _ := r.(string)
+
+		r, err = c.do(ctx, "GET", s.versionKey(loc))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := check(typ, parseVersion(r)); err != nil {
+			return nil, err
+		}
+
+		return s.setCmds(loc, value, ttl, version), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// setCmds returns the commands that store a KV entry and its version.
+func (s *Store) setCmds(loc *eventstore.LocationType, value []byte, ttl time.Duration, version uint64) [][]interface{} {
+	set := cmd("SET", s.key(loc), value)
+	setVersion := cmd("SET", s.versionKey(loc), int64(version))
+	if ttl > 0 {
+		set = append(set, "PX", milliseconds(ttl))
+		setVersion = append(setVersion, "PX", milliseconds(ttl))
+	}
+
+	return append([][]interface{}{set, setVersion}, s.indexCmds(loc, s.key(loc))...)
+}
+
+// Get implements server.KV.
+func (s *Store) Get(ctx context.Context, loc *eventstore.LocationType) ([]byte, uint64, error) {
+	res, err := s.multi(ctx, cmd("GET", s.key(loc)), cmd("GET", s.versionKey(loc)))
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := replyErr(loc, res[0]); err != nil {
+		return nil, 0, err
+	}
+
+	v, _ := res[0].([]byte)
+	if v == nil {
+		return nil, 0, errNotFound(loc)
+	}
+
+	return v, parseVersion(res[1]), nil
+}
+
+// Del implements server.Storage.
+func (s *Store) Del(ctx context.Context, loc *eventstore.LocationType) error {
+	res, err := s.multi(ctx,
+		cmd("DEL", s.key(loc)),
+		cmd("DEL", s.versionKey(loc), s.reservedKey(loc), s.scheduledKey(loc), s.offsetsKey(loc), s.fieldsKey(loc)),
+		s.unindexCmd(loc, s.key(loc)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if n, _ := res[0].(int64); n == 0 {
+		return errNotFound(loc)
+	}
+
+	return nil
+}
+
+// IncrBy implements server.KV.
+func (s *Store) IncrBy(ctx context.Context, loc *eventstore.LocationType, delta int64) (int64, error) {
+	r, err := s.incr(ctx, loc, cmd("INCRBY", s.key(loc), delta), func(v string) error {
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrNotInteger)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	n, _ := r.(int64)
+	return n, nil
+}
+
+// IncrByFloat implements server.KV.
+func (s *Store) IncrByFloat(ctx context.Context, loc *eventstore.LocationType, delta float64) (float64, error) {
+	r, err := s.incr(ctx, loc, cmd("INCRBYFLOAT", s.key(loc), formatFloat(delta)), func(v string) error {
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrNotFloat)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return parseFloat(r)
+}
+
+// incr runs the increment command for the KV entry at the location
+// and assigns it a new version. The check function validates the
+// current value, if any, and the increment reply is returned.
+func (s *Store) incr(ctx context.Context, loc *eventstore.LocationType, incrCmd []interface{},
+	check func(v string) error) (interface{}, error) {
+	version, err := s.nextVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := s.key(loc)
+	res, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		// Values that cannot be incremented are detected
+		// beforehand to keep their version.
+		r, err := c.do(ctx, "GET", key)
+		if err != nil {
+			return nil, translate(loc, err)
+		}
+		if v, _ := r.([]byte); v != nil {
+			if err := check(string(v)); err != nil {
+				return nil, err
+			}
+		}
+
+		r, err = c.do(ctx, "PTTL", key)
+		if err != nil {
+			return nil, err
+		}
+
+		// The version is written with the remaining
+		// TTL of the entry, if any.
+		setVersion := cmd("SET", s.versionKey(loc), int64(version))
+		if ttl, _ := r.(int64); ttl > 0 {
+			setVersion = append(setVersion, "PX", ttl)
+		}
+
+		return append([][]interface{}{incrCmd, setVersion}, s.indexCmds(loc, key)...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := replyErr(loc, res[0]); err != nil {
+		return nil, err
+	}
+
+	return res[0], nil
+}
+
+// parseVersion returns the version from a GET reply, entries
+// written without a version being at version zero.
+func parseVersion(reply interface{}) uint64 {
+	b, _ := reply.([]byte)
+	v, _ := strconv.ParseUint(string(b), 10, 64)
+	return v
+}
+
+// formatFloat formats floating point increments.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// parseFloat returns the number from a floating point increment reply.
+func parseFloat(reply interface{}) (float64, error) {
+	b, _ := reply.([]byte)
+	f, err := strconv.ParseFloat(string(b), 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected increment reply %q", b)
+	}
+	return f, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Lock implements server.Locker. Locks are stored as hashes with the
+// unlock token, the owner and the fencing token, which is taken from
+// the counter of versions.
+//
+// A plain SET NX PX of the unlock token is not enough: the fencing
+// token must be taken from the counter in the same step that grants
+// the lock, and exclusive locks can only be granted while no shared
+// lock readers hold the key. Both conditions span several keys, so
+// the lock is written in a MULTI block that is discarded when the
+// lock or readers keys change while they are checked.
+func (s *Store) Lock(ctx context.Context, loc *eventstore.LocationType, timeout time.Duration, owner string) (server.Lease, error) {
+	key, rkey := s.lockKey(loc), s.readersKey(loc)
+
+	token, err := newToken()
+	if err != nil {
+		return server.Lease{}, fmt.Errorf("generating unlock token: %w", err)
+	}
+
+	var fencing uint64
+	_, err = s.transactionAll(ctx, []string{key, rkey}, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrLocked)
+		}
+
+		readers, err := readHolders(ctx, c, rkey)
+		if err != nil {
+			return nil, err
+		}
+		if len(readers.live) != 0 {
+			return nil, fmt.Errorf("key %q is read locked: %w", loc.GetKey(), server.ErrLocked)
+		}
+
+		// versions skipped when the transaction is retried are
+		// never used, which keeps fencing tokens increasing.
+		if r, err = c.do(ctx, "INCR", s.prefix+":version"); err != nil {
+			return nil, err
+		}
+		n, _ := r.(int64)
+		fencing = uint64(n)
+
+		cmds := [][]interface{}{cmd("HSET", key, lockToken, token, lockOwner, owner, lockFencing, n)}
+		if exp := expireCmd(key, timeout); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, s.indexCmds(loc, key)...), nil
+	})
+	if err != nil {
+		return server.Lease{}, err
+	}
+
+	return server.Lease{
+		Token:        token,
+		FencingToken: fencing,
+		Owner:        owner,
+		TTL:          timeout,
+	}, nil
+}
+
+// Unlock implements server.Locker.
+func (s *Store) Unlock(ctx context.Context, loc *eventstore.LocationType, token string) error {
+	key := s.lockKey(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		if err := checkLock(ctx, c, loc, key, token); err != nil {
+			return nil, err
+		}
+
+		return [][]interface{}{cmd("DEL", key), s.unindexCmd(loc, key)}, nil
+	})
+
+	return err
+}
+
+// RefreshLock implements server.Locker.
+func (s *Store) RefreshLock(ctx context.Context, loc *eventstore.LocationType, token string, timeout time.Duration) error {
+	key := s.lockKey(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		if err := checkLock(ctx, c, loc, key, token); err != nil {
+			return nil, err
+		}
+
+		if exp := expireCmd(key, timeout); exp != nil {
+			return [][]interface{}{exp}, nil
+		}
+		return [][]interface{}{cmd("PERSIST", key)}, nil
+	})
+
+	return err
+}
+
+// LockInfo implements server.Locker.
+func (s *Store) LockInfo(ctx context.Context, loc *eventstore.LocationType) (server.Lease, error) {
+	key, rkey := s.lockKey(loc), s.readersKey(loc)
+
+	res, err := s.multi(ctx, cmd("HGETALL", key), cmd("PTTL", key), cmd("HGETALL", rkey), cmd("TIME"))
+	if err != nil {
+		return server.Lease{}, err
+	}
+
+	kvs, _ := res[0].([]interface{})
+	if len(kvs) == 0 {
+		now, err := parseTime(res[3])
+		if err != nil {
+			return server.Lease{}, err
+		}
+		readers, err := parseHolders(rkey, res[2], now)
+		if err != nil {
+			return server.Lease{}, err
+		}
+
+		if len(readers.live) != 0 {
+			return server.Lease{Readers: len(readers.live)}, nil
+		}
+		return server.Lease{}, errLockNotFound(loc)
+	}
+
+	var lease server.Lease
+	for i := 0; i+1 < len(kvs); i += 2 {
+		k, _ := kvs[i].([]byte)
+		v, _ := kvs[i+1].([]byte)
+
+		switch string(k) {
+		case lockToken:
+			lease.Token = string(v)
+		case lockOwner:
+			lease.Owner = string(v)
+		case lockFencing:
+			lease.FencingToken = parseVersion(v)
+		}
+	}
+
+	if ms, _ := res[1].(int64); ms > 0 {
+		lease.TTL = time.Duration(ms) * time.Millisecond
+	}
+
+	return lease, nil
+}
+
+// RLock implements server.Locker. Readers are stored as holders of
+// a single permit at a companion hash.
+func (s *Store) RLock(ctx context.Context, loc *eventstore.LocationType, timeout time.Duration) (string, error) {
+	key, rkey := s.lockKey(loc), s.readersKey(loc)
+
+	token, err := newToken()
+	if err != nil {
+		return "", fmt.Errorf("generating unlock token: %w", err)
+	}
+
+	_, err = s.transactionAll(ctx, []string{key, rkey}, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrLocked)
+		}
+
+		readers, err := readHolders(ctx, c, rkey)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(readers.add(token, 1, timeout), s.indexCmds(loc, rkey)...), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// RUnlock implements server.Locker.
+func (s *Store) RUnlock(ctx context.Context, loc *eventstore.LocationType, token string) error {
+	return s.releaseHolder(ctx, s.readersKey(loc), loc, token)
+}
+
+// checkLock fails if the lock at the key is not held using the token.
+func checkLock(ctx context.Context, c *conn, loc *eventstore.LocationType, key, token string) error {
+	r, err := c.do(ctx, "HGET", key, lockToken)
+	if err != nil {
+		return err
+	}
+
+	current, _ := r.([]byte)
+	switch {
+	case current == nil:
+		return errLockNotFound(loc)
+	case string(current) != token:
+		return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrUnlockMismatch)
+	}
+
+	return nil
+}
+
+func errLockNotFound(loc *eventstore.LocationType) error {
+	return fmt.Errorf("lock for key %q: %w", loc.GetKey(), server.ErrNotFound)
+}
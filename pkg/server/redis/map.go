@@ -0,0 +1,397 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// HNew implements server.Hash.
+func (s *Store) HNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, errAlreadyExists(loc)
+		}
+
+		// Field expirations left by a previous map at the key are removed.
+		cmds := [][]interface{}{cmd("HSET", key, mapSentinel, ""), cmd("DEL", s.fieldsKey(loc))}
+		if exp := expireCmd(key, ttl); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, s.indexCmds(loc, key)...), nil
+	})
+
+	return err
+}
+
+// HSet implements server.Hash.
+func (s *Store) HSet(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration) error {
+	return s.hset(ctx, loc, field, value, ttl, false)
+}
+
+// HSetNX implements server.Hash.
+func (s *Store) HSetNX(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration) error {
+	return s.hset(ctx, loc, field, value, ttl, true)
+}
+
+// hset stores the value at a map field, failing when the
+// field exists if nx is set.
+func (s *Store) hset(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration, nx bool) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeMap); err != nil {
+			return nil, err
+		}
+
+		m, err := s.readFields(ctx, c, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		if nx {
+			exists, err := m.exists(ctx, c, field)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, fmt.Errorf("field %q at key %q exists: %w", field, loc.GetKey(), server.ErrConflict)
+			}
+		}
+
+		cmds := m.purge()
+		cmds = append(cmds, cmd("HSET", key, field, value))
+		return append(cmds, m.expireField(field, ttl)...), nil
+	})
+
+	return err
+}
+
+// HGet implements server.Hash.
+func (s *Store) HGet(ctx context.Context, loc *eventstore.LocationType, field string) ([]byte, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("HGET", key, field), cmd("ZSCORE", s.fieldsKey(loc), field), cmd("TIME"))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeMap); err != nil {
+		return nil, err
+	}
+
+	v, _ := res[1].([]byte)
+	if v == nil {
+		return nil, errFieldNotFound(loc, field)
+	}
+
+	if b, _ := res[2].([]byte); b != nil {
+		now, err := parseTime(res[3])
+		if err != nil {
+			return nil, err
+		}
+		expireAt, err := strconv.ParseFloat(string(b), 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected score %q", b)
+		}
+		if int64(expireAt) <= now {
+			return nil, errFieldNotFound(loc, field)
+		}
+	}
+
+	return v, nil
+}
+
+// HDel implements server.Hash.
+func (s *Store) HDel(ctx context.Context, loc *eventstore.LocationType, field string) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeMap); err != nil {
+			return nil, err
+		}
+
+		m, err := s.readFields(ctx, c, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		exists, err := m.exists(ctx, c, field)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errFieldNotFound(loc, field)
+		}
+
+		return append(m.purge(), cmd("HDEL", key, field), cmd("ZREM", m.fkey, field)), nil
+	})
+
+	return err
+}
+
+// HIncrBy implements server.Hash.
+func (s *Store) HIncrBy(ctx context.Context, loc *eventstore.LocationType, field string, delta int64) (int64, error) {
+	res, err := s.hincr(ctx, loc, "HINCRBY", field, delta)
+	if err != nil {
+		return 0, err
+	}
+
+	n, _ := res.(int64)
+	return n, nil
+}
+
+// HIncrByFloat implements server.Hash.
+func (s *Store) HIncrByFloat(ctx context.Context, loc *eventstore.LocationType, field string, delta float64) (float64, error) {
+	res, err := s.hincr(ctx, loc, "HINCRBYFLOAT", field, formatFloat(delta))
+	if err != nil {
+		return 0, err
+	}
+
+	return parseFloat(res)
+}
+
+// hincr runs the increment command for a map field, which starts
+// from zero when the field expired, and returns its reply.
+func (s *Store) hincr(ctx context.Context, loc *eventstore.LocationType, name, field string, delta interface{}) (interface{}, error) {
+	key := s.key(loc)
+
+	res, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeMap); err != nil {
+			return nil, err
+		}
+
+		m, err := s.readFields(ctx, c, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(m.purge(), cmd(name, key, field, delta)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := res[len(res)-1]
+	if err := replyErr(loc, reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// HGetAll implements server.Hash.
+func (s *Store) HGetAll(ctx context.Context, loc *eventstore.LocationType) (map[string][]byte, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("HGETALL", key), s.expirationsCmd(loc), cmd("TIME"))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeMap); err != nil {
+		return nil, err
+	}
+
+	expired, err := expiredFields(res[2], res[3])
+	if err != nil {
+		return nil, err
+	}
+
+	kvs, _ := res[1].([]interface{})
+	fields := make(map[string][]byte, len(kvs)/2)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		k, _ := kvs[i].([]byte)
+		if string(k) == mapSentinel || expired[string(k)] {
+			continue
+		}
+		v, _ := kvs[i+1].([]byte)
+		fields[string(k)] = v
+	}
+
+	return fields, nil
+}
+
+// HLen implements server.Hash.
+func (s *Store) HLen(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("HLEN", key), s.expirationsCmd(loc), cmd("TIME"))
+	if err != nil {
+		return 0, err
+	}
+	if err := checkType(loc, res[0], typeMap); err != nil {
+		return 0, err
+	}
+
+	expired, err := expiredFields(res[2], res[3])
+	if err != nil {
+		return 0, err
+	}
+
+	n, _ := res[1].(int64)
+	return int(n) - 1 - len(expired), nil
+}
+
+// expirationsCmd returns the command that reads the expiration
+// of the fields of the map at the location.
+func (s *Store) expirationsCmd(loc *eventstore.LocationType) []interface{} {
+	return cmd("ZRANGE", s.fieldsKey(loc), 0, -1, "WITHSCORES")
+}
+
+// expiredFields returns the fields at the reply of expirationsCmd
+// that expired at the time replied by the TIME command.
+func expiredFields(reply, timeReply interface{}) (map[string]bool, error) {
+	now, err := parseTime(timeReply)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := parseScored(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := make(map[string]bool)
+	for _, f := range fields {
+		if int64(f.Score) <= now {
+			expired[f.Member] = true
+		}
+	}
+
+	return expired, nil
+}
+
+// mapFields is the state of the fields of a map read within a
+// transaction that watches the map.
+type mapFields struct {
+	key  string
+	fkey string
+	// now is the server time in milliseconds.
+	now int64
+	// pttl is the remaining TTL of the map in
+	// milliseconds, not positive when it never expires.
+	pttl    int64
+	expired map[string]bool
+}
+
+// readFields reads the expiration of the fields of the map at the
+// location. Must be called while preparing a transaction.
+func (s *Store) readFields(ctx context.Context, c *conn, loc *eventstore.LocationType) (*mapFields, error) {
+	m := &mapFields{
+		key:     s.key(loc),
+		fkey:    s.fieldsKey(loc),
+		expired: make(map[string]bool),
+	}
+
+	var err error
+	if m.now, err = serverTime(ctx, c); err != nil {
+		return nil, err
+	}
+
+	r, err := c.do(ctx, "PTTL", m.key)
+	if err != nil {
+		return nil, err
+	}
+	m.pttl, _ = r.(int64)
+
+	if r, err = c.do(ctx, "ZRANGEBYSCORE", m.fkey, "-inf", m.now); err != nil {
+		return nil, err
+	}
+	rs, _ := r.([]interface{})
+	for _, f := range rs {
+		b, _ := f.([]byte)
+		m.expired[string(b)] = true
+	}
+
+	return m, nil
+}
+
+// exists returns whether the field exists and has not expired.
+func (m *mapFields) exists(ctx context.Context, c *conn, field string) (bool, error) {
+	if m.expired[field] {
+		return false, nil
+	}
+
+	r, err := c.do(ctx, "HEXISTS", m.key, field)
+	if err != nil {
+		return false, err
+	}
+
+	n, _ := r.(int64)
+	return n == 1, nil
+}
+
+// purge returns the commands that remove the expired fields.
+func (m *mapFields) purge() [][]interface{} {
+	if len(m.expired) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(m.expired))
+	for f := range m.expired {
+		fields = append(fields, f)
+	}
+	m.expired = make(map[string]bool)
+
+	return [][]interface{}{memberCmd("HDEL", m.key, fields), memberCmd("ZREM", m.fkey, fields)}
+}
+
+// expireField returns the commands that set the TTL of a field, which
+// lives as long as the map when the TTL is not positive. Expirations
+// are removed along with the map.
+func (m *mapFields) expireField(field string, ttl time.Duration) [][]interface{} {
+	if ttl <= 0 {
+		return [][]interface{}{cmd("ZREM", m.fkey, field)}
+	}
+
+	cmds := [][]interface{}{cmd("ZADD", m.fkey, m.now+milliseconds(ttl), field)}
+	if m.pttl > 0 {
+		cmds = append(cmds, cmd("PEXPIRE", m.fkey, m.pttl))
+	}
+	return cmds
+}
+
+func errAlreadyExists(loc *eventstore.LocationType) error {
+	return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrAlreadyExists)
+}
+
+func errFieldNotFound(loc *eventstore.LocationType, field string) error {
+	return fmt.Errorf("field %q at key %q: %w", field, loc.GetKey(), server.ErrNotFound)
+}
@@ -0,0 +1,359 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Queue items are stored after the sentinel at the head of the list,
+// which is why list indexes are shifted by one. The sentinel of queues
+// whose length is limited also holds the limit.
+
+// LNew implements server.List.
+func (s *Store) LNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration, limit server.QueueLimit) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, errAlreadyExists(loc)
+		}
+
+		// Reserved and scheduled items left by a previous
+		// queue at the key are removed.
+		cmds := [][]interface{}{cmd("RPUSH", key, sentinelFor(limit)), cmd("DEL", s.reservedKey(loc), s.scheduledKey(loc))}
+		if exp := expireCmd(key, ttl); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, s.indexCmds(loc, key)...), nil
+	})
+
+	return err
+}
+
+// RPush implements server.List. Unbounded queues without
+// scheduled items are appended to using a single command.
+func (s *Store) RPush(ctx context.Context, loc *eventstore.LocationType, value []byte, opts server.PushOptions) error {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("LINDEX", key, 0), cmd("EXISTS", s.scheduledKey(loc)))
+	if err != nil {
+		return err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return err
+	}
+	sentinel, _ := res[1].([]byte)
+	if n, _ := res[2].(int64); n != 0 || parseSentinel(sentinel).MaxLen > 0 || opts != (server.PushOptions{}) {
+		return s.push(ctx, loc, value, opts, false)
+	}
+
+	r, err := s.do(ctx, "RPUSHX", key, value)
+	if err != nil {
+		return translate(loc, err)
+	}
+
+	if n, _ := r.(int64); n == 0 {
+		return errNotFound(loc)
+	}
+
+	return nil
+}
+
+// LPush implements server.List.
+func (s *Store) LPush(ctx context.Context, loc *eventstore.LocationType, value []byte) error {
+	return s.push(ctx, loc, value, server.PushOptions{}, true)
+}
+
+// push adds the value at the tail of the queue, or at
+// the head when front is true, enforcing its limit.
+func (s *Store) push(ctx context.Context, loc *eventstore.LocationType, value []byte, opts server.PushOptions, front bool) error {
+	_, err := s.queueTransaction(ctx, loc, func(c *conn, q *queue) ([][]interface{}, error) {
+		return s.pushCmds(ctx, c, q, value, opts, front)
+	})
+
+	return err
+}
+
+// LIndex implements server.List.
+func (s *Store) LIndex(ctx context.Context, loc *eventstore.LocationType, index int) ([]byte, error) {
+	key := s.key(loc)
+
+	if index < 0 {
+		return nil, errOutOfRange(loc, index)
+	}
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("EXISTS", s.scheduledKey(loc)), cmd("LINDEX", key, index+1))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return nil, err
+	}
+
+	v, _ := res[2].([]byte)
+	if n, _ := res[1].(int64); n != 0 {
+		_, err = s.queueTransaction(ctx, loc, func(c *conn, q *queue) ([][]interface{}, error) {
+			if index >= q.len() {
+				return nil, errOutOfRange(loc, index)
+			}
+			v, err = q.at(ctx, c, index)
+			return nil, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v == nil {
+		return nil, errOutOfRange(loc, index)
+	}
+
+	return v, nil
+}
+
+// LPop implements server.List.
+func (s *Store) LPop(ctx context.Context, loc *eventstore.LocationType) ([]byte, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("EXISTS", s.scheduledKey(loc)))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return nil, err
+	}
+	if n, _ := res[1].(int64); n != 0 {
+		return s.pop(ctx, loc, false)
+	}
+
+	// The head item is replaced with a marker that is then removed,
+	// which leaves the queue untouched when there is no head item
+	// and avoids emptying the list, which would remove its TTL.
+	res, err = s.multi(ctx,
+		cmd("TYPE", key),
+		cmd("LINDEX", key, 1),
+		cmd("LSET", key, 1, queuePopped),
+		cmd("LREM", key, 1, queuePopped),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return nil, err
+	}
+
+	v, _ := res[1].([]byte)
+	if v == nil {
+		return nil, errEmptyQueue(loc)
+	}
+
+	return v, nil
+}
+
+// RPop implements server.List.
+func (s *Store) RPop(ctx context.Context, loc *eventstore.LocationType) ([]byte, error) {
+	return s.pop(ctx, loc, true)
+}
+
+// pop removes and returns the first visible item of the
+// queue, or the last one when back is true.
+func (s *Store) pop(ctx context.Context, loc *eventstore.LocationType, back bool) ([]byte, error) {
+	var (
+		v         []byte
+		scheduled bool
+	)
+	res, err := s.queueTransaction(ctx, loc, func(c *conn, q *queue) ([][]interface{}, error) {
+		if q.len() == 0 {
+			return nil, errEmptyQueue(loc)
+		}
+
+		var cmds [][]interface{}
+		if back {
+			cmds, v, scheduled = q.popBack()
+		} else {
+			cmds, v, scheduled = q.popFront()
+		}
+		return cmds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !scheduled {
+		v, _ = res[0].([]byte)
+	}
+	return v, nil
+}
+
+// LRange implements server.List.
+func (s *Store) LRange(ctx context.Context, loc *eventstore.LocationType, start, stop int) ([][]byte, error) {
+	key := s.key(loc)
+
+	// Negative indexes point to the same items in list space.
+	from, to := start, stop
+	if from >= 0 {
+		from++
+	}
+	if to >= 0 {
+		to++
+	}
+
+	res, err := s.multi(ctx,
+		cmd("TYPE", key),
+		cmd("EXISTS", s.scheduledKey(loc)),
+		cmd("LLEN", key),
+		cmd("LRANGE", key, from, to),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return nil, err
+	}
+
+	if n, _ := res[1].(int64); n != 0 {
+		var items [][]byte
+		_, err = s.queueTransaction(ctx, loc, func(c *conn, q *queue) ([][]interface{}, error) {
+			start, stop := span(q.len(), start, stop)
+			items = [][]byte{}
+			if start <= stop {
+				items, err = q.items(ctx, c, start, stop)
+			}
+			return nil, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return items, nil
+	}
+
+	values, _ := res[3].([]interface{})
+
+	// Negative start indexes beyond the first item
+	// are clamped to the sentinel, which is skipped.
+	if n, _ := res[2].(int64); start < 0 && int64(start)+n <= 0 && len(values) > 0 {
+		values = values[1:]
+	}
+
+	items := make([][]byte, 0, len(values))
+	for _, v := range values {
+		b, _ := v.([]byte)
+		items = append(items, b)
+	}
+
+	return items, nil
+}
+
+// LTrim implements server.List.
+func (s *Store) LTrim(ctx context.Context, loc *eventstore.LocationType, start, stop int) error {
+	_, err := s.queueTransaction(ctx, loc, func(c *conn, q *queue) ([][]interface{}, error) {
+		start, stop := span(q.len(), start, stop)
+		return q.trim(start, stop), nil
+	})
+
+	return err
+}
+
+// LLen implements server.List.
+func (s *Store) LLen(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("EXISTS", s.scheduledKey(loc)), cmd("LLEN", key))
+	if err != nil {
+		return 0, err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return 0, err
+	}
+
+	n, _ := res[2].(int64)
+	if scheduled, _ := res[1].(int64); scheduled == 0 {
+		return int(n) - 1, nil
+	}
+
+	var l int
+	_, err = s.queueTransaction(ctx, loc, func(c *conn, q *queue) ([][]interface{}, error) {
+		l = q.len()
+		return nil, nil
+	})
+	return l, err
+}
+
+// queueTransaction runs a transaction that watches the queue at the
+// location and its scheduled items, calling prepare with its state.
+func (s *Store) queueTransaction(ctx context.Context, loc *eventstore.LocationType,
+	prepare func(c *conn, q *queue) ([][]interface{}, error)) ([]interface{}, error) {
+	return s.transactionAll(ctx, []string{s.key(loc), s.scheduledKey(loc)}, func(c *conn) ([][]interface{}, error) {
+		q, err := s.loadQueue(ctx, c, loc)
+		if err != nil {
+			return nil, err
+		}
+		return prepare(c, q)
+	})
+}
+
+// sentinelFor returns the sentinel for a queue with the limit.
+func sentinelFor(limit server.QueueLimit) string {
+	if limit.MaxLen == 0 {
+		return queueSentinel
+	}
+	return fmt.Sprintf("%s:%d:%d", queueSentinel, limit.MaxLen, limit.Overflow)
+}
+
+// parseSentinel returns the limit held by a queue sentinel.
+func parseSentinel(sentinel []byte) server.QueueLimit {
+	var limit server.QueueLimit
+	_, _ = fmt.Sscanf(string(sentinel), queueSentinel+":%d:%d", &limit.MaxLen, &limit.Overflow)
+	return limit
+}
+
+// span resolves negative start and stop indexes for a queue of
+// length n and clamps them to its items. Start is greater than stop
+// when there are no items in between.
+func span(n, start, stop int) (int, int) {
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	return start, stop
+}
+
+func errOutOfRange(loc *eventstore.LocationType, index int) error {
+	return fmt.Errorf("index %d for queue %q: %w", index, loc.GetKey(), server.ErrOutOfRange)
+}
+
+func errEmptyQueue(loc *eventstore.LocationType) error {
+	return fmt.Errorf("queue %q is empty: %w", loc.GetKey(), server.ErrNotFound)
+}
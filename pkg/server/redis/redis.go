@@ -0,0 +1,542 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redis implements an EventStore storage driver for
+// servers that speak the Redis protocol (RESP).
+//
+// Scopes are translated into key namespaces, maps are stored as hashes,
+// queues as lists, and sets, sorted sets and streams as their Redis
+// counterparts.
+// Redis removes empty collections, which is why all of them hold a
+// sentinel that keeps them, and their TTL, in place until they are
+// explicitly deleted.
+//
+// KV entries are strings, their versions are stored at a companion
+// key with the same TTL, and are taken from a counter shared by all
+// scopes so that they are never reused. The expiration of map fields,
+// reserved and scheduled queue items, and the offsets committed by
+// stream consumer groups are also stored at companion keys, which are
+// removed along with their key.
+//
+// Locks, shared lock readers and semaphores live at namespaces of their
+// own within each scope.
+//
+// Keys written at bridges and instances are recorded at an index set
+// of their scope, and bridges record the instances that hold keys,
+// which lets scopes be dropped without listing the whole keyspace. Keys that expire are kept at the
+// index until they are deleted or their scope is dropped.
+package redis
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	mrand "math/rand"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+const (
+	defaultPrefix  = "eventstore"
+	defaultMaxIdle = 16
+
+	// mapSentinel is a field that cannot be set through the Map
+	// service, since field names must be informed.
+	mapSentinel = ""
+	// setSentinel is a member that cannot be added through the
+	// Set service, since members cannot be empty.
+	setSentinel = ""
+	// zsetSentinel is a sorted set member that cannot be added through
+	// the SortedSet service. It is scored negative infinity, which
+	// keeps it at rank zero.
+	zsetSentinel = ""
+	// queueSentinel is always kept at the head of queue lists.
+	queueSentinel = "eventstore:queue"
+	// queuePopped marks the queue item that is being popped.
+	queuePopped = "eventstore:popped"
+
+	// Fields of the hashes that store locks.
+	lockToken   = "token"
+	lockOwner   = "owner"
+	lockFencing = "fencing"
+
+	// maxTxAttempts bounds the retries for transactions whose
+	// watched keys are modified concurrently.
+	maxTxAttempts = 16
+	// txBackoff is the base wait between transaction retries.
+	txBackoff = time.Millisecond
+)
+
+// Redis types for each kind of value.
+const (
+	typeNone   = "none"
+	typeKV     = "string"
+	typeMap    = "hash"
+	typeQueue  = "list"
+	typeSet    = "set"
+	typeZSet   = "zset"
+	typeStream = "stream"
+)
+
+var errTxConflict = errors.New("transaction aborted after too many concurrent modifications")
+
+// Store is a storage driver backed by a Redis compatible server.
+type Store struct {
+	addr     string
+	password string
+	db       int
+	prefix   string
+
+	pool *pool
+}
+
+var _ server.Storage = (*Store)(nil)
+
+// Option customizes the Redis storage driver.
+type Option func(*Store)
+
+// WithPassword authenticates connections with the password.
+func WithPassword(password string) Option {
+	return func(s *Store) {
+		s.password = password
+	}
+}
+
+// WithDB selects the database number for connections.
+func WithDB(db int) Option {
+	return func(s *Store) {
+		s.db = db
+	}
+}
+
+// WithPrefix sets the prefix for all keys written by the driver.
+func WithPrefix(prefix string) Option {
+	return func(s *Store) {
+		s.prefix = prefix
+	}
+}
+
+// WithMaxIdle sets the maximum number of idle connections kept open.
+func WithMaxIdle(n int) Option {
+	return func(s *Store) {
+		s.pool.maxIdle = n
+	}
+}
+
+// New creates a storage driver for the server at addr. Connections
+// are established on demand.
+func New(addr string, opts ...Option) *Store {
+	s := &Store{
+		addr:   addr,
+		prefix: defaultPrefix,
+	}
+	s.pool = &pool{
+		dial:    s.dial,
+		maxIdle: defaultMaxIdle,
+	}
+
+	for _, f := range opts {
+		f(s)
+	}
+
+	return s
+}
+
+// Close the idle connections to the server.
+func (s *Store) Close() error {
+	return s.pool.close()
+}
+
+// Ping checks connectivity with the server.
+func (s *Store) Ping(ctx context.Context) error {
+	_, err := s.do(ctx, "PING")
+	return err
+}
+
+func (s *Store) dial(ctx context.Context) (*conn, error) {
+	var d net.Dialer
+	nc, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &conn{
+		c:  nc,
+		br: bufio.NewReader(nc),
+		bw: bufio.NewWriter(nc),
+	}
+
+	if s.password != "" {
+		if _, err := c.do(ctx, "AUTH", s.password); err != nil {
+			_ = nc.Close()
+			return nil, fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	if s.db != 0 {
+		if _, err := c.do(ctx, "SELECT", s.db); err != nil {
+			_ = nc.Close()
+			return nil, fmt.Errorf("selecting database: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// do runs a single command.
+func (s *Store) do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	c, err := s.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer s.pool.put(c)
+
+	return c.do(ctx, args...)
+}
+
+// multi runs the commands atomically and returns their replies,
+// which might contain error replies.
+func (s *Store) multi(ctx context.Context, cmds ...[]interface{}) ([]interface{}, error) {
+	c, err := s.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer s.pool.put(c)
+
+	return exec(ctx, c, cmds)
+}
+
+// transaction watches the key and calls prepare, which can read it and
+// returns the commands to run atomically. The transaction is retried
+// when the key is modified before the commands run.
+func (s *Store) transaction(ctx context.Context, key string,
+	prepare func(c *conn) ([][]interface{}, error)) ([]interface{}, error) {
+	return s.transactionAll(ctx, []string{key}, prepare)
+}
+
+// transactionAll works like transaction watching all the keys.
+func (s *Store) transactionAll(ctx context.Context, keys []string,
+	prepare func(c *conn) ([][]interface{}, error)) ([]interface{}, error) {
+	c, err := s.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer s.pool.put(c)
+
+	watch := make([]interface{}, 0, len(keys)+1)
+	watch = append(watch, "WATCH")
+	for _, k := range keys {
+		watch = append(watch, k)
+	}
+
+	for i := 0; i < maxTxAttempts; i++ {
+		if _, err := c.do(ctx, watch...); err != nil {
+			return nil, err
+		}
+
+		cmds, err := prepare(c)
+		if err != nil {
+			if _, uerr := c.do(ctx, "UNWATCH"); uerr != nil {
+				c.broken = true
+			}
+			return nil, err
+		}
+
+		res, err := exec(ctx, c, cmds)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return res, nil
+		}
+
+		if err := backoff(ctx, i); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, errTxConflict
+}
+
+// backoff waits a random time that grows with the attempt number,
+// which spreads the retries of conflicting transactions.
+func backoff(ctx context.Context, attempt int) error {
+	max := int64(txBackoff) << uint(attempt)
+	if max > int64(100*time.Millisecond) {
+		max = int64(100 * time.Millisecond)
+	}
+
+	t := time.NewTimer(time.Duration(mrand.Int63n(max) + 1))
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// exec queues the commands in a MULTI block and executes them. A nil
+// result means that the transaction was aborted because a watched
+// key was modified.
+func exec(ctx context.Context, c *conn, cmds [][]interface{}) ([]interface{}, error) {
+	if _, err := c.do(ctx, "MULTI"); err != nil {
+		return nil, err
+	}
+
+	for _, cmd := range cmds {
+		if _, err := c.do(ctx, cmd...); err != nil {
+			if _, derr := c.do(ctx, "DISCARD"); derr != nil {
+				c.broken = true
+			}
+			return nil, err
+		}
+	}
+
+	r, err := c.do(ctx, "EXEC")
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := r.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected EXEC reply %T", r)
+	}
+
+	return res, nil
+}
+
+func cmd(args ...interface{}) []interface{} {
+	return args
+}
+
+// key returns the namespaced Redis key for the location.
+func (s *Store) key(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "k:" + loc.GetKey()
+}
+
+// versionKey returns the namespaced Redis key for the version
+// of the KV entry at the location.
+func (s *Store) versionKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "v:" + loc.GetKey()
+}
+
+// nextVersion returns a new version for KV entries.
+func (s *Store) nextVersion(ctx context.Context) (uint64, error) {
+	r, err := s.do(ctx, "INCR", s.prefix+":version")
+	if err != nil {
+		return 0, err
+	}
+
+	n, _ := r.(int64)
+	return uint64(n), nil
+}
+
+// reservedKey returns the namespaced Redis key for the
+// reserved items of the queue at the location.
+func (s *Store) reservedKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "r:" + loc.GetKey()
+}
+
+// scheduledKey returns the namespaced Redis key for the
+// scheduled items of the queue at the location.
+func (s *Store) scheduledKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "s:" + loc.GetKey()
+}
+
+// offsetsKey returns the namespaced Redis key for the offsets
+// committed by consumer groups of the stream at the location.
+func (s *Store) offsetsKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "o:" + loc.GetKey()
+}
+
+// fieldsKey returns the namespaced Redis key for the expiration
+// of the fields of the map at the location.
+func (s *Store) fieldsKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "f:" + loc.GetKey()
+}
+
+// lockKey returns the namespaced Redis key for the location lock.
+func (s *Store) lockKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "l:" + loc.GetKey()
+}
+
+// readersKey returns the namespaced Redis key for the holders
+// of shared locks at the location.
+func (s *Store) readersKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "rl:" + loc.GetKey()
+}
+
+// semaphoreKey returns the namespaced Redis key for the holders
+// of permits of the semaphore at the location.
+func (s *Store) semaphoreKey(loc *eventstore.LocationType) string {
+	return s.scopePrefix(loc.GetScope()) + "sm:" + loc.GetKey()
+}
+
+// indexKey returns the namespaced Redis key for the set of
+// keys written at the scope, relative to its namespace.
+func (s *Store) indexKey(sc *eventstore.ScopeType) string {
+	return s.scopePrefix(sc) + "keys"
+}
+
+// instancesKey returns the namespaced Redis key for the set
+// of instances of the scope bridge that hold keys.
+func (s *Store) instancesKey(sc *eventstore.ScopeType) string {
+	return s.prefix + ":b:" + url.QueryEscape(sc.GetBridge()) + ":instances"
+}
+
+// indexCmds returns the commands that record the key, written at the
+// location, at the index of its scope. Global keys are not indexed,
+// since the global scope cannot be dropped.
+func (s *Store) indexCmds(loc *eventstore.LocationType, key string) [][]interface{} {
+	sc := loc.GetScope()
+	switch sc.GetType() {
+	case eventstore.ScopeChoice_Global:
+		return nil
+	case eventstore.ScopeChoice_Bridge:
+		return [][]interface{}{cmd("SADD", s.indexKey(sc), strings.TrimPrefix(key, s.scopePrefix(sc)))}
+	default:
+		return [][]interface{}{
+			cmd("SADD", s.indexKey(sc), strings.TrimPrefix(key, s.scopePrefix(sc))),
+			cmd("SADD", s.instancesKey(sc), sc.GetInstance()),
+		}
+	}
+}
+
+// unindexCmd returns the command that removes the
+// key from the index of the location scope.
+func (s *Store) unindexCmd(loc *eventstore.LocationType, key string) []interface{} {
+	sc := loc.GetScope()
+	return cmd("SREM", s.indexKey(sc), strings.TrimPrefix(key, s.scopePrefix(sc)))
+}
+
+// scopePrefix returns the namespace for keys at the scope. Bridge and
+// instance names are escaped so that they cannot contain separators.
+func (s *Store) scopePrefix(sc *eventstore.ScopeType) string {
+	switch sc.GetType() {
+	case eventstore.ScopeChoice_Global:
+		return s.prefix + ":g:"
+	case eventstore.ScopeChoice_Bridge:
+		return s.prefix + ":b:" + url.QueryEscape(sc.GetBridge()) + ":"
+	default:
+		return s.prefix + ":b:" + url.QueryEscape(sc.GetBridge()) + ":i:" + url.QueryEscape(sc.GetInstance()) + ":"
+	}
+}
+
+// checkType fails if the TYPE reply does not match the expected type.
+func checkType(loc *eventstore.LocationType, reply interface{}, expected string) error {
+	t, ok := reply.(string)
+	if !ok {
+		return fmt.Errorf("unexpected TYPE reply %v", reply)
+	}
+
+	switch t {
+	case expected:
+		return nil
+	case typeNone:
+		return errNotFound(loc)
+	}
+
+	return fmt.Errorf("key %q holds a %s, not a %s: %w", loc.GetKey(), kindOf(t), kindOf(expected), server.ErrWrongKind)
+}
+
+func kindOf(redisType string) string {
+	switch redisType {
+	case typeKV:
+		return "kv"
+	case typeMap:
+		return "map"
+	case typeQueue:
+		return "queue"
+	case typeSet:
+		return "set"
+	case typeZSet:
+		return "sorted set"
+	case typeStream:
+		return "stream"
+	}
+	return redisType
+}
+
+// replyErr returns the error for a transaction reply, if any.
+func replyErr(loc *eventstore.LocationType, reply interface{}) error {
+	if err, ok := reply.(redisError); ok {
+		return translate(loc, err)
+	}
+	return nil
+}
+
+// translate Redis error replies into storage errors.
+func translate(loc *eventstore.LocationType, err error) error {
+	var rerr redisError
+	if !errors.As(err, &rerr) {
+		return err
+	}
+
+	msg := string(rerr)
+	switch {
+	case strings.HasPrefix(msg, "WRONGTYPE"):
+		return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrWrongKind)
+	case strings.Contains(msg, "not an integer"):
+		return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrNotInteger)
+	case strings.Contains(msg, "not a valid float"), strings.Contains(msg, "not a float"):
+		return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrNotFloat)
+	case strings.Contains(msg, "NaN or Infinity"):
+		return fmt.Errorf("key %q: increment would produce NaN or infinity: %w", loc.GetKey(), server.ErrOutOfRange)
+	case strings.Contains(msg, "overflow"):
+		return fmt.Errorf("key %q: increment would overflow: %w", loc.GetKey(), server.ErrOutOfRange)
+	}
+
+	return err
+}
+
+func errNotFound(loc *eventstore.LocationType) error {
+	return fmt.Errorf("key %q: %w", loc.GetKey(), server.ErrNotFound)
+}
+
+// expireCmd returns the command that sets the TTL for the key, or nil
+// when the key should not expire.
+func expireCmd(key string, ttl time.Duration) []interface{} {
+	if ttl <= 0 {
+		return nil
+	}
+	return cmd("PEXPIRE", key, milliseconds(ttl))
+}
+
+func milliseconds(d time.Duration) int64 {
+	ms := int64(d / time.Millisecond)
+	if ms == 0 && d > 0 {
+		ms = 1
+	}
+	return ms
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,1247 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+	"github.com/triggermesh/eventstore/pkg/server/redis/fake"
+)
+
+const (
+	tBridge   = "test-bridge"
+	tInstance = "test-instance"
+	tKey      = "test-key"
+)
+
+var tValue = []byte("test-value")
+
+func newTestStore(t *testing.T, opts ...Option) (*Store, *fake.Server) {
+	srv, err := fake.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = srv.Close() })
+
+	s := New(srv.Addr(), opts...)
+	t.Cleanup(func() { _ = s.Close() })
+
+	return s, srv
+}
+
+func globalLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Global},
+		Key:   key,
+	}
+}
+
+func bridgeLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Bridge, Bridge: tBridge},
+		Key:   key,
+	}
+}
+
+func instanceLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Instance, Bridge: tBridge, Instance: tInstance},
+		Key:   key,
+	}
+}
+
+func TestKV(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	require.NoError(t, s.Ping(ctx))
+
+	_, _, err := s.Get(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+
+	require.NoError(t, err)
+	v, _, err := s.Get(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+
+	require.NoError(t, s.Del(ctx, loc))
+	_, _, err = s.Get(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	assert.ErrorIs(t, s.Del(ctx, loc), server.ErrNotFound)
+}
+
+func TestNamespaces(t *testing.T) {
+	s, srv := newTestStore(t, WithPrefix("test"), WithDB(2), WithPassword("secret"))
+	ctx := context.Background()
+
+	locations := []*eventstore.LocationType{
+		globalLocation(tKey),
+		bridgeLocation(tKey),
+		instanceLocation(tKey),
+		{
+			Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Bridge, Bridge: tBridge + ":i:" + tInstance},
+			Key:   tKey,
+		},
+	}
+
+	for i, loc := range locations {
+		_, err := s.Set(ctx, loc, []byte{byte(i)}, 0)
+		require.NoError(t, err)
+	}
+
+	for i, loc := range locations {
+		v, _, err := s.Get(ctx, loc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, v, "unexpected value at %s scope", loc.Scope.Type)
+	}
+
+	keys := srv.Keys(2)
+	sort.Strings(keys)
+	assert.Equal(t, []string{
+		"test:b:test-bridge%3Ai%3Atest-instance:k:test-key",
+		"test:b:test-bridge%3Ai%3Atest-instance:keys",
+		"test:b:test-bridge%3Ai%3Atest-instance:v:test-key",
+		"test:b:test-bridge:i:test-instance:k:test-key",
+		"test:b:test-bridge:i:test-instance:keys",
+		"test:b:test-bridge:i:test-instance:v:test-key",
+		"test:b:test-bridge:instances",
+		"test:b:test-bridge:k:test-key",
+		"test:b:test-bridge:keys",
+		"test:b:test-bridge:v:test-key",
+		"test:g:k:test-key",
+		"test:g:v:test-key",
+		"test:version",
+	}, keys)
+	assert.Empty(t, srv.Keys(0))
+}
+
+func TestExpiry(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+
+	kv, m, q := bridgeLocation("kv"), bridgeLocation("map"), bridgeLocation("queue")
+	_, err := s.Set(ctx, kv, tValue, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, m, 20*time.Second))
+	require.NoError(t, s.LNew(ctx, q, 0, server.QueueLimit{}))
+	_, err = s.Lock(ctx, bridgeLocation("lock"), 10*time.Second, "")
+	require.NoError(t, err)
+
+	srv.FastForward(10 * time.Second)
+
+	_, _, err = s.Get(ctx, kv)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, err = s.HLen(ctx, m)
+	assert.NoError(t, err)
+	_, err = s.Lock(ctx, bridgeLocation("lock"), 0, "")
+	assert.NoError(t, err, "expired lock should be acquirable")
+
+	srv.FastForward(10 * time.Second)
+
+	_, err = s.HLen(ctx, m)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, err = s.LLen(ctx, q)
+	assert.NoError(t, err, "entries without TTL should never expire")
+}
+
+func TestScan(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+
+	_, err := s.Set(ctx, bridgeLocation("a*kv"), tValue, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, bridgeLocation("a*map"), 0))
+	require.NoError(t, s.LNew(ctx, bridgeLocation("a*queue"), 0, server.QueueLimit{}))
+	_, err = s.Set(ctx, bridgeLocation("ab"), tValue, 0)
+	require.NoError(t, err)
+	_, err = s.Set(ctx, instanceLocation("a*other"), tValue, 0)
+	require.NoError(t, err)
+
+	var keys []server.KeyInfo
+	cursor := ""
+	for {
+		page, next, err := s.Scan(ctx, bridgeLocation("").Scope, "a*", cursor, 2)
+		require.NoError(t, err)
+		keys = append(keys, page...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	require.Len(t, keys, 3)
+	assert.Equal(t, "a*kv", keys[0].Key)
+	assert.Equal(t, server.KindKV, keys[0].Kind)
+	assert.Greater(t, int64(keys[0].TTL), int64(9*time.Second))
+	assert.Equal(t, server.KeyInfo{Key: "a*map", Kind: server.KindMap}, keys[1])
+	assert.Equal(t, server.KeyInfo{Key: "a*queue", Kind: server.KindQueue}, keys[2])
+}
+
+func TestDropScope(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+
+	_, err := s.Set(ctx, bridgeLocation("kv"), tValue, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.HNew(ctx, instanceLocation("map"), 0))
+	_, err = s.Lock(ctx, instanceLocation("lock"), 0, "")
+	require.NoError(t, err)
+	_, err = s.Set(ctx, globalLocation("kv"), tValue, 0)
+	require.NoError(t, err)
+
+	dropped, err := s.DropScope(ctx, instanceLocation("").Scope)
+	require.NoError(t, err)
+	require.Len(t, dropped, 1)
+	assert.Equal(t, "map", dropped[0].Key)
+	_, _, err = s.Get(ctx, bridgeLocation("kv"))
+	assert.NoError(t, err, "dropping an instance should not affect its bridge")
+
+	require.NoError(t, s.LNew(ctx, instanceLocation("queue"), 0, server.QueueLimit{}))
+	dropped, err = s.DropScope(ctx, bridgeLocation("").Scope)
+	require.NoError(t, err)
+	sort.Slice(dropped, func(i, j int) bool { return dropped[i].Key < dropped[j].Key })
+	require.Len(t, dropped, 2)
+	assert.Equal(t, "kv", dropped[0].Key)
+	assert.Equal(t, eventstore.ScopeChoice_Bridge, dropped[0].Scope.Type)
+	assert.Equal(t, "queue", dropped[1].Key)
+	assert.Equal(t, tInstance, dropped[1].Scope.Instance)
+
+	keys := srv.Keys(0)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"eventstore:g:k:kv", "eventstore:g:v:kv", "eventstore:version"}, keys)
+
+	dropped, err = s.DropScope(ctx, bridgeLocation("").Scope)
+	require.NoError(t, err)
+	assert.Empty(t, dropped)
+
+	// Keys that expired or were deleted are not reported.
+	_, err = s.Set(ctx, instanceLocation("expiring"), tValue, time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.SNew(ctx, instanceLocation("deleted"), 0))
+	require.NoError(t, s.Del(ctx, instanceLocation("deleted")))
+	srv.FastForward(time.Minute)
+	dropped, err = s.DropScope(ctx, bridgeLocation("").Scope)
+	require.NoError(t, err)
+	assert.Empty(t, dropped)
+	assert.Len(t, srv.Keys(0), len(keys), "indexes should be removed along with their scope")
+}
+
+func TestTTL(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	kv, q := bridgeLocation("kv"), bridgeLocation("queue")
+
+	_, err := s.TTL(ctx, kv)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	assert.ErrorIs(t, s.Expire(ctx, kv, time.Second), server.ErrNotFound)
+	assert.ErrorIs(t, s.Persist(ctx, kv), server.ErrNotFound)
+
+	version, err := s.Set(ctx, kv, tValue, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, s.LNew(ctx, q, 0, server.QueueLimit{}))
+
+	ttl, err := s.TTL(ctx, kv)
+	require.NoError(t, err)
+	assert.Greater(t, int64(ttl), int64(9*time.Second))
+	ttl, err = s.TTL(ctx, q)
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+
+	require.NoError(t, s.Expire(ctx, kv, 20*time.Second))
+	require.NoError(t, s.Expire(ctx, q, 5*time.Second))
+	srv.FastForward(10 * time.Second)
+
+	_, err = s.LLen(ctx, q)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	_, v, err := s.Get(ctx, kv)
+	require.NoError(t, err)
+	assert.Equal(t, version, v, "the version should expire along with the value")
+
+	require.NoError(t, s.Persist(ctx, kv))
+	srv.FastForward(time.Hour)
+	_, v, err = s.Get(ctx, kv)
+	require.NoError(t, err)
+	assert.Equal(t, version, v)
+}
+
+func TestIncr(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	v, err := s.IncrBy(ctx, loc, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), v)
+
+	v, err = s.IncrBy(ctx, loc, -7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), v)
+
+	b, _, err := s.Get(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("-2"), b)
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+
+	require.NoError(t, err)
+	_, err = s.IncrBy(ctx, loc, 1)
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+
+	require.NoError(t, s.HNew(ctx, bridgeLocation(tKey), 0))
+	_, err = s.IncrBy(ctx, bridgeLocation(tKey), 1)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func TestIncrFloat(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	f, err := s.IncrByFloat(ctx, loc, 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, f)
+
+	_, err = s.IncrBy(ctx, loc, 1)
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+
+	f, err = s.IncrByFloat(ctx, loc, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, f)
+
+	n, err := s.IncrBy(ctx, loc, 1)
+	require.NoError(t, err, "integral results should be incremented as integers")
+	assert.Equal(t, int64(3), n)
+
+	_, err = s.IncrByFloat(ctx, loc, math.MaxFloat64)
+	require.NoError(t, err)
+	_, err = s.IncrByFloat(ctx, loc, math.MaxFloat64)
+	assert.ErrorIs(t, err, server.ErrOutOfRange)
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+	require.NoError(t, err)
+	_, err = s.IncrByFloat(ctx, loc, 1)
+	assert.ErrorIs(t, err, server.ErrNotFloat)
+
+	m := bridgeLocation(tKey)
+	require.NoError(t, s.HNew(ctx, m, 0))
+	f, err = s.HIncrByFloat(ctx, m, "counter", -0.25)
+	require.NoError(t, err)
+	assert.Equal(t, -0.25, f)
+	v, err := s.HGet(ctx, m, "counter")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("-0.25"), v)
+
+	require.NoError(t, s.HSet(ctx, m, "field", tValue, 0))
+	_, err = s.HIncrByFloat(ctx, m, "field", 1)
+	assert.ErrorIs(t, err, server.ErrNotFloat)
+}
+
+func TestConditionalSet(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	_, err := s.SetXX(ctx, loc, tValue, 0)
+	assert.ErrorIs(t, err, server.ErrConflict)
+	_, err = s.CompareAndSwap(ctx, loc, tValue, 0, 1)
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	v1, err := s.SetNX(ctx, loc, tValue, 0)
+	require.NoError(t, err)
+	assert.NotZero(t, v1)
+	_, err = s.SetNX(ctx, loc, tValue, 0)
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	_, version, err := s.Get(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, v1, version)
+
+	v2, err := s.SetXX(ctx, loc, []byte("2"), 0)
+	require.NoError(t, err)
+	assert.Greater(t, v2, v1)
+
+	_, err = s.CompareAndSwap(ctx, loc, []byte("3"), 0, v1)
+	assert.ErrorIs(t, err, server.ErrConflict)
+	v3, err := s.CompareAndSwap(ctx, loc, []byte("3"), 0, v2)
+	require.NoError(t, err)
+	assert.Greater(t, v3, v2)
+
+	n, err := s.IncrBy(ctx, loc, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+	_, err = s.CompareAndSwap(ctx, loc, tValue, 0, v3)
+	assert.ErrorIs(t, err, server.ErrConflict, "increments should change the version")
+
+	require.NoError(t, s.Del(ctx, loc))
+	v4, err := s.Set(ctx, loc, tValue, 0)
+	require.NoError(t, err)
+	assert.Greater(t, v4, v3, "versions should not be reused after deleting a key")
+
+	require.NoError(t, s.HNew(ctx, globalLocation(tKey), 0))
+	_, err = s.CompareAndSwap(ctx, globalLocation(tKey), tValue, 0, 1)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func TestTxn(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	queue := bridgeLocation("queue")
+	counts := bridgeLocation("counts")
+	total := bridgeLocation("total")
+
+	require.NoError(t, s.LNew(ctx, queue, 0, server.QueueLimit{}))
+	require.NoError(t, s.RPush(ctx, queue, tValue, server.PushOptions{}))
+	require.NoError(t, s.HNew(ctx, counts, 0))
+	version, err := s.Set(ctx, total, []byte("1"), 0)
+	require.NoError(t, err)
+
+	res, err := s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardVersion, Location: total, Version: version},
+		{Kind: server.GuardNotExists, Location: bridgeLocation(tKey)},
+	}, []server.Op{
+		{Kind: server.OpLPop, Location: queue},
+		{Kind: server.OpHIncrBy, Location: counts, Field: "items", Delta: 2},
+		{Kind: server.OpIncrBy, Location: total, Delta: 1},
+		{Kind: server.OpSet, Location: bridgeLocation(tKey), Value: tValue},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+	assert.Equal(t, tValue, res[0].Value)
+	assert.Equal(t, int64(2), res[1].Number)
+	assert.Equal(t, int64(2), res[2].Number)
+	assert.Greater(t, res[2].Version, version)
+	assert.Greater(t, res[3].Version, res[2].Version)
+
+	v, current, err := s.Get(ctx, total)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), v)
+	assert.Equal(t, res[2].Version, current)
+
+	_, err = s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardField, Location: counts, Field: "items", Value: []byte("1")},
+	}, []server.Op{
+		{Kind: server.OpDel, Location: total},
+	})
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	// The second pop fails, nothing is applied.
+	_, err = s.Txn(ctx, []server.Guard{
+		{Kind: server.GuardField, Location: counts, Field: "items", Value: []byte("2")},
+		{Kind: server.GuardExists, Location: queue},
+	}, []server.Op{
+		{Kind: server.OpRPush, Location: queue, Value: tValue},
+		{Kind: server.OpHDel, Location: counts, Field: "items"},
+		{Kind: server.OpDel, Location: total},
+		{Kind: server.OpLPop, Location: queue},
+		{Kind: server.OpLPop, Location: queue},
+	})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	n, err := s.LLen(ctx, queue)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	v, err = s.HGet(ctx, counts, "items")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), v)
+	_, _, err = s.Get(ctx, total)
+	assert.NoError(t, err)
+
+	res, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpDel, Location: counts},
+		{Kind: server.OpHNew, Location: counts},
+		{Kind: server.OpHIncrBy, Location: counts, Field: "items", Delta: 1},
+		{Kind: server.OpRPush, Location: queue, Value: tValue},
+		{Kind: server.OpLPop, Location: queue},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), res[2].Number, "fields of deleted maps should not be kept")
+	assert.Equal(t, tValue, res[4].Value)
+
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpIncrBy, Location: bridgeLocation(tKey), Delta: 1},
+	})
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpHSet, Location: total, Field: "f", Value: tValue},
+	})
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func TestMap(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	assert.ErrorIs(t, s.HSet(ctx, loc, "field", tValue, 0), server.ErrNotFound)
+
+	require.NoError(t, s.HNew(ctx, loc, 0))
+	assert.ErrorIs(t, s.HNew(ctx, loc, 0), server.ErrAlreadyExists)
+
+	l, err := s.HLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 0, l, "new maps should be empty")
+
+	require.NoError(t, s.HSet(ctx, loc, "field", tValue, 0))
+	v, err := s.HGet(ctx, loc, "field")
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+
+	_, err = s.HGet(ctx, loc, "missing")
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	n, err := s.HIncrBy(ctx, loc, "counter", 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	_, err = s.HIncrBy(ctx, loc, "field", 1)
+	assert.ErrorIs(t, err, server.ErrNotInteger)
+
+	l, err = s.HLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+
+	all, err := s.HGetAll(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"field": tValue, "counter": []byte("3")}, all)
+
+	require.NoError(t, s.HDel(ctx, loc, "field"))
+	require.NoError(t, s.HDel(ctx, loc, "counter"))
+	assert.ErrorIs(t, s.HDel(ctx, loc, "field"), server.ErrNotFound)
+
+	all, err = s.HGetAll(ctx, loc)
+	require.NoError(t, err, "maps should exist after removing all fields")
+	assert.Empty(t, all)
+
+	_, _, err = s.Get(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+
+	require.NoError(t, s.Del(ctx, loc))
+	_, err = s.HLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+}
+
+func TestMapFieldTTL(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	require.NoError(t, s.HNew(ctx, loc, time.Hour))
+	require.NoError(t, s.HSet(ctx, loc, "short", tValue, time.Second))
+	require.NoError(t, s.HSet(ctx, loc, "long", tValue, time.Minute))
+	require.NoError(t, s.HSet(ctx, loc, "kept", tValue, 0))
+
+	err := s.HSetNX(ctx, loc, "short", []byte("other"), 0)
+	assert.ErrorIs(t, err, server.ErrConflict)
+
+	srv.FastForward(2 * time.Second)
+
+	_, err = s.HGet(ctx, loc, "short")
+	assert.ErrorIs(t, err, server.ErrNotFound, "expired fields should not be found")
+	assert.ErrorIs(t, s.HDel(ctx, loc, "short"), server.ErrNotFound)
+
+	l, err := s.HLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l)
+
+	// expired fields can be set again, and increments start from zero.
+	require.NoError(t, s.HSetNX(ctx, loc, "short", []byte("1"), time.Second))
+	srv.FastForward(2 * time.Second)
+	n, err := s.HIncrBy(ctx, loc, "short", 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	// setting a field without TTL keeps it as long as the map.
+	require.NoError(t, s.HSet(ctx, loc, "long", tValue, 0))
+
+	_, err = s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpHSet, Location: loc, Field: "txn", Value: tValue, TTL: time.Second},
+	})
+	require.NoError(t, err)
+
+	srv.FastForward(2 * time.Minute)
+
+	all, err := s.HGetAll(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"short": []byte("3"), "long": tValue, "kept": tValue}, all)
+
+	require.NoError(t, s.Del(ctx, loc))
+	assert.Empty(t, srv.Keys(0), "field expirations should be removed along with the map")
+}
+
+func TestQueue(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	assert.ErrorIs(t, s.RPush(ctx, loc, tValue, server.PushOptions{}), server.ErrNotFound)
+
+	require.NoError(t, s.LNew(ctx, loc, time.Hour, server.QueueLimit{}))
+	_, err := s.LPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	for _, v := range []string{"a", "b", "c"} {
+		require.NoError(t, s.RPush(ctx, loc, []byte(v), server.PushOptions{}))
+	}
+
+	v, err := s.LIndex(ctx, loc, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("b"), v)
+
+	_, err = s.LIndex(ctx, loc, 3)
+	assert.ErrorIs(t, err, server.ErrOutOfRange)
+
+	items, err := s.LRange(ctx, loc, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("c")}, items)
+
+	items, err = s.LRange(ctx, loc, -2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	items, err = s.LRange(ctx, loc, 2, 1)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	for _, expected := range []string{"a", "b", "c"} {
+		v, err = s.LPop(ctx, loc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(expected), v)
+	}
+
+	l, err := s.LLen(ctx, loc)
+	require.NoError(t, err, "queues should exist after popping all items")
+	assert.Equal(t, 0, l)
+
+	_, err = s.LPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	_, err = s.HLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+}
+
+func TestDoubleEndedQueue(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	_, err := s.RPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.RPush(ctx, loc, []byte("b"), server.PushOptions{}))
+	require.NoError(t, s.LPush(ctx, loc, []byte("a")))
+	require.NoError(t, s.RPush(ctx, loc, []byte("c"), server.PushOptions{}))
+	require.NoError(t, s.RPush(ctx, loc, []byte("d"), server.PushOptions{}))
+
+	items, err := s.LRange(ctx, loc, 1, -2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	v, err := s.RPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("d"), v)
+
+	require.NoError(t, s.LTrim(ctx, loc, 1, 5))
+	items, err = s.LRange(ctx, loc, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	require.NoError(t, s.LTrim(ctx, loc, 2, 1))
+	l, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Zero(t, l)
+
+	require.NoError(t, s.LPush(ctx, loc, tValue))
+	v, err = s.LPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, tValue, v)
+}
+
+func TestBoundedQueue(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	reject, drop := instanceLocation("reject"), instanceLocation("drop")
+
+	require.NoError(t, s.LNew(ctx, reject, 0, server.QueueLimit{MaxLen: 2}))
+	require.NoError(t, s.LNew(ctx, drop, 0, server.QueueLimit{MaxLen: 2, Overflow: server.OverflowDropOldest}))
+
+	for _, v := range []string{"a", "b"} {
+		require.NoError(t, s.RPush(ctx, reject, []byte(v), server.PushOptions{}))
+		require.NoError(t, s.RPush(ctx, drop, []byte(v), server.PushOptions{}))
+	}
+
+	assert.ErrorIs(t, s.RPush(ctx, reject, []byte("c"), server.PushOptions{}), server.ErrQueueFull)
+	assert.ErrorIs(t, s.LPush(ctx, reject, []byte("c")), server.ErrQueueFull)
+
+	require.NoError(t, s.RPush(ctx, drop, []byte("c"), server.PushOptions{}))
+	items, err := s.LRange(ctx, drop, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, items)
+
+	require.NoError(t, s.LPush(ctx, drop, []byte("a")))
+	items, err = s.LRange(ctx, drop, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, items)
+
+	_, err = s.Txn(ctx, nil, []server.Op{{Kind: server.OpRPush, Location: reject, Value: tValue}})
+	assert.ErrorIs(t, err, server.ErrQueueFull)
+
+	// The limit is kept when the queue is trimmed.
+	require.NoError(t, s.LTrim(ctx, reject, 0, 0))
+	require.NoError(t, s.RPush(ctx, reject, tValue, server.PushOptions{}))
+	assert.ErrorIs(t, s.RPush(ctx, reject, tValue, server.PushOptions{}), server.ErrQueueFull)
+}
+
+func TestScheduledQueue(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc, bounded := instanceLocation("queue"), instanceLocation("bounded")
+
+	push := func(loc *eventstore.LocationType, v string, priority int, delay time.Duration) error {
+		return s.RPush(ctx, loc, []byte(v), server.PushOptions{Priority: priority, Delay: delay})
+	}
+	all := func() []string {
+		items, err := s.LRange(ctx, loc, 0, -1)
+		require.NoError(t, err)
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, string(item))
+		}
+		return values
+	}
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	require.NoError(t, push(loc, "a", 0, 0))
+	require.NoError(t, push(loc, "low", -1, 0))
+	require.NoError(t, push(loc, "b", 0, 0))
+	require.NoError(t, push(loc, "high", 5, 0))
+	require.NoError(t, push(loc, "later", 0, 10*time.Second))
+	require.NoError(t, push(loc, "urgent", 10, time.Minute))
+	assert.Equal(t, []string{"high", "a", "b", "low"}, all())
+
+	n, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n, "hidden items should not be counted")
+
+	// Items pushed once a delayed item is visible are popped after it.
+	srv.FastForward(10 * time.Second)
+	require.NoError(t, push(loc, "c", 0, 0))
+	assert.Equal(t, []string{"high", "a", "b", "later", "c", "low"}, all())
+
+	v, err := s.LIndex(ctx, loc, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("later"), v)
+
+	srv.FastForward(time.Minute)
+	for _, expected := range []string{"urgent", "high"} {
+		v, err = s.LPop(ctx, loc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(expected), v)
+	}
+	v, err = s.RPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("low"), v)
+
+	require.NoError(t, s.LPush(ctx, loc, []byte("first")))
+	require.NoError(t, s.LTrim(ctx, loc, 1, -2))
+	assert.Equal(t, []string{"a", "b", "later"}, all())
+
+	res, err := s.Txn(ctx, nil, []server.Op{
+		{Kind: server.OpRPush, Location: loc, Value: []byte("top"), Push: server.PushOptions{Priority: 1}},
+		{Kind: server.OpLPop, Location: loc},
+		{Kind: server.OpLPop, Location: loc},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("top"), res[1].Value)
+	assert.Equal(t, []byte("a"), res[2].Value)
+	assert.Equal(t, []string{"b", "later"}, all())
+
+	// Hidden items count towards the limit, but are never dropped.
+	require.NoError(t, s.LNew(ctx, bounded, 0, server.QueueLimit{MaxLen: 1, Overflow: server.OverflowDropOldest}))
+	require.NoError(t, push(bounded, "x", 0, time.Minute))
+	assert.ErrorIs(t, push(bounded, "y", 0, 0), server.ErrQueueFull)
+}
+
+func TestReserve(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc, dl := instanceLocation("queue"), instanceLocation("dead-letter")
+	opts := server.ReserveOptions{Visibility: 10 * time.Second, MaxDeliveries: 2, DeadLetter: dl}
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	_, err := s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound, "dead-letter queue should exist")
+
+	require.NoError(t, s.LNew(ctx, dl, 0, server.QueueLimit{}))
+	_, err = s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	for _, v := range []string{"a", "b"} {
+		require.NoError(t, s.RPush(ctx, loc, []byte(v), server.PushOptions{}))
+	}
+
+	a, err := s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), a.Value)
+	assert.Equal(t, 1, a.Deliveries)
+
+	l, err := s.LLen(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l, "reserved items should not be counted")
+
+	b, err := s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("b"), b.Value)
+	require.NoError(t, s.LAck(ctx, loc, b.ID))
+	assert.ErrorIs(t, s.LAck(ctx, loc, b.ID), server.ErrNotFound)
+
+	_, err = s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound, "reserved items should be hidden")
+
+	srv.FastForward(10 * time.Second)
+
+	a, err = s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), a.Value)
+	assert.Equal(t, 2, a.Deliveries)
+
+	require.NoError(t, s.LNack(ctx, loc, a.ID))
+	assert.ErrorIs(t, s.LNack(ctx, loc, "unknown"), server.ErrNotFound)
+
+	res, err := s.LReserve(ctx, loc, opts)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	assert.Equal(t, [][]byte{[]byte("a")}, res.DeadLetters)
+
+	items, err := s.LRange(ctx, dl, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a")}, items)
+
+	require.NoError(t, s.RPush(ctx, loc, []byte("c"), server.PushOptions{}))
+	c, err := s.LReserve(ctx, loc, opts)
+	require.NoError(t, err)
+	require.NoError(t, s.Del(ctx, loc))
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	assert.ErrorIs(t, s.LAck(ctx, loc, c.ID), server.ErrNotFound, "reservations should be removed with the queue")
+}
+
+func TestReserveDeadLetterLimit(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc, reject, drop := instanceLocation("queue"), instanceLocation("reject"), instanceLocation("drop")
+
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	require.NoError(t, s.LNew(ctx, reject, 0, server.QueueLimit{MaxLen: 1}))
+	require.NoError(t, s.LNew(ctx, drop, 0, server.QueueLimit{MaxLen: 1, Overflow: server.OverflowDropOldest}))
+	for _, dl := range []*eventstore.LocationType{reject, drop} {
+		require.NoError(t, s.RPush(ctx, dl, []byte("x"), server.PushOptions{}))
+	}
+
+	require.NoError(t, s.RPush(ctx, loc, []byte("a"), server.PushOptions{}))
+	a, err := s.LReserve(ctx, loc, server.ReserveOptions{Visibility: time.Minute})
+	require.NoError(t, err)
+	require.NoError(t, s.LNack(ctx, loc, a.ID))
+
+	// Dead letters are pushed like any other item.
+	_, err = s.LReserve(ctx, loc, server.ReserveOptions{Visibility: time.Minute, MaxDeliveries: 1, DeadLetter: reject})
+	assert.ErrorIs(t, err, server.ErrQueueFull)
+
+	res, err := s.LReserve(ctx, loc, server.ReserveOptions{Visibility: time.Minute, MaxDeliveries: 1, DeadLetter: drop})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+	assert.Equal(t, [][]byte{[]byte("a")}, res.DeadLetters)
+
+	for dl, want := range map[*eventstore.LocationType]string{reject: "x", drop: "a"} {
+		items, err := s.LRange(ctx, dl, 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(want)}, items)
+	}
+}
+
+func TestConcurrentPop(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	const items = 50
+	require.NoError(t, s.LNew(ctx, loc, 0, server.QueueLimit{}))
+	for i := 0; i < items; i++ {
+		require.NoError(t, s.RPush(ctx, loc, []byte{byte(i)}, server.PushOptions{}))
+	}
+
+	var (
+		mu     sync.Mutex
+		popped = make(map[byte]int)
+		wg     sync.WaitGroup
+	)
+	for w := 0; w < 5; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, err := s.LPop(ctx, loc)
+				if err != nil {
+					assert.ErrorIs(t, err, server.ErrNotFound)
+					return
+				}
+				mu.Lock()
+				popped[v[0]]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, popped, items)
+	for i, n := range popped {
+		assert.Equal(t, 1, n, "item %d popped more than once", i)
+	}
+}
+
+func TestSet(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	_, err := s.SAdd(ctx, loc, []string{"a"})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.SNew(ctx, loc, time.Minute))
+	assert.ErrorIs(t, s.SNew(ctx, loc, 0), server.ErrAlreadyExists)
+
+	l, err := s.SCard(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 0, l, "new sets should be empty")
+
+	_, err = s.SPop(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	n, err := s.SAdd(ctx, loc, []string{"a", "b", "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	ok, err := s.SIsMember(ctx, loc, "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	n, err = s.SRem(ctx, loc, []string{"a", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	members, err := s.SMembers(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, members)
+
+	m, err := s.SPop(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, "b", m)
+
+	members, err = s.SMembers(ctx, loc)
+	require.NoError(t, err, "sets should exist after removing all members")
+	assert.Empty(t, members)
+
+	_, err = s.HLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+
+	keys, _, err := s.Scan(ctx, loc.Scope, "", "", 10)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, server.KindSet, keys[0].Kind)
+
+	srv.FastForward(time.Minute)
+	_, err = s.SCard(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+}
+
+func TestSortedSet(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	loc := instanceLocation(tKey)
+
+	_, err := s.ZAdd(ctx, loc, []server.ScoredMember{{Member: "a", Score: 1}})
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.ZNew(ctx, loc, 0))
+	assert.ErrorIs(t, s.ZNew(ctx, loc, 0), server.ErrAlreadyExists)
+
+	l, err := s.ZCard(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 0, l, "new sorted sets should be empty")
+
+	n, err := s.ZAdd(ctx, loc, []server.ScoredMember{
+		{Member: "c", Score: 3},
+		{Member: "a", Score: 1},
+		{Member: "b", Score: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	n, err = s.ZAdd(ctx, loc, []server.ScoredMember{{Member: "c", Score: 2}, {Member: "d", Score: -4}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "existing members should be updated")
+
+	score, err := s.ZIncrBy(ctx, loc, "e", 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, score)
+
+	members, err := s.ZRange(ctx, loc, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{
+		{Member: "d", Score: -4},
+		{Member: "a", Score: 1},
+		{Member: "b", Score: 1},
+		{Member: "e", Score: 1.5},
+		{Member: "c", Score: 2},
+	}, members)
+
+	members, err = s.ZRange(ctx, loc, -10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{{Member: "d", Score: -4}}, members)
+
+	members, err = s.ZRangeByScore(ctx, loc, math.Inf(-1), 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []server.ScoredMember{{Member: "d", Score: -4}, {Member: "a", Score: 1}}, members)
+
+	removed, err := s.ZRemRangeByScore(ctx, loc, math.Inf(-1), math.Inf(1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "a", "b", "e", "c"}, removed)
+
+	l, err = s.ZCard(ctx, loc)
+	require.NoError(t, err, "sorted sets should exist after removing all members")
+	assert.Equal(t, 0, l)
+
+	_, err = s.ZIncrBy(ctx, loc, "f", math.MaxFloat64)
+	require.NoError(t, err)
+	_, err = s.ZIncrBy(ctx, loc, "f", math.MaxFloat64)
+	assert.ErrorIs(t, err, server.ErrOutOfRange)
+
+	keys, _, err := s.Scan(ctx, loc.Scope, "", "", 10)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, server.KindSortedSet, keys[0].Kind)
+}
+
+func TestStream(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := bridgeLocation(tKey)
+
+	_, err := s.XAdd(ctx, loc, tValue)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	require.NoError(t, s.XNew(ctx, loc, time.Hour, server.StreamRetention{MaxLen: 3, MaxAge: time.Minute}))
+	assert.ErrorIs(t, s.XNew(ctx, loc, 0, server.StreamRetention{}), server.ErrAlreadyExists)
+
+	entries, err := s.XRange(ctx, loc, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "new streams should be empty")
+
+	for i := int64(1); i <= 4; i++ {
+		offset, err := s.XAdd(ctx, loc, []byte{byte(i)})
+		require.NoError(t, err)
+		assert.Equal(t, i, offset)
+		srv.FastForward(20 * time.Second)
+	}
+
+	entries, err = s.XRange(ctx, loc, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, offsets(entries), "entries out of the retention should be skipped")
+	assert.Equal(t, []byte{3}, entries[0].Value)
+
+	entries, err = s.XRange(ctx, loc, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, offsets(entries), "retired entries should not count towards the limit")
+
+	srv.FastForward(time.Minute)
+	offset, err := s.XAdd(ctx, loc, tValue)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), offset, "offsets should not be reused")
+
+	entries, err = s.XRange(ctx, loc, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5}, offsets(entries))
+
+	o, err := s.XOffset(ctx, loc, "indexer")
+	require.NoError(t, err)
+	assert.Zero(t, o)
+
+	require.NoError(t, s.XCommit(ctx, loc, "indexer", 6))
+	assert.ErrorIs(t, s.XCommit(ctx, loc, "indexer", 7), server.ErrOutOfRange)
+
+	o, err = s.XOffset(ctx, loc, "indexer")
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), o)
+
+	_, err = s.LLen(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrWrongKind)
+
+	require.NoError(t, s.Del(ctx, loc))
+	assert.Empty(t, srv.Keys(0), "committed offsets should be removed along with the stream")
+}
+
+func offsets(entries []server.StreamEntry) []int64 {
+	res := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, e.Offset)
+	}
+	return res
+}
+
+func TestLock(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	lease, err := s.Lock(ctx, loc, 0, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, lease.Token)
+
+	_, err = s.Lock(ctx, loc, 0, "")
+	assert.ErrorIs(t, err, server.ErrLocked)
+
+	_, err = s.Lock(ctx, bridgeLocation(tKey), 0, "")
+	assert.NoError(t, err, "locks should be isolated by scope")
+
+	_, err = s.Set(ctx, loc, tValue, 0)
+
+	require.NoError(t, err)
+	for _, k := range srv.Keys(0) {
+		assert.True(t, strings.HasPrefix(k, defaultPrefix+":"), "unexpected key %q", k)
+	}
+
+	assert.ErrorIs(t, s.Unlock(ctx, loc, "wrong"), server.ErrUnlockMismatch)
+	require.NoError(t, s.Unlock(ctx, loc, lease.Token))
+	assert.ErrorIs(t, s.Unlock(ctx, loc, lease.Token), server.ErrNotFound)
+
+	_, _, err = s.Get(ctx, loc)
+	assert.NoError(t, err, "locks should not collide with keys")
+}
+
+func TestLockLease(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	_, err := s.LockInfo(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+
+	first, err := s.Lock(ctx, loc, 10*time.Second, "worker-1")
+	require.NoError(t, err)
+
+	info, err := s.LockInfo(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, "worker-1", info.Owner)
+	assert.Equal(t, first.FencingToken, info.FencingToken)
+	assert.Equal(t, first.Token, info.Token)
+	assert.InDelta(t, 10*time.Second, info.TTL, float64(time.Second))
+
+	assert.ErrorIs(t, s.RefreshLock(ctx, loc, "wrong", time.Minute), server.ErrUnlockMismatch)
+	require.NoError(t, s.RefreshLock(ctx, loc, first.Token, time.Minute))
+
+	srv.FastForward(30 * time.Second)
+	_, err = s.Lock(ctx, loc, 0, "worker-2")
+	assert.ErrorIs(t, err, server.ErrLocked, "refreshed locks should be held")
+
+	srv.FastForward(time.Minute)
+	assert.ErrorIs(t, s.RefreshLock(ctx, loc, first.Token, time.Minute), server.ErrNotFound)
+
+	second, err := s.Lock(ctx, loc, 0, "worker-2")
+	require.NoError(t, err)
+	assert.Greater(t, second.FencingToken, first.FencingToken, "fencing tokens should increase")
+
+	info, err = s.LockInfo(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), info.TTL)
+}
+
+func TestReadLock(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	first, err := s.RLock(ctx, loc, 10*time.Second)
+	require.NoError(t, err)
+	second, err := s.RLock(ctx, loc, 0)
+	require.NoError(t, err)
+
+	info, err := s.LockInfo(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, server.Lease{Readers: 2}, info)
+
+	_, err = s.Lock(ctx, loc, 0, "writer")
+	assert.ErrorIs(t, err, server.ErrLocked, "read locked keys should not be locked exclusively")
+
+	srv.FastForward(time.Minute)
+	assert.ErrorIs(t, s.RUnlock(ctx, loc, first), server.ErrNotFound, "shared locks should time out")
+	require.NoError(t, s.RUnlock(ctx, loc, second))
+	assert.ErrorIs(t, s.RUnlock(ctx, loc, second), server.ErrNotFound)
+
+	lease, err := s.Lock(ctx, loc, 0, "writer")
+	require.NoError(t, err)
+
+	_, err = s.RLock(ctx, loc, 0)
+	assert.ErrorIs(t, err, server.ErrLocked, "locked keys should not be read locked")
+
+	require.NoError(t, s.Unlock(ctx, loc, lease.Token))
+	_, err = s.LockInfo(ctx, loc)
+	assert.ErrorIs(t, err, server.ErrNotFound)
+}
+
+func TestSemaphore(t *testing.T) {
+	s, srv := newTestStore(t)
+	ctx := context.Background()
+	loc := globalLocation(tKey)
+
+	first, err := s.Acquire(ctx, loc, 2, 3, 10*time.Second)
+	require.NoError(t, err)
+	second, err := s.Acquire(ctx, loc, 1, 3, 0)
+	require.NoError(t, err)
+
+	_, err = s.Acquire(ctx, loc, 1, 3, 0)
+	assert.ErrorIs(t, err, server.ErrNoPermits)
+
+	// Semaphores do not share the namespace of locks.
+	_, err = s.Lock(ctx, loc, 0, "")
+	require.NoError(t, err)
+
+	srv.FastForward(time.Minute)
+	assert.ErrorIs(t, s.Release(ctx, loc, first), server.ErrNotFound, "permits should time out")
+
+	third, err := s.Acquire(ctx, loc, 2, 3, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Release(ctx, loc, second))
+	require.NoError(t, s.Release(ctx, loc, third))
+	assert.ErrorIs(t, s.Release(ctx, loc, third), server.ErrNotFound)
+
+	keys := srv.Keys(0)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"eventstore:g:l:test-key", "eventstore:version"}, keys,
+		"semaphores should be removed along with their last holder")
+}
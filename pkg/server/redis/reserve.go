@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Reserved queue items are stored at a companion hash whose fields are
+// the reservation identifiers. Values hold the time in milliseconds when
+// the item is visible again, the number of deliveries and the item.
+// Times are taken from the Redis clock.
+
+type reserved struct {
+	id         string
+	visibleAt  int64
+	deliveries int
+	value      []byte
+}
+
+func (r reserved) encode() []byte {
+	b := []byte(strconv.FormatInt(r.visibleAt, 10) + " " + strconv.Itoa(r.deliveries) + " ")
+	return append(b, r.value...)
+}
+
+func decodeReserved(id string, b []byte) (reserved, error) {
+	parts := bytes.SplitN(b, []byte(" "), 3)
+	if len(parts) != 3 {
+		return reserved{}, fmt.Errorf("malformed reservation %q", id)
+	}
+
+	visibleAt, err := strconv.ParseInt(string(parts[0]), 10, 64)
+	if err != nil {
+		return reserved{}, fmt.Errorf("malformed reservation %q: %w", id, err)
+	}
+	deliveries, err := strconv.Atoi(string(parts[1]))
+	if err != nil {
+		return reserved{}, fmt.Errorf("malformed reservation %q: %w", id, err)
+	}
+
+	return reserved{id: id, visibleAt: visibleAt, deliveries: deliveries, value: parts[2]}, nil
+}
+
+// LReserve implements server.List.
+func (s *Store) LReserve(ctx context.Context, loc *eventstore.LocationType, opts server.ReserveOptions) (server.Reservation, error) {
+	id, err := newToken()
+	if err != nil {
+		return server.Reservation{}, err
+	}
+
+	key, rkey := s.key(loc), s.reservedKey(loc)
+	watch := []string{key, rkey, s.scheduledKey(loc)}
+	if opts.DeadLetter != nil {
+		watch = append(watch, s.key(opts.DeadLetter), s.scheduledKey(opts.DeadLetter))
+	}
+
+	var (
+		res   server.Reservation
+		empty bool
+	)
+	_, err = s.transactionAll(ctx, watch, func(c *conn) ([][]interface{}, error) {
+		res, empty = server.Reservation{ID: id}, false
+
+		q, err := s.loadQueue(ctx, c, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		// Dead letters are pushed like any other item,
+		// honoring the limit of the dead-letter queue.
+		var dlq *queue
+		if opts.DeadLetter != nil {
+			if dlq, err = s.loadQueue(ctx, c, opts.DeadLetter); err != nil {
+				return nil, err
+			}
+		}
+
+		r, err := c.do(ctx, "HGETALL", rkey)
+		if err != nil {
+			return nil, err
+		}
+		items, err := parseReserved(r)
+		if err != nil {
+			return nil, err
+		}
+
+		now, err := q.clock(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		var cmds [][]interface{}
+		delivered := false
+		for _, item := range items {
+			if item.visibleAt > now {
+				break
+			}
+
+			cmds = append(cmds, cmd("HDEL", rkey, item.id))
+			if opts.MaxDeliveries == 0 || item.deliveries < opts.MaxDeliveries {
+				res.Value, res.Deliveries = item.value, item.deliveries
+				delivered = true
+				break
+			}
+
+			res.DeadLetters = append(res.DeadLetters, item.value)
+			if dlq != nil {
+				push, err := s.pushCmds(ctx, c, dlq, item.value, server.PushOptions{}, false)
+				if err != nil {
+					return nil, err
+				}
+				cmds = append(cmds, push...)
+			}
+		}
+
+		if !delivered {
+			if q.len() == 0 {
+				if len(cmds) == 0 {
+					return nil, errEmptyQueue(loc)
+				}
+				empty = true
+				return cmds, nil
+			}
+
+			if res.Value, err = q.at(ctx, c, 0); err != nil {
+				return nil, err
+			}
+			pop, _, _ := q.popFront()
+			cmds = append(cmds, pop...)
+		}
+
+		// Reservations expire along with the queue.
+		res.Deliveries++
+		item := reserved{
+			id:         id,
+			visibleAt:  now + milliseconds(opts.Visibility),
+			deliveries: res.Deliveries,
+			value:      res.Value,
+		}
+		cmds = append(cmds, cmd("HSET", rkey, id, item.encode()))
+		if q.pttl > 0 {
+			cmds = append(cmds, cmd("PEXPIRE", rkey, q.pttl))
+		}
+
+		return cmds, nil
+	})
+	if err != nil {
+		return server.Reservation{}, err
+	}
+
+	if empty {
+		return server.Reservation{DeadLetters: res.DeadLetters}, errEmptyQueue(loc)
+	}
+
+	return res, nil
+}
+
+// LAck implements server.List.
+func (s *Store) LAck(ctx context.Context, loc *eventstore.LocationType, id string) error {
+	res, err := s.multi(ctx, cmd("TYPE", s.key(loc)), cmd("HDEL", s.reservedKey(loc), id))
+	if err != nil {
+		return err
+	}
+	if err := checkType(loc, res[0], typeQueue); err != nil {
+		return err
+	}
+
+	if n, _ := res[1].(int64); n == 0 {
+		return errReservationNotFound(loc, id)
+	}
+
+	return nil
+}
+
+// LNack implements server.List.
+func (s *Store) LNack(ctx context.Context, loc *eventstore.LocationType, id string) error {
+	key, rkey := s.key(loc), s.reservedKey(loc)
+
+	_, err := s.transactionAll(ctx, []string{key, rkey}, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeQueue); err != nil {
+			return nil, err
+		}
+
+		if r, err = c.do(ctx, "HGET", rkey, id); err != nil {
+			return nil, err
+		}
+		b, _ := r.([]byte)
+		if b == nil {
+			return nil, errReservationNotFound(loc, id)
+		}
+
+		item, err := decodeReserved(id, b)
+		if err != nil {
+			return nil, err
+		}
+		if item.visibleAt, err = serverTime(ctx, c); err != nil {
+			return nil, err
+		}
+
+		return [][]interface{}{cmd("HSET", rkey, id, item.encode())}, nil
+	})
+
+	return err
+}
+
+// serverTime returns the Redis clock in milliseconds, which
+// is shared by all the EventStore servers using it.
+func serverTime(ctx context.Context, c *conn) (int64, error) {
+	r, err := c.do(ctx, "TIME")
+	if err != nil {
+		return 0, err
+	}
+
+	return parseTime(r)
+}
+
+// parseTime parses TIME replies into milliseconds.
+func parseTime(r interface{}) (int64, error) {
+	reply, _ := r.([]interface{})
+	if len(reply) != 2 {
+		return 0, fmt.Errorf("unexpected TIME reply %v", r)
+	}
+	sec, _ := reply[0].([]byte)
+	usec, _ := reply[1].([]byte)
+
+	s, err := strconv.ParseInt(string(sec), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected TIME reply %v", r)
+	}
+	us, err := strconv.ParseInt(string(usec), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected TIME reply %v", r)
+	}
+
+	return s*1000 + us/1000, nil
+}
+
+// parseReserved decodes the HGETALL reply for reserved items, sorted
+// by the time they are visible again.
+func parseReserved(reply interface{}) ([]reserved, error) {
+	kvs, _ := reply.([]interface{})
+	items := make([]reserved, 0, len(kvs)/2)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		id, _ := kvs[i].([]byte)
+		v, _ := kvs[i+1].([]byte)
+
+		item, err := decodeReserved(string(id), v)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].visibleAt != items[j].visibleAt {
+			return items[i].visibleAt < items[j].visibleAt
+		}
+		return items[i].id < items[j].id
+	})
+
+	return items, nil
+}
+
+func errReservationNotFound(loc *eventstore.LocationType, id string) error {
+	return fmt.Errorf("reservation %q for queue %q: %w", id, loc.GetKey(), server.ErrNotFound)
+}
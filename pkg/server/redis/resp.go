@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// redisError is an error reply sent by the server. Error replies
+// are values when they are part of a transaction result.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// conn is a connection to a RESP server.
+type conn struct {
+	c  net.Conn
+	br *bufio.Reader
+	bw *bufio.Writer
+
+	// broken connections are discarded instead of
+	// being returned to the pool.
+	broken bool
+}
+
+// do sends a command and returns its reply. Error replies
+// are returned as errors.
+func (c *conn) do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	if dl, ok := ctx.Deadline(); ok {
+		_ = c.c.SetDeadline(dl)
+	} else {
+		_ = c.c.SetDeadline(time.Time{})
+	}
+
+	if err := writeCommand(c.bw, args); err != nil {
+		c.broken = true
+		return nil, err
+	}
+	if err := c.bw.Flush(); err != nil {
+		c.broken = true
+		return nil, err
+	}
+
+	r, err := readReply(c.br)
+	if err != nil {
+		c.broken = true
+		return nil, err
+	}
+
+	if rerr, ok := r.(redisError); ok {
+		return nil, rerr
+	}
+
+	return r, nil
+}
+
+// writeCommand writes the arguments as an array of bulk strings.
+func writeCommand(w *bufio.Writer, args []interface{}) error {
+	if _, err := fmt.Fprintf(w, "*%d\r\n", len(args)); err != nil {
+		return err
+	}
+
+	for _, a := range args {
+		var b []byte
+		switch v := a.(type) {
+		case string:
+			b = []byte(v)
+		case []byte:
+			b = v
+		case int:
+			b = strconv.AppendInt(nil, int64(v), 10)
+		case int64:
+			b = strconv.AppendInt(nil, v, 10)
+		default:
+			return fmt.Errorf("unsupported argument type %T", a)
+		}
+
+		if _, err := fmt.Fprintf(w, "$%d\r\n", len(b)); err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if _, err := w.WriteString("\r\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readReply reads a RESP value, which is returned as:
+//   - string for simple strings.
+//   - redisError for errors.
+//   - int64 for integers.
+//   - []byte for bulk strings, nil for the null bulk string.
+//   - []interface{} for arrays, nil for the null array.
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return string(line[1:]), nil
+
+	case '-':
+		return redisError(line[1:]), nil
+
+	case ':':
+		return strconv.ParseInt(string(line[1:]), 10, 64)
+
+	case '$':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return []byte(nil), nil
+		}
+
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+
+	case '*':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return []interface{}(nil), nil
+		}
+
+		a := make([]interface{}, n)
+		for i := range a {
+			if a[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	}
+
+	return nil, fmt.Errorf("unexpected reply type %q", line[0])
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, errors.New("malformed reply line")
+	}
+	return line[:len(line)-2], nil
+}
+
+// pool keeps idle connections for reuse.
+type pool struct {
+	dial    func(ctx context.Context) (*conn, error)
+	mu      sync.Mutex
+	idle    []*conn
+	maxIdle int
+	closed  bool
+}
+
+func (p *pool) get(ctx context.Context) (*conn, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, errors.New("connection pool is closed")
+	}
+	if n := len(p.idle); n > 0 {
+		c := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return c, nil
+	}
+	p.mu.Unlock()
+
+	return p.dial(ctx)
+}
+
+func (p *pool) put(c *conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if c.broken || p.closed || len(p.idle) >= p.maxIdle {
+		_ = c.c.Close()
+		return
+	}
+
+	p.idle = append(p.idle, c)
+}
+
+func (p *pool) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for _, c := range p.idle {
+		_ = c.c.Close()
+	}
+	p.idle = nil
+
+	return nil
+}
@@ -0,0 +1,464 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Queue items pushed with a priority or a delay are scheduled at a
+// companion sorted set, scored by the time in milliseconds when they are
+// visible. Members hold the priority, a sequence number taken from the
+// version counter, which keeps members unique and sorts items visible at
+// the same time, and the item. Times are taken from the Redis clock.
+//
+// Visible scheduled items with a positive priority are popped before the
+// items of the list, and the rest of them after the list. Items pushed
+// without priority or delay are also scheduled while there are visible
+// scheduled items with priority zero, so that they are popped after them.
+
+type scheduledItem struct {
+	priority  int
+	seq       int64
+	visibleAt int64
+	value     []byte
+}
+
+func (it scheduledItem) member() []byte {
+	b := []byte(strconv.Itoa(it.priority) + " " + strconv.FormatInt(it.seq, 10) + " ")
+	return append(b, it.value...)
+}
+
+// before reports whether the item is popped before o.
+func (it scheduledItem) before(o scheduledItem) bool {
+	if it.priority != o.priority {
+		return it.priority > o.priority
+	}
+	if it.visibleAt != o.visibleAt {
+		return it.visibleAt < o.visibleAt
+	}
+	return it.seq < o.seq
+}
+
+func decodeScheduled(member, score []byte) (scheduledItem, error) {
+	parts := bytes.SplitN(member, []byte(" "), 3)
+	if len(parts) != 3 {
+		return scheduledItem{}, fmt.Errorf("malformed scheduled item %q", member)
+	}
+
+	priority, err := strconv.Atoi(string(parts[0]))
+	if err != nil {
+		return scheduledItem{}, fmt.Errorf("malformed scheduled item %q: %w", member, err)
+	}
+	seq, err := strconv.ParseInt(string(parts[1]), 10, 64)
+	if err != nil {
+		return scheduledItem{}, fmt.Errorf("malformed scheduled item %q: %w", member, err)
+	}
+	visibleAt, err := strconv.ParseFloat(string(score), 64)
+	if err != nil {
+		return scheduledItem{}, fmt.Errorf("malformed scheduled item %q: %w", member, err)
+	}
+
+	return scheduledItem{
+		priority:  priority,
+		seq:       seq,
+		visibleAt: int64(visibleAt),
+		value:     parts[2],
+	}, nil
+}
+
+// queue is the state of a queue read by a transaction, which
+// is updated as the commands applied to it are planned.
+type queue struct {
+	loc      *eventstore.LocationType
+	key      string
+	skey     string
+	sentinel []byte
+	limit    server.QueueLimit
+	// pttl is the remaining TTL in milliseconds,
+	// not positive when the queue never expires.
+	pttl int64
+	// now is the Redis clock, read on demand.
+	now int64
+	// length is the number of list items.
+	length int
+	// scheduled is the number of scheduled items, including hidden ones.
+	scheduled int
+	// head and tail are the visible scheduled items popped
+	// before and after the list items respectively.
+	head []scheduledItem
+	tail []scheduledItem
+}
+
+// newQueue returns the state of an empty queue with the limit and TTL.
+func (s *Store) newQueue(loc *eventstore.LocationType, limit server.QueueLimit, ttl int64) *queue {
+	return &queue{
+		loc:      loc,
+		key:      s.key(loc),
+		skey:     s.scheduledKey(loc),
+		sentinel: []byte(sentinelFor(limit)),
+		limit:    limit,
+		pttl:     ttl,
+	}
+}
+
+// loadQueue reads the queue at the location. It must be called
+// within a transaction watching the queue and its scheduled items.
+func (s *Store) loadQueue(ctx context.Context, c *conn, loc *eventstore.LocationType) (*queue, error) {
+	r, err := c.do(ctx, "TYPE", s.key(loc))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, r, typeQueue); err != nil {
+		return nil, err
+	}
+
+	return s.readQueue(ctx, c, loc)
+}
+
+// readQueue works like loadQueue for keys known to hold a queue.
+func (s *Store) readQueue(ctx context.Context, c *conn, loc *eventstore.LocationType) (*queue, error) {
+	q := s.newQueue(loc, server.QueueLimit{}, 0)
+
+	r, err := c.do(ctx, "LINDEX", q.key, 0)
+	if err != nil {
+		return nil, err
+	}
+	q.sentinel, _ = r.([]byte)
+	q.limit = parseSentinel(q.sentinel)
+
+	if r, err = c.do(ctx, "LLEN", q.key); err != nil {
+		return nil, err
+	}
+	n, _ := r.(int64)
+	q.length = int(n) - 1
+
+	if r, err = c.do(ctx, "PTTL", q.key); err != nil {
+		return nil, err
+	}
+	q.pttl, _ = r.(int64)
+
+	if r, err = c.do(ctx, "ZRANGE", q.skey, 0, -1, "WITHSCORES"); err != nil {
+		return nil, err
+	}
+	reply, _ := r.([]interface{})
+	if len(reply) == 0 {
+		return q, nil
+	}
+
+	now, err := q.clock(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible []scheduledItem
+	for i := 0; i+1 < len(reply); i += 2 {
+		member, _ := reply[i].([]byte)
+		score, _ := reply[i+1].([]byte)
+
+		it, err := decodeScheduled(member, score)
+		if err != nil {
+			return nil, err
+		}
+		q.scheduled++
+
+		if it.visibleAt <= now {
+			visible = append(visible, it)
+		}
+	}
+
+	sort.Slice(visible, func(i, j int) bool {
+		return visible[i].before(visible[j])
+	})
+	split := sort.Search(len(visible), func(i int) bool {
+		return visible[i].priority <= 0
+	})
+	q.head, q.tail = visible[:split:split], visible[split:]
+
+	return q, nil
+}
+
+// clock returns the Redis clock in milliseconds, reading it once.
+func (q *queue) clock(ctx context.Context, c *conn) (int64, error) {
+	if q.now == 0 {
+		now, err := serverTime(ctx, c)
+		if err != nil {
+			return 0, err
+		}
+		q.now = now
+	}
+	return q.now, nil
+}
+
+// len returns the number of visible items.
+func (q *queue) len() int {
+	return len(q.head) + q.length + len(q.tail)
+}
+
+// due reports whether there are visible scheduled items with
+// priority zero, which are popped after the list items.
+func (q *queue) due() bool {
+	return len(q.tail) != 0 && q.tail[0].priority == 0
+}
+
+// at returns the visible item at the index, which must exist.
+func (q *queue) at(ctx context.Context, c *conn, i int) ([]byte, error) {
+	if i < len(q.head) {
+		return q.head[i].value, nil
+	}
+	if i -= len(q.head); i >= q.length {
+		return q.tail[i-q.length].value, nil
+	}
+
+	r, err := c.do(ctx, "LINDEX", q.key, i+1)
+	if err != nil {
+		return nil, err
+	}
+	v, _ := r.([]byte)
+	return v, nil
+}
+
+// items returns the visible items between the start and stop indexes,
+// which have already been resolved.
+func (q *queue) items(ctx context.Context, c *conn, start, stop int) ([][]byte, error) {
+	items := make([][]byte, 0, stop-start+1)
+	for i := start; i <= stop && i < len(q.head); i++ {
+		items = append(items, q.head[i].value)
+	}
+
+	if from, to := listSpan(q, start, stop); from <= to {
+		r, err := c.do(ctx, "LRANGE", q.key, from+1, to+1)
+		if err != nil {
+			return nil, err
+		}
+		values, _ := r.([]interface{})
+		for _, v := range values {
+			b, _ := v.([]byte)
+			items = append(items, b)
+		}
+	}
+
+	offset := len(q.head) + q.length
+	for i, it := range q.tail {
+		if offset+i >= start && offset+i <= stop {
+			items = append(items, it.value)
+		}
+	}
+
+	return items, nil
+}
+
+// popFront returns the commands that remove the first visible item,
+// which must exist. Scheduled items are also returned, while list
+// items are returned by the reply of the first command.
+func (q *queue) popFront() ([][]interface{}, []byte, bool) {
+	switch {
+	case len(q.head) != 0:
+		it := q.head[0]
+		q.head = q.head[1:]
+		return q.unschedule(it), it.value, true
+
+	case q.length != 0:
+		q.length--
+		return [][]interface{}{
+			cmd("LINDEX", q.key, 1),
+			cmd("LSET", q.key, 1, queuePopped),
+			cmd("LREM", q.key, 1, queuePopped),
+		}, nil, false
+
+	default:
+		it := q.tail[0]
+		q.tail = q.tail[1:]
+		return q.unschedule(it), it.value, true
+	}
+}
+
+// popBack works like popFront for the last visible item.
+func (q *queue) popBack() ([][]interface{}, []byte, bool) {
+	switch {
+	case len(q.tail) != 0:
+		it := q.tail[len(q.tail)-1]
+		q.tail = q.tail[:len(q.tail)-1]
+		return q.unschedule(it), it.value, true
+
+	case q.length != 0:
+		q.length--
+		return [][]interface{}{cmd("RPOP", q.key)}, nil, false
+
+	default:
+		it := q.head[len(q.head)-1]
+		q.head = q.head[:len(q.head)-1]
+		return q.unschedule(it), it.value, true
+	}
+}
+
+// trim returns the commands that remove the visible items out of
+// the start and stop indexes, which have already been resolved.
+func (q *queue) trim(start, stop int) [][]interface{} {
+	keep := func(i int) bool {
+		return i >= start && i <= stop
+	}
+
+	zrem := cmd("ZREM", q.skey)
+	head := q.head[:0]
+	for i, it := range q.head {
+		if keep(i) {
+			head = append(head, it)
+		} else {
+			zrem = append(zrem, it.member())
+		}
+	}
+
+	// The item before the range is kept and
+	// then replaced with the sentinel.
+	cmds := [][]interface{}{cmd("LTRIM", q.key, 0, 0)}
+	from, to := listSpan(q, start, stop)
+	if from <= to {
+		cmds = [][]interface{}{
+			cmd("LTRIM", q.key, from, to+1),
+			cmd("LSET", q.key, 0, q.sentinel),
+		}
+	}
+
+	offset := len(q.head) + q.length
+	tail := q.tail[:0]
+	for i, it := range q.tail {
+		if keep(offset + i) {
+			tail = append(tail, it)
+		} else {
+			zrem = append(zrem, it.member())
+		}
+	}
+
+	q.scheduled -= len(zrem) - 2
+	q.head, q.tail = head, tail
+	q.length = to - from + 1
+	if from > to {
+		q.length = 0
+	}
+
+	if len(zrem) > 2 {
+		cmds = append(cmds, zrem)
+	}
+	return cmds
+}
+
+// listSpan returns the indexes of the list items that are visible
+// between the start and stop indexes. From is greater than to when
+// there are no list items in between.
+func listSpan(q *queue, start, stop int) (int, int) {
+	from, to := start-len(q.head), stop-len(q.head)
+	if from < 0 {
+		from = 0
+	}
+	if to >= q.length {
+		to = q.length - 1
+	}
+	return from, to
+}
+
+// unschedule returns the commands that remove the scheduled item.
+func (q *queue) unschedule(it scheduledItem) [][]interface{} {
+	q.scheduled--
+	return [][]interface{}{cmd("ZREM", q.skey, it.member())}
+}
+
+// pushCmds returns the commands that add the value at the tail of the
+// queue, or at the head when front is true, enforcing its limit. Items
+// are inserted at the head by replacing the sentinel and pushing it again.
+func (s *Store) pushCmds(ctx context.Context, c *conn, q *queue, value []byte, opts server.PushOptions, front bool) ([][]interface{}, error) {
+	var cmds [][]interface{}
+	for q.limit.MaxLen > 0 && q.length+q.scheduled >= q.limit.MaxLen {
+		// hidden items are never dropped
+		if q.limit.Overflow != server.OverflowDropOldest || q.len() == 0 {
+			return nil, errQueueFull(q.loc)
+		}
+
+		var drop [][]interface{}
+		if front {
+			drop, _, _ = q.popBack()
+		} else {
+			drop, _, _ = q.popFront()
+		}
+		cmds = append(cmds, drop...)
+	}
+
+	switch {
+	case front:
+		q.length++
+		return append(cmds, cmd("LSET", q.key, 0, value), cmd("LPUSH", q.key, q.sentinel)), nil
+
+	case opts.Priority == 0 && opts.Delay <= 0 && !q.due():
+		q.length++
+		return append(cmds, cmd("RPUSH", q.key, value)), nil
+	}
+
+	r, err := c.do(ctx, "INCR", s.prefix+":version")
+	if err != nil {
+		return nil, err
+	}
+	now, err := q.clock(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	it := scheduledItem{
+		priority:  opts.Priority,
+		visibleAt: now + milliseconds(opts.Delay),
+		value:     value,
+	}
+	it.seq, _ = r.(int64)
+
+	q.scheduled++
+	if it.visibleAt <= now {
+		if it.priority > 0 {
+			q.head = insertScheduled(q.head, it)
+		} else {
+			q.tail = insertScheduled(q.tail, it)
+		}
+	}
+
+	// Scheduled items expire along with the queue.
+	cmds = append(cmds, cmd("ZADD", q.skey, it.visibleAt, it.member()))
+	if q.pttl > 0 {
+		cmds = append(cmds, cmd("PEXPIRE", q.skey, q.pttl))
+	}
+	return cmds, nil
+}
+
+// insertScheduled adds the item to the sorted items.
+func insertScheduled(items []scheduledItem, it scheduledItem) []scheduledItem {
+	i := sort.Search(len(items), func(i int) bool {
+		return it.before(items[i])
+	})
+
+	items = append(items, scheduledItem{})
+	copy(items[i+1:], items[i:])
+	items[i] = it
+	return items
+}
+
+func errQueueFull(loc *eventstore.LocationType) error {
+	return fmt.Errorf("queue %q: %w", loc.GetKey(), server.ErrQueueFull)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Scan implements server.Storage using the Redis SCAN cursor, which
+// might return fewer keys than requested, and keys that were added or
+// removed while scanning might be missing.
+func (s *Store) Scan(ctx context.Context, sc *eventstore.ScopeType, prefix, cursor string, count int) ([]server.KeyInfo, string, error) {
+	if cursor == "" {
+		cursor = "0"
+	}
+
+	ns := s.scopePrefix(sc) + "k:"
+	keys, cursor, err := s.scan(ctx, cursor, globEscape(ns+prefix)+"*", count)
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor == "0" {
+		cursor = ""
+	}
+	if len(keys) == 0 {
+		return nil, cursor, nil
+	}
+
+	cmds := make([][]interface{}, 0, 2*len(keys))
+	for _, k := range keys {
+		cmds = append(cmds, cmd("TYPE", k), cmd("PTTL", k))
+	}
+	res, err := s.multi(ctx, cmds...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	infos := make([]server.KeyInfo, 0, len(keys))
+	for i, k := range keys {
+		info := server.KeyInfo{Key: strings.TrimPrefix(k, ns)}
+
+		// Keys removed after being scanned are skipped.
+		switch t, _ := res[2*i].(string); t {
+		case typeKV:
+			info.Kind = server.KindKV
+		case typeMap:
+			info.Kind = server.KindMap
+		case typeQueue:
+			info.Kind = server.KindQueue
+		case typeSet:
+			info.Kind = server.KindSet
+		case typeZSet:
+			info.Kind = server.KindSortedSet
+		case typeStream:
+			info.Kind = server.KindStream
+		default:
+			continue
+		}
+
+		if ms, _ := res[2*i+1].(int64); ms > 0 {
+			info.TTL = time.Duration(ms) * time.Millisecond
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, cursor, nil
+}
+
+// DropScope implements server.Storage. Keys are read from the scope
+// index, along with the indexes of the instances of bridge scopes, and
+// removed in a single transaction that is retried when keys are added
+// to or removed from any of them meanwhile.
+func (s *Store) DropScope(ctx context.Context, sc *eventstore.ScopeType) ([]*eventstore.LocationType, error) {
+	watch := []string{s.indexKey(sc)}
+	if sc.GetType() == eventstore.ScopeChoice_Bridge {
+		watch = append(watch, s.instancesKey(sc))
+	}
+
+	var found []*eventstore.LocationType
+	res, err := s.transactionAll(ctx, watch, func(c *conn) ([][]interface{}, error) {
+		found = nil
+
+		scopes := []*eventstore.ScopeType{sc}
+		if sc.GetType() == eventstore.ScopeChoice_Bridge {
+			instances, err := smembers(ctx, c, s.instancesKey(sc))
+			if err != nil {
+				return nil, err
+			}
+			for _, instance := range instances {
+				scopes = append(scopes, &eventstore.ScopeType{
+					Type:     eventstore.ScopeChoice_Instance,
+					Bridge:   sc.GetBridge(),
+					Instance: instance,
+				})
+			}
+		}
+
+		var exists, del [][]interface{}
+		for _, isc := range scopes {
+			ikey := s.indexKey(isc)
+			if isc != sc {
+				if _, err := c.do(ctx, "WATCH", ikey); err != nil {
+					return nil, err
+				}
+			}
+
+			keys, err := smembers(ctx, c, ikey)
+			if err != nil {
+				return nil, err
+			}
+
+			// Data keys are removed along with their companion keys,
+			// and reported as dropped when they still exist.
+			ns := s.scopePrefix(isc)
+			d := cmd("DEL", ikey)
+			for _, k := range keys {
+				if !strings.HasPrefix(k, "k:") {
+					d = append(d, ns+k)
+					continue
+				}
+
+				loc := &eventstore.LocationType{Scope: isc, Key: k[2:]}
+				found = append(found, loc)
+				exists = append(exists, cmd("EXISTS", s.key(loc)))
+				d = append(d, s.key(loc), s.versionKey(loc), s.reservedKey(loc), s.scheduledKey(loc), s.offsetsKey(loc), s.fieldsKey(loc))
+			}
+			del = append(del, d)
+		}
+		if sc.GetType() == eventstore.ScopeChoice_Bridge {
+			del = append(del, cmd("DEL", s.instancesKey(sc)))
+		}
+
+		return append(exists, del...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dropped []*eventstore.LocationType
+	for i, loc := range found {
+		if n, _ := res[i].(int64); n != 0 {
+			dropped = append(dropped, loc)
+		}
+	}
+
+	return dropped, nil
+}
+
+// smembers returns the members of the set at the key.
+func smembers(ctx context.Context, c *conn, key string) ([]string, error) {
+	r, err := c.do(ctx, "SMEMBERS", key)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, _ := r.([]interface{})
+	members := make([]string, 0, len(rs))
+	for _, r := range rs {
+		m, _ := r.([]byte)
+		members = append(members, string(m))
+	}
+
+	return members, nil
+}
+
+// scan runs a single SCAN iteration and returns the
+// deduplicated keys along with the next cursor.
+func (s *Store) scan(ctx context.Context, cursor, pattern string, count int) ([]string, string, error) {
+	r, err := s.do(ctx, "SCAN", cursor, "MATCH", pattern, "COUNT", count)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reply, ok := r.([]interface{})
+	if !ok || len(reply) != 2 {
+		return nil, "", fmt.Errorf("unexpected SCAN reply %v", r)
+	}
+	next, _ := reply[0].([]byte)
+	found, _ := reply[1].([]interface{})
+
+	keys := make([]string, 0, len(found))
+	seen := make(map[string]struct{}, len(found))
+	for _, f := range found {
+		k, _ := f.([]byte)
+		if _, ok := seen[string(k)]; ok {
+			continue
+		}
+		seen[string(k)] = struct{}{}
+		keys = append(keys, string(k))
+	}
+
+	return keys, string(next), nil
+}
+
+// globEscape escapes the characters that SCAN patterns interpret.
+func globEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`\*?[]`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Semaphore permits and shared lock readers are stored at hashes whose
+// fields are the tokens of their holders. Values hold the time in
+// milliseconds when the holder expires, zero meaning never, and the
+// number of permits held. Times are taken from the Redis clock, and
+// hashes expire along with their last holder.
+
+type holder struct {
+	expireAt int64
+	permits  int
+}
+
+func (h holder) encode() string {
+	return strconv.FormatInt(h.expireAt, 10) + " " + strconv.Itoa(h.permits)
+}
+
+func decodeHolder(token string, b []byte) (holder, error) {
+	parts := bytes.SplitN(b, []byte(" "), 2)
+	if len(parts) != 2 {
+		return holder{}, fmt.Errorf("malformed holder %q", token)
+	}
+
+	expireAt, err := strconv.ParseInt(string(parts[0]), 10, 64)
+	if err != nil {
+		return holder{}, fmt.Errorf("malformed holder %q: %w", token, err)
+	}
+	permits, err := strconv.Atoi(string(parts[1]))
+	if err != nil {
+		return holder{}, fmt.Errorf("malformed holder %q: %w", token, err)
+	}
+
+	return holder{expireAt: expireAt, permits: permits}, nil
+}
+
+// holders stored at a hash, read within a transaction.
+type holders struct {
+	key     string
+	now     int64
+	live    map[string]holder
+	expired []interface{}
+}
+
+// readHolders reads the holders stored at the key, which
+// must be watched by the transaction.
+func readHolders(ctx context.Context, c *conn, key string) (*holders, error) {
+	r, err := c.do(ctx, "HGETALL", key)
+	if err != nil {
+		return nil, err
+	}
+	now, err := serverTime(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseHolders(key, r, now)
+}
+
+// parseHolders decodes the HGETALL reply for the holders at the key.
+func parseHolders(key string, reply interface{}, now int64) (*holders, error) {
+	hs := &holders{key: key, now: now, live: make(map[string]holder)}
+
+	kvs, _ := reply.([]interface{})
+	for i := 0; i+1 < len(kvs); i += 2 {
+		token, _ := kvs[i].([]byte)
+		v, _ := kvs[i+1].([]byte)
+
+		h, err := decodeHolder(string(token), v)
+		if err != nil {
+			return nil, err
+		}
+
+		if h.expireAt != 0 && h.expireAt <= now {
+			hs.expired = append(hs.expired, string(token))
+			continue
+		}
+		hs.live[string(token)] = h
+	}
+
+	return hs, nil
+}
+
+// permits returns the number of permits held.
+func (hs *holders) permits() int {
+	n := 0
+	for _, h := range hs.live {
+		n += h.permits
+	}
+	return n
+}
+
+// add returns the commands that store a new holder
+// and remove the expired ones.
+func (hs *holders) add(token string, permits int, timeout time.Duration) [][]interface{} {
+	h := holder{permits: permits}
+	if timeout > 0 {
+		h.expireAt = hs.now + milliseconds(timeout)
+	}
+	hs.live[token] = h
+
+	cmds := hs.purge()
+	cmds = append(cmds, cmd("HSET", hs.key, token, h.encode()))
+	return append(cmds, hs.expiry())
+}
+
+// remove returns the commands that remove the holder and the expired
+// ones, failing with ErrNotFound when there is no holder with the token.
+func (hs *holders) remove(loc *eventstore.LocationType, token string) ([][]interface{}, error) {
+	if _, ok := hs.live[token]; !ok {
+		return nil, fmt.Errorf("holder %q for key %q: %w", token, loc.GetKey(), server.ErrNotFound)
+	}
+	delete(hs.live, token)
+
+	if len(hs.live) == 0 {
+		return [][]interface{}{cmd("DEL", hs.key)}, nil
+	}
+
+	cmds := hs.purge()
+	cmds = append(cmds, cmd("HDEL", hs.key, token))
+	return append(cmds, hs.expiry()), nil
+}
+
+// purge returns the commands that remove expired holders.
+func (hs *holders) purge() [][]interface{} {
+	if len(hs.expired) == 0 {
+		return nil
+	}
+	return [][]interface{}{append(cmd("HDEL", hs.key), hs.expired...)}
+}
+
+// expiry returns the command that keeps the hash until the
+// last live holder expires.
+func (hs *holders) expiry() []interface{} {
+	var last int64
+	for _, h := range hs.live {
+		if h.expireAt == 0 {
+			return cmd("PERSIST", hs.key)
+		}
+		if h.expireAt > last {
+			last = h.expireAt
+		}
+	}
+	return cmd("PEXPIRE", hs.key, last-hs.now)
+}
+
+// Acquire implements server.Semaphore.
+func (s *Store) Acquire(ctx context.Context, loc *eventstore.LocationType, permits, limit int, timeout time.Duration) (string, error) {
+	key := s.semaphoreKey(loc)
+
+	token, err := newToken()
+	if err != nil {
+		return "", fmt.Errorf("generating release token: %w", err)
+	}
+
+	_, err = s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		hs, err := readHolders(ctx, c, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if held := hs.permits(); held+permits > limit {
+			return nil, fmt.Errorf("semaphore %q holds %d of %d permits: %w", loc.GetKey(), held, limit, server.ErrNoPermits)
+		}
+
+		return append(hs.add(token, permits, timeout), s.indexCmds(loc, key)...), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// Release implements server.Semaphore.
+func (s *Store) Release(ctx context.Context, loc *eventstore.LocationType, token string) error {
+	return s.releaseHolder(ctx, s.semaphoreKey(loc), loc, token)
+}
+
+// releaseHolder removes the holder with the token from the hash at the key.
+func (s *Store) releaseHolder(ctx context.Context, key string, loc *eventstore.LocationType, token string) error {
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		hs, err := readHolders(ctx, c, key)
+		if err != nil {
+			return nil, err
+		}
+
+		cmds, err := hs.remove(loc, token)
+		if err != nil || len(hs.live) != 0 {
+			return cmds, err
+		}
+		return append(cmds, s.unindexCmd(loc, key)), nil
+	})
+
+	return err
+}
@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// SNew implements server.Set.
+func (s *Store) SNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, errAlreadyExists(loc)
+		}
+
+		cmds := [][]interface{}{cmd("SADD", key, setSentinel)}
+		if exp := expireCmd(key, ttl); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, s.indexCmds(loc, key)...), nil
+	})
+
+	return err
+}
+
+// SAdd implements server.Set.
+func (s *Store) SAdd(ctx context.Context, loc *eventstore.LocationType, members []string) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeSet); err != nil {
+			return nil, err
+		}
+
+		return [][]interface{}{memberCmd("SADD", key, members)}, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	n, _ := res[0].(int64)
+	return int(n), nil
+}
+
+// SRem implements server.Set.
+func (s *Store) SRem(ctx context.Context, loc *eventstore.LocationType, members []string) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), memberCmd("SREM", key, members))
+	if err != nil {
+		return 0, err
+	}
+	if err := checkType(loc, res[0], typeSet); err != nil {
+		return 0, err
+	}
+
+	n, _ := res[1].(int64)
+	return int(n), nil
+}
+
+// SIsMember implements server.Set.
+func (s *Store) SIsMember(ctx context.Context, loc *eventstore.LocationType, member string) (bool, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("SISMEMBER", key, member))
+	if err != nil {
+		return false, err
+	}
+	if err := checkType(loc, res[0], typeSet); err != nil {
+		return false, err
+	}
+
+	n, _ := res[1].(int64)
+	return n == 1, nil
+}
+
+// SMembers implements server.Set.
+func (s *Store) SMembers(ctx context.Context, loc *eventstore.LocationType) ([]string, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("SMEMBERS", key))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeSet); err != nil {
+		return nil, err
+	}
+
+	rs, _ := res[1].([]interface{})
+	members := make([]string, 0, len(rs))
+	for _, r := range rs {
+		m, _ := r.([]byte)
+		if string(m) == setSentinel {
+			continue
+		}
+		members = append(members, string(m))
+	}
+
+	return members, nil
+}
+
+// SCard implements server.Set.
+func (s *Store) SCard(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("SCARD", key))
+	if err != nil {
+		return 0, err
+	}
+	if err := checkType(loc, res[0], typeSet); err != nil {
+		return 0, err
+	}
+
+	n, _ := res[1].(int64)
+	return int(n) - 1, nil
+}
+
+// SPop implements server.Set. SPOP could remove the sentinel, instead
+// two random members are read and the one that is not the sentinel
+// is removed.
+func (s *Store) SPop(ctx context.Context, loc *eventstore.LocationType) (string, error) {
+	key := s.key(loc)
+
+	var member string
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeSet); err != nil {
+			return nil, err
+		}
+
+		if r, err = c.do(ctx, "SRANDMEMBER", key, 2); err != nil {
+			return nil, err
+		}
+		rs, _ := r.([]interface{})
+		for _, r := range rs {
+			if m, _ := r.([]byte); string(m) != setSentinel {
+				member = string(m)
+				return [][]interface{}{cmd("SREM", key, member)}, nil
+			}
+		}
+
+		return nil, errEmptySet(loc)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return member, nil
+}
+
+// memberCmd returns the command that applies to all members.
+func memberCmd(name, key string, members []string) []interface{} {
+	args := make([]interface{}, 0, len(members)+2)
+	args = append(args, name, key)
+	for _, m := range members {
+		args = append(args, m)
+	}
+	return args
+}
+
+func errEmptySet(loc *eventstore.LocationType) error {
+	return fmt.Errorf("set %q is empty: %w", loc.GetKey(), server.ErrNotFound)
+}
@@ -0,0 +1,386 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Stream entries are stored at a Redis stream using the offset as the
+// entry ID, along with the time they were appended in milliseconds.
+// The stream retention is stored at a sentinel entry whose ID is lower
+// than any offset, and the offsets committed by consumer groups at a
+// companion hash.
+
+const (
+	// streamSentinel is the ID of the sentinel entry.
+	streamSentinel = "0-1"
+
+	// streamTrimCount is the number of entries read
+	// per iteration when looking for retired entries.
+	streamTrimCount = 100
+)
+
+// Fields of stream entries.
+const (
+	fieldTime   = "t"
+	fieldValue  = "v"
+	fieldMaxLen = "max-len"
+	fieldMaxAge = "max-age"
+)
+
+// streamEntry is a stream entry read from Redis.
+type streamEntry struct {
+	offset int64
+	fields map[string][]byte
+}
+
+// XNew implements server.Stream.
+func (s *Store) XNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration, retention server.StreamRetention) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, errAlreadyExists(loc)
+		}
+
+		// Offsets left by a previous stream at the key are removed.
+		cmds := [][]interface{}{
+			cmd("XADD", key, streamSentinel, fieldMaxLen, retention.MaxLen, fieldMaxAge, milliseconds(retention.MaxAge)),
+			cmd("DEL", s.offsetsKey(loc)),
+		}
+		if exp := expireCmd(key, ttl); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, s.indexCmds(loc, key)...), nil
+	})
+
+	return err
+}
+
+// XAdd implements server.Stream.
+func (s *Store) XAdd(ctx context.Context, loc *eventstore.LocationType, value []byte) (int64, error) {
+	key := s.key(loc)
+
+	var offset int64
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeStream); err != nil {
+			return nil, err
+		}
+		if r, err = c.do(ctx, "XRANGE", key, "-", "+", "COUNT", 1); err != nil {
+			return nil, err
+		}
+		retention, err := parseRetention(r)
+		if err != nil {
+			return nil, err
+		}
+		last, err := s.lastOffset(ctx, c, key)
+		if err != nil {
+			return nil, err
+		}
+		if r, err = c.do(ctx, "XLEN", key); err != nil {
+			return nil, err
+		}
+		n, _ := r.(int64)
+		now, err := serverTime(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		offset = last + 1
+		cmds := [][]interface{}{cmd("XADD", key, entryID(offset), fieldTime, now, fieldValue, value)}
+
+		// Entries are sorted by offset and time, which
+		// means that the ones to remove are at the head.
+		excess := 0
+		if retention.MaxLen > 0 {
+			excess = int(n) - retention.MaxLen
+		}
+		retired, err := s.retired(ctx, c, key, retention, now, excess)
+		if err != nil {
+			return nil, err
+		}
+		if len(retired) > 0 {
+			cmds = append(cmds, append(cmd("XDEL", key), retired...))
+		}
+
+		return cmds, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return offset, nil
+}
+
+// lastOffset returns the offset of the last appended entry, which is
+// never removed by the retention of the stream, or zero if none.
+func (s *Store) lastOffset(ctx context.Context, c *conn, key string) (int64, error) {
+	r, err := c.do(ctx, "XREVRANGE", key, "+", "-", "COUNT", 1)
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := parseStream(r)
+	if err != nil || len(last) != 1 {
+		return 0, fmt.Errorf("unexpected XREVRANGE reply %v", r)
+	}
+
+	return last[0].offset, nil
+}
+
+// retired returns the IDs of the entries at the head of the stream
+// that are out of its retention, including at least the informed
+// number of entries.
+func (s *Store) retired(ctx context.Context, c *conn, key string, retention server.StreamRetention, now int64, min int) ([]interface{}, error) {
+	if min <= 0 && retention.MaxAge <= 0 {
+		return nil, nil
+	}
+
+	var ids []interface{}
+	for from := int64(1); ; {
+		r, err := c.do(ctx, "XRANGE", key, entryID(from), "+", "COUNT", streamTrimCount)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := parseStream(r)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries {
+			if len(ids) >= min && !e.retired(retention, now) {
+				return ids, nil
+			}
+			ids = append(ids, entryID(e.offset))
+		}
+
+		if len(entries) < streamTrimCount {
+			return ids, nil
+		}
+		from = entries[len(entries)-1].offset + 1
+	}
+}
+
+// XRange implements server.Stream. Entries older than the maximum
+// age of the stream are skipped until they are removed by XAdd.
+func (s *Store) XRange(ctx context.Context, loc *eventstore.LocationType, offset int64, limit int) ([]server.StreamEntry, error) {
+	key := s.key(loc)
+
+	if offset < 1 {
+		offset = 1
+	}
+	count := []interface{}{}
+	if limit > 0 {
+		count = append(count, "COUNT", limit)
+	}
+
+	res, err := s.multi(ctx,
+		cmd("TYPE", key),
+		cmd("XRANGE", key, "-", "+", "COUNT", 1),
+		cmd("TIME"),
+		append(cmd("XRANGE", key, entryID(offset), "+"), count...))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeStream); err != nil {
+		return nil, err
+	}
+	retention, err := parseRetention(res[1])
+	if err != nil {
+		return nil, err
+	}
+	now, err := parseTime(res[2])
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []server.StreamEntry{}
+	for r := res[3]; ; {
+		page, err := parseStream(r)
+		if err != nil {
+			return nil, err
+		}
+
+		skipped := 0
+		for _, e := range page {
+			if e.retired(retention, now) {
+				skipped++
+				continue
+			}
+			ms, _ := strconv.ParseInt(string(e.fields[fieldTime]), 10, 64)
+			entries = append(entries, server.StreamEntry{
+				Offset: e.offset,
+				Value:  e.fields[fieldValue],
+				Time:   time.Unix(0, ms*int64(time.Millisecond)),
+			})
+		}
+
+		// Retired entries are replaced by the ones that follow,
+		// which is only needed when the page was full.
+		if skipped == 0 || limit == 0 || len(page) < limit {
+			return entries, nil
+		}
+
+		next := page[len(page)-1].offset + 1
+		if r, err = s.do(ctx, "XRANGE", key, entryID(next), "+", "COUNT", limit-len(entries)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// XCommit implements server.Stream. Committed offsets
+// expire along with the stream.
+func (s *Store) XCommit(ctx context.Context, loc *eventstore.LocationType, group string, offset int64) error {
+	key, okey := s.key(loc), s.offsetsKey(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeStream); err != nil {
+			return nil, err
+		}
+		last, err := s.lastOffset(ctx, c, key)
+		if err != nil {
+			return nil, err
+		}
+		if offset > last+1 {
+			return nil, errOffsetOutOfRange(loc, offset)
+		}
+		if r, err = c.do(ctx, "PTTL", key); err != nil {
+			return nil, err
+		}
+
+		cmds := [][]interface{}{cmd("HSET", okey, group, offset)}
+		if pttl, _ := r.(int64); pttl > 0 {
+			cmds = append(cmds, cmd("PEXPIRE", okey, pttl))
+		}
+		return cmds, nil
+	})
+
+	return err
+}
+
+// XOffset implements server.Stream.
+func (s *Store) XOffset(ctx context.Context, loc *eventstore.LocationType, group string) (int64, error) {
+	res, err := s.multi(ctx, cmd("TYPE", s.key(loc)), cmd("HGET", s.offsetsKey(loc), group))
+	if err != nil {
+		return 0, err
+	}
+	if err := checkType(loc, res[0], typeStream); err != nil {
+		return 0, err
+	}
+
+	b, _ := res[1].([]byte)
+	if b == nil {
+		return 0, nil
+	}
+
+	offset, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected offset %q for group %q at key %q", b, group, loc.GetKey())
+	}
+
+	return offset, nil
+}
+
+// retired reports whether the entry is older than the
+// maximum age of the stream, given the time in milliseconds.
+func (e streamEntry) retired(retention server.StreamRetention, now int64) bool {
+	if retention.MaxAge <= 0 {
+		return false
+	}
+	t, _ := strconv.ParseInt(string(e.fields[fieldTime]), 10, 64)
+	return now-t >= milliseconds(retention.MaxAge)
+}
+
+// entryID returns the stream entry ID for the offset.
+func entryID(offset int64) string {
+	return strconv.FormatInt(offset, 10) + "-0"
+}
+
+// parseStream parses XRANGE and XREVRANGE replies. The sentinel
+// entry is returned with a zero offset.
+func parseStream(reply interface{}) ([]streamEntry, error) {
+	items, ok := reply.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected stream reply %v", reply)
+	}
+
+	entries := make([]streamEntry, 0, len(items))
+	for _, item := range items {
+		parts, _ := item.([]interface{})
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("unexpected stream entry %v", item)
+		}
+		id, _ := parts[0].([]byte)
+		fields, _ := parts[1].([]interface{})
+
+		e := streamEntry{fields: make(map[string][]byte, len(fields)/2)}
+		if string(id) != streamSentinel {
+			ms := strings.SplitN(string(id), "-", 2)[0]
+			offset, err := strconv.ParseInt(ms, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected stream entry ID %q", id)
+			}
+			e.offset = offset
+		}
+		for i := 0; i+1 < len(fields); i += 2 {
+			f, _ := fields[i].([]byte)
+			v, _ := fields[i+1].([]byte)
+			e.fields[string(f)] = v
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// parseRetention parses the retention stored at the sentinel
+// entry, read as the first entry of the stream.
+func parseRetention(reply interface{}) (server.StreamRetention, error) {
+	entries, err := parseStream(reply)
+	if err != nil || len(entries) != 1 || entries[0].offset != 0 {
+		return server.StreamRetention{}, fmt.Errorf("unexpected stream sentinel %v", reply)
+	}
+
+	maxLen, _ := strconv.Atoi(string(entries[0].fields[fieldMaxLen]))
+	maxAge, _ := strconv.ParseInt(string(entries[0].fields[fieldMaxAge]), 10, 64)
+	return server.StreamRetention{MaxLen: maxLen, MaxAge: time.Duration(maxAge) * time.Millisecond}, nil
+}
+
+func errOffsetOutOfRange(loc *eventstore.LocationType, offset int64) error {
+	return fmt.Errorf("offset %d for stream %q is past the end of the stream: %w", offset, loc.GetKey(), server.ErrOutOfRange)
+}
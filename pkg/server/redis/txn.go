@@ -0,0 +1,485 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// Txn implements server.Storage.
+//
+// Redis does not roll back the commands of a transaction that fail, which
+// is why every key touched by the transaction is watched and read first,
+// and the operations are checked against that state before running their
+// commands atomically. The transaction is retried when any of the keys is
+// modified meanwhile.
+func (s *Store) Txn(ctx context.Context, guards []server.Guard, ops []server.Op) ([]server.OpResult, error) {
+	var versioned int
+	for _, op := range ops {
+		if op.Kind == server.OpSet || op.Kind == server.OpIncrBy {
+			versioned++
+		}
+	}
+
+	var version uint64
+	if versioned > 0 {
+		r, err := s.do(ctx, "INCRBY", s.prefix+":version", versioned)
+		if err != nil {
+			return nil, err
+		}
+		n, _ := r.(int64)
+		version = uint64(n) - uint64(versioned)
+	}
+
+	var watch []string
+	for _, g := range guards {
+		watch = append(watch, s.key(g.Location), s.versionKey(g.Location))
+	}
+	for _, op := range ops {
+		watch = append(watch, s.key(op.Location), s.versionKey(op.Location), s.scheduledKey(op.Location))
+	}
+
+	var (
+		results []server.OpResult
+		pops    map[int]int
+	)
+	res, err := s.transactionAll(ctx, watch, func(c *conn) ([][]interface{}, error) {
+		tx := &txState{
+			s:    s,
+			c:    c,
+			ctx:  ctx,
+			keys: make(map[string]*txKey),
+		}
+
+		for _, g := range guards {
+			if err := tx.check(g); err != nil {
+				return nil, err
+			}
+		}
+
+		results = make([]server.OpResult, len(ops))
+		pops = make(map[int]int)
+		v := version
+
+		var cmds [][]interface{}
+		for i, op := range ops {
+			if op.Kind == server.OpSet || op.Kind == server.OpIncrBy {
+				v++
+				results[i].Version = v
+			}
+
+			opCmds, err := tx.plan(op, &results[i])
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %w", i, err)
+			}
+
+			// values popped from lists are read from
+			// the reply of the first command.
+			if op.Kind == server.OpLPop && results[i].Value == nil {
+				pops[i] = len(cmds)
+			}
+			cmds = append(cmds, opCmds...)
+		}
+
+		return cmds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, idx := range pops {
+		results[i].Value, _ = res[idx].([]byte)
+	}
+
+	return results, nil
+}
+
+// txKey is the state of a key read by a transaction, which is
+// updated as the operations are planned.
+type txKey struct {
+	typ     string
+	value   []byte
+	version uint64
+	// pttl is the remaining TTL in milliseconds of KV
+	// entries, not positive when they never expire.
+	pttl int64
+	// fields of maps that have been read, all fields
+	// are known when the map is complete.
+	fields   map[string]txField
+	complete bool
+	// expiration of map fields.
+	mfields *mapFields
+	queue   *queue
+}
+
+type txField struct {
+	value  []byte
+	exists bool
+}
+
+// txState reads the keys of a transaction.
+type txState struct {
+	s    *Store
+	c    *conn
+	ctx  context.Context
+	keys map[string]*txKey
+}
+
+// load returns the state of the key at the location, reading
+// it the first time the location is accessed.
+func (tx *txState) load(loc *eventstore.LocationType) (*txKey, error) {
+	key := tx.s.key(loc)
+	if k, ok := tx.keys[key]; ok {
+		return k, nil
+	}
+
+	r, err := tx.c.do(tx.ctx, "TYPE", key)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &txKey{fields: make(map[string]txField)}
+	k.typ, _ = r.(string)
+
+	switch k.typ {
+	case typeNone:
+		k.complete = true
+
+	case typeKV:
+		if r, err = tx.c.do(tx.ctx, "GET", key); err != nil {
+			return nil, err
+		}
+		k.value, _ = r.([]byte)
+
+		if r, err = tx.c.do(tx.ctx, "GET", tx.s.versionKey(loc)); err != nil {
+			return nil, err
+		}
+		k.version = parseVersion(r)
+
+		if r, err = tx.c.do(tx.ctx, "PTTL", key); err != nil {
+			return nil, err
+		}
+		k.pttl, _ = r.(int64)
+
+	case typeMap:
+		if k.mfields, err = tx.s.readFields(tx.ctx, tx.c, loc); err != nil {
+			return nil, err
+		}
+		for f := range k.mfields.expired {
+			k.fields[f] = txField{}
+		}
+
+	case typeQueue:
+		if k.queue, err = tx.s.readQueue(tx.ctx, tx.c, loc); err != nil {
+			return nil, err
+		}
+	}
+
+	tx.keys[key] = k
+	return k, nil
+}
+
+// loadKind works like load, failing if the key does not exist
+// or holds a different kind of value.
+func (tx *txState) loadKind(loc *eventstore.LocationType, typ string) (*txKey, error) {
+	k, err := tx.load(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkType(loc, k.typ, typ); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// field returns the state of a field of the map at the location,
+// reading it the first time the field is accessed.
+func (tx *txState) field(loc *eventstore.LocationType, k *txKey, field string) (txField, error) {
+	if f, ok := k.fields[field]; ok || k.complete {
+		return f, nil
+	}
+
+	r, err := tx.c.do(tx.ctx, "HGET", tx.s.key(loc), field)
+	if err != nil {
+		return txField{}, err
+	}
+
+	v, _ := r.([]byte)
+	f := txField{value: v, exists: v != nil}
+	k.fields[field] = f
+
+	return f, nil
+}
+
+// check fails with server.ErrConflict if the guard does not hold.
+func (tx *txState) check(g server.Guard) error {
+	loc := g.Location
+
+	k, err := tx.load(loc)
+	if err != nil {
+		return err
+	}
+
+	switch g.Kind {
+	case server.GuardExists:
+		if k.typ == typeNone {
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		}
+
+	case server.GuardNotExists:
+		if k.typ != typeNone {
+			return fmt.Errorf("key %q exists: %w", loc.GetKey(), server.ErrConflict)
+		}
+
+	case server.GuardVersion:
+		switch {
+		case k.typ == typeNone:
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		case k.typ != typeKV:
+			return checkType(loc, k.typ, typeKV)
+		case k.version != g.Version:
+			return fmt.Errorf("key %q is at version %d, not %d: %w", loc.GetKey(), k.version, g.Version, server.ErrConflict)
+		}
+
+	case server.GuardField:
+		switch {
+		case k.typ == typeNone:
+			return fmt.Errorf("key %q does not exist: %w", loc.GetKey(), server.ErrConflict)
+		case k.typ != typeMap:
+			return checkType(loc, k.typ, typeMap)
+		}
+
+		f, err := tx.field(loc, k, g.Field)
+		if err != nil {
+			return err
+		}
+		if !f.exists || !bytes.Equal(f.value, g.Value) {
+			return fmt.Errorf("field %q at key %q does not hold the value: %w", g.Field, loc.GetKey(), server.ErrConflict)
+		}
+
+	default:
+		return fmt.Errorf("unknown guard %d", g.Kind)
+	}
+
+	return nil
+}
+
+// plan checks that the operation can be applied to the current state
+// of the key, updates it, and returns the commands for the operation.
+// Versions assigned to KV entries are informed at the result.
+func (tx *txState) plan(op server.Op, res *server.OpResult) ([][]interface{}, error) {
+	loc := op.Location
+	key := tx.s.key(loc)
+
+	switch op.Kind {
+	case server.OpSet:
+		k, err := tx.load(loc)
+		if err != nil {
+			return nil, err
+		}
+
+		*k = txKey{
+			typ:     typeKV,
+			value:   op.Value,
+			version: res.Version,
+			pttl:    milliseconds(op.TTL),
+		}
+
+		return tx.s.setCmds(loc, op.Value, op.TTL, res.Version), nil
+
+	case server.OpIncrBy:
+		k, err := tx.load(loc)
+		if err != nil {
+			return nil, err
+		}
+		if k.typ != typeNone && k.typ != typeKV {
+			return nil, checkType(loc, k.typ, typeKV)
+		}
+
+		n, err := addInt(k.value, op.Delta)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", loc.GetKey(), err)
+		}
+		k.typ = typeKV
+		k.value = []byte(strconv.FormatInt(n, 10))
+		k.version = res.Version
+		res.Number = n
+
+		setVersion := cmd("SET", tx.s.versionKey(loc), int64(res.Version))
+		if k.pttl > 0 {
+			setVersion = append(setVersion, "PX", k.pttl)
+		}
+
+		return append([][]interface{}{cmd("INCRBY", key, op.Delta), setVersion}, tx.s.indexCmds(loc, key)...), nil
+
+	case server.OpDel:
+		k, err := tx.load(loc)
+		if err != nil {
+			return nil, err
+		}
+		if k.typ == typeNone {
+			return nil, errNotFound(loc)
+		}
+
+		*k = txKey{
+			typ:      typeNone,
+			fields:   make(map[string]txField),
+			complete: true,
+		}
+
+		return [][]interface{}{
+			cmd("DEL", key),
+			cmd("DEL", tx.s.versionKey(loc), tx.s.reservedKey(loc), tx.s.scheduledKey(loc), tx.s.offsetsKey(loc), tx.s.fieldsKey(loc)),
+			tx.s.unindexCmd(loc, key),
+		}, nil
+
+	case server.OpHNew, server.OpLNew:
+		k, err := tx.load(loc)
+		if err != nil {
+			return nil, err
+		}
+		if k.typ != typeNone {
+			return nil, errAlreadyExists(loc)
+		}
+
+		cmds := [][]interface{}{cmd("HSET", key, mapSentinel, ""), cmd("DEL", tx.s.fieldsKey(loc))}
+		k.typ = typeMap
+		if op.Kind == server.OpHNew {
+			now, err := serverTime(tx.ctx, tx.c)
+			if err != nil {
+				return nil, err
+			}
+			k.mfields = &mapFields{
+				key:     key,
+				fkey:    tx.s.fieldsKey(loc),
+				now:     now,
+				pttl:    milliseconds(op.TTL),
+				expired: make(map[string]bool),
+			}
+		}
+		if op.Kind == server.OpLNew {
+			cmds = [][]interface{}{cmd("RPUSH", key, sentinelFor(op.Limit)), cmd("DEL", tx.s.reservedKey(loc), tx.s.scheduledKey(loc))}
+			k.typ = typeQueue
+			k.queue = tx.s.newQueue(loc, op.Limit, milliseconds(op.TTL))
+		}
+
+		if exp := expireCmd(key, op.TTL); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, tx.s.indexCmds(loc, key)...), nil
+
+	case server.OpHSet:
+		k, err := tx.loadKind(loc, typeMap)
+		if err != nil {
+			return nil, err
+		}
+		k.fields[op.Field] = txField{value: op.Value, exists: true}
+
+		cmds := append(k.mfields.purge(), cmd("HSET", key, op.Field, op.Value))
+		return append(cmds, k.mfields.expireField(op.Field, op.TTL)...), nil
+
+	case server.OpHDel:
+		k, err := tx.loadKind(loc, typeMap)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := tx.field(loc, k, op.Field)
+		if err != nil {
+			return nil, err
+		}
+		if !f.exists {
+			return nil, errFieldNotFound(loc, op.Field)
+		}
+		k.fields[op.Field] = txField{}
+
+		return append(k.mfields.purge(), cmd("HDEL", key, op.Field), cmd("ZREM", k.mfields.fkey, op.Field)), nil
+
+	case server.OpHIncrBy:
+		k, err := tx.loadKind(loc, typeMap)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := tx.field(loc, k, op.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := addInt(f.value, op.Delta)
+		if err != nil {
+			return nil, fmt.Errorf("field %q at key %q: %w", op.Field, loc.GetKey(), err)
+		}
+		k.fields[op.Field] = txField{value: []byte(strconv.FormatInt(n, 10)), exists: true}
+		res.Number = n
+
+		return append(k.mfields.purge(), cmd("HINCRBY", key, op.Field, op.Delta)), nil
+
+	case server.OpRPush:
+		k, err := tx.loadKind(loc, typeQueue)
+		if err != nil {
+			return nil, err
+		}
+
+		return tx.s.pushCmds(tx.ctx, tx.c, k.queue, op.Value, op.Push, false)
+
+	case server.OpLPop:
+		k, err := tx.loadKind(loc, typeQueue)
+		if err != nil {
+			return nil, err
+		}
+		if k.queue.len() == 0 {
+			return nil, errEmptyQueue(loc)
+		}
+
+		cmds, v, scheduled := k.queue.popFront()
+		if scheduled {
+			res.Value = v
+		}
+		return cmds, nil
+	}
+
+	return nil, fmt.Errorf("unknown operation %d", op.Kind)
+}
+
+// addInt parses the integer value and adds delta to it,
+// detecting the errors that Redis would reply with.
+func addInt(value []byte, delta int64) (int64, error) {
+	var v int64
+	if value != nil {
+		var err error
+		v, err = strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return 0, server.ErrNotInteger
+		}
+	}
+
+	if (delta > 0 && v > math.MaxInt64-delta) || (delta < 0 && v < math.MinInt64-delta) {
+		return 0, fmt.Errorf("increment would overflow: %w", server.ErrOutOfRange)
+	}
+
+	return v + delta, nil
+}
@@ -0,0 +1,260 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+)
+
+// ZNew implements server.SortedSet.
+func (s *Store) ZNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error {
+	key := s.key(loc)
+
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "EXISTS", key)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := r.(int64); n != 0 {
+			return nil, errAlreadyExists(loc)
+		}
+
+		cmds := [][]interface{}{cmd("ZADD", key, "-inf", zsetSentinel)}
+		if exp := expireCmd(key, ttl); exp != nil {
+			cmds = append(cmds, exp)
+		}
+		return append(cmds, s.indexCmds(loc, key)...), nil
+	})
+
+	return err
+}
+
+// ZAdd implements server.SortedSet.
+func (s *Store) ZAdd(ctx context.Context, loc *eventstore.LocationType, members []server.ScoredMember) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeZSet); err != nil {
+			return nil, err
+		}
+
+		add := make([]interface{}, 0, 2*len(members)+2)
+		add = append(add, "ZADD", key)
+		for _, m := range members {
+			add = append(add, formatFloat(m.Score), m.Member)
+		}
+		return [][]interface{}{add}, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	n, _ := res[0].(int64)
+	return int(n), nil
+}
+
+// ZIncrBy implements server.SortedSet. The resulting score is
+// checked before incrementing it, since Redis accepts infinite
+// scores.
+func (s *Store) ZIncrBy(ctx context.Context, loc *eventstore.LocationType, member string, delta float64) (float64, error) {
+	key := s.key(loc)
+
+	var score float64
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeZSet); err != nil {
+			return nil, err
+		}
+
+		if r, err = c.do(ctx, "ZSCORE", key, member); err != nil {
+			return nil, err
+		}
+		score = 0
+		if b, _ := r.([]byte); b != nil {
+			if score, err = parseFloat(b); err != nil {
+				return nil, err
+			}
+		}
+
+		score += delta
+		if math.IsNaN(score) || math.IsInf(score, 0) {
+			return nil, fmt.Errorf("increment would produce NaN or infinity: %w", server.ErrOutOfRange)
+		}
+
+		return [][]interface{}{cmd("ZADD", key, formatFloat(score), member)}, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return score, nil
+}
+
+// ZRangeByScore implements server.SortedSet.
+func (s *Store) ZRangeByScore(ctx context.Context, loc *eventstore.LocationType, min, max float64, limit int) ([]server.ScoredMember, error) {
+	key := s.key(loc)
+
+	rangeCmd := cmd("ZRANGEBYSCORE", key, formatScore(min), formatScore(max), "WITHSCORES")
+	if limit > 0 {
+		// one more member is requested in case the sentinel is in range
+		rangeCmd = append(rangeCmd, "LIMIT", 0, limit+1)
+	}
+
+	res, err := s.multi(ctx, cmd("TYPE", key), rangeCmd)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeZSet); err != nil {
+		return nil, err
+	}
+
+	members, err := parseScored(res[1])
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(members) > limit {
+		members = members[:limit]
+	}
+
+	return members, nil
+}
+
+// ZRange implements server.SortedSet. Positive ranks are
+// shifted to skip the sentinel.
+func (s *Store) ZRange(ctx context.Context, loc *eventstore.LocationType, start, stop int) ([]server.ScoredMember, error) {
+	key := s.key(loc)
+
+	if start >= 0 {
+		start++
+	}
+	if stop >= 0 {
+		stop++
+	}
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("ZRANGE", key, start, stop, "WITHSCORES"))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkType(loc, res[0], typeZSet); err != nil {
+		return nil, err
+	}
+
+	return parseScored(res[1])
+}
+
+// ZRemRangeByScore implements server.SortedSet. Members in range are
+// removed one by one, since ZREMRANGEBYSCORE could remove the sentinel.
+func (s *Store) ZRemRangeByScore(ctx context.Context, loc *eventstore.LocationType, min, max float64) ([]string, error) {
+	key := s.key(loc)
+
+	var removed []string
+	_, err := s.transaction(ctx, key, func(c *conn) ([][]interface{}, error) {
+		r, err := c.do(ctx, "TYPE", key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkType(loc, r, typeZSet); err != nil {
+			return nil, err
+		}
+
+		if r, err = c.do(ctx, "ZRANGEBYSCORE", key, formatScore(min), formatScore(max)); err != nil {
+			return nil, err
+		}
+
+		removed = []string{}
+		rs, _ := r.([]interface{})
+		for _, r := range rs {
+			if m, _ := r.([]byte); string(m) != zsetSentinel {
+				removed = append(removed, string(m))
+			}
+		}
+		if len(removed) == 0 {
+			return nil, nil
+		}
+
+		return [][]interface{}{memberCmd("ZREM", key, removed)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
+
+// ZCard implements server.SortedSet.
+func (s *Store) ZCard(ctx context.Context, loc *eventstore.LocationType) (int, error) {
+	key := s.key(loc)
+
+	res, err := s.multi(ctx, cmd("TYPE", key), cmd("ZCARD", key))
+	if err != nil {
+		return 0, err
+	}
+	if err := checkType(loc, res[0], typeZSet); err != nil {
+		return 0, err
+	}
+
+	n, _ := res[1].(int64)
+	return int(n) - 1, nil
+}
+
+// formatScore formats the ends of a score range,
+// which might be infinite.
+func formatScore(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	return formatFloat(f)
+}
+
+// parseScored returns the members of a WITHSCORES
+// reply, skipping the sentinel.
+func parseScored(reply interface{}) ([]server.ScoredMember, error) {
+	rs, _ := reply.([]interface{})
+	members := make([]server.ScoredMember, 0, len(rs)/2)
+	for i := 0; i+1 < len(rs); i += 2 {
+		m, _ := rs[i].([]byte)
+		if string(m) == zsetSentinel {
+			continue
+		}
+
+		b, _ := rs[i+1].([]byte)
+		score, err := strconv.ParseFloat(string(b), 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected score %q", b)
+		}
+
+		members = append(members, server.ScoredMember{Member: string(m), Score: score})
+	}
+	return members, nil
+}
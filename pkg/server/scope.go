@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+const (
+	defaultScanCount = 100
+	maxScanCount     = 1000
+)
+
+type scopeServer struct {
+	eventstore.UnimplementedScopeServer
+	store  Storage
+	events *hub
+}
+
+func (s *scopeServer) Scan(ctx context.Context, in *eventstore.ScanScopeRequest) (*eventstore.ScanScopeResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	count := int(in.Count)
+	switch {
+	case count == 0:
+		count = defaultScanCount
+	case count > maxScanCount:
+		count = maxScanCount
+	}
+
+	keys, cursor, err := s.store.Scan(ctx, in.Scope, in.Prefix, in.Cursor, count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &eventstore.ScanScopeResponse{
+		Keys:   make([]*eventstore.KeyInfo, 0, len(keys)),
+		Cursor: cursor,
+	}
+	for _, k := range keys {
+		res.Keys = append(res.Keys, &eventstore.KeyInfo{
+			Key:  k.Key,
+			Type: keyType(k.Kind),
+			Ttl:  ttlSeconds(k.TTL),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *scopeServer) Drop(ctx context.Context, in *eventstore.DropScopeRequest) (*eventstore.DropScopeResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	dropped, err := s.store.DropScope(ctx, in.Scope)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	for _, loc := range dropped {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, loc, "", nil)
+	}
+
+	return &eventstore.DropScopeResponse{Keys: int32(len(dropped))}, nil
+}
+
+func keyType(k Kind) eventstore.KeyType {
+	switch k {
+	case KindMap:
+		return eventstore.KeyType_TypeMap
+	case KindQueue:
+		return eventstore.KeyType_TypeQueue
+	case KindSet:
+		return eventstore.KeyType_TypeSet
+	case KindSortedSet:
+		return eventstore.KeyType_TypeSortedSet
+	case KindStream:
+		return eventstore.KeyType_TypeStream
+	}
+	return eventstore.KeyType_TypeKV
+}
+
+// ttlSeconds rounds the remaining TTL up, so that
+// keys about to expire are not reported as persistent.
+func ttlSeconds(ttl time.Duration) int32 {
+	if ttl <= 0 {
+		return 0
+	}
+	return int32((ttl + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,1470 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// Register the KV, Map, Queue, Sync, Watch, Scope, Batch and Txn services
+// backed by the storage driver at the gRPC server. Requests are validated
+// before reaching the driver.
+//
+// Watchers are notified of the changes made through the registered
+// services, expirations are only notified for storage drivers that
+// implement ExpiryNotifier.
+func Register(gs *grpc.Server, s Storage) {
+	events := newHub()
+	if n, ok := s.(ExpiryNotifier); ok {
+		n.NotifyExpired(events.expired)
+	}
+
+	kv := &kvServer{store: s, events: events}
+	m := &mapServer{store: s, events: events}
+	q := &queueServer{store: s, events: events}
+
+	eventstore.RegisterKVServer(gs, kv)
+	eventstore.RegisterMapServer(gs, m)
+	eventstore.RegisterQueueServer(gs, q)
+	eventstore.RegisterSetServer(gs, &setServer{store: s, events: events})
+	eventstore.RegisterSortedSetServer(gs, &sortedSetServer{store: s, events: events})
+	eventstore.RegisterStreamServer(gs, &streamServer{store: s, events: events})
+	eventstore.RegisterSyncServer(gs, &syncServer{store: s, events: events})
+	eventstore.RegisterWatchServer(gs, &watchServer{events: events})
+	eventstore.RegisterScopeServer(gs, &scopeServer{store: s, events: events})
+	eventstore.RegisterBatchServer(gs, &batchServer{kv: kv, m: m, q: q})
+	eventstore.RegisterTxnServer(gs, &txnServer{store: s, events: events})
+}
+
+type validator interface {
+	Validate() error
+}
+
+func validate(r validator) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func seconds(s int32) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// queueLimit returns the limit requested for a new queue.
+func queueLimit(in *eventstore.NewQueueRequest) QueueLimit {
+	limit := QueueLimit{MaxLen: int(in.MaxLen)}
+	if in.Overflow == eventstore.QueueOverflow_OverflowDropOldest {
+		limit.Overflow = OverflowDropOldest
+	}
+	return limit
+}
+
+// streamRetention returns the retention requested for a new stream.
+func streamRetention(in *eventstore.NewStreamRequest) StreamRetention {
+	return StreamRetention{MaxLen: int(in.MaxLen), MaxAge: seconds(in.MaxAge)}
+}
+
+// pushOptions returns the options informed when pushing to a queue.
+func pushOptions(in *eventstore.PushQueueRequest) PushOptions {
+	return PushOptions{Priority: int(in.Priority), Delay: seconds(in.Delay)}
+}
+
+// integer formats the result of increments the same
+// way numbers are stored.
+func integer(n int64) []byte {
+	return strconv.AppendInt(nil, n, 10)
+}
+
+// float formats the result of floating point increments.
+func float(f float64) []byte {
+	return strconv.AppendFloat(nil, f, 'f', -1, 64)
+}
+
+type kvServer struct {
+	eventstore.UnimplementedKVServer
+	store  Storage
+	events *hub
+}
+
+func (s *kvServer) Set(ctx context.Context, in *eventstore.SetKVRequest) (*eventstore.SetKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	version, err := s.store.Set(ctx, in.Location, in.Value, seconds(in.Ttl))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", in.Value)
+
+	return &eventstore.SetKVResponse{Version: version}, nil
+}
+
+func (s *kvServer) Incr(ctx context.Context, in *eventstore.IncrKVRequest) (*eventstore.IncrKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.IncrBy(ctx, in.Location, in.Incr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", integer(n))
+
+	return &eventstore.IncrKVResponse{Value: n}, nil
+}
+
+func (s *kvServer) Decr(ctx context.Context, in *eventstore.DecrKVRequest) (*eventstore.DecrKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.IncrBy(ctx, in.Location, -in.Decr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", integer(n))
+
+	return &eventstore.DecrKVResponse{Value: n}, nil
+}
+
+func (s *kvServer) IncrFloat(ctx context.Context, in *eventstore.IncrFloatKVRequest) (*eventstore.IncrFloatKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	f, err := s.store.IncrByFloat(ctx, in.Location, in.Incr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", float(f))
+
+	return &eventstore.IncrFloatKVResponse{Value: f}, nil
+}
+
+func (s *kvServer) Del(ctx context.Context, in *eventstore.DelKVRequest) (*eventstore.DelKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Del(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, in.Location, "", nil)
+
+	return &eventstore.DelKVResponse{}, nil
+}
+
+func (s *kvServer) Get(ctx context.Context, in *eventstore.GetKVRequest) (*eventstore.GetKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	v, version, err := s.store.Get(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.GetKVResponse{Value: v, Version: version}, nil
+}
+
+func (s *kvServer) CompareAndSwap(ctx context.Context, in *eventstore.CompareAndSwapKVRequest) (*eventstore.CompareAndSwapKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	version, err := s.store.CompareAndSwap(ctx, in.Location, in.Value, seconds(in.Ttl), in.Version)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", in.Value)
+
+	return &eventstore.CompareAndSwapKVResponse{Version: version}, nil
+}
+
+func (s *kvServer) SetIfNotExists(ctx context.Context, in *eventstore.SetIfNotExistsKVRequest) (*eventstore.SetIfNotExistsKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	version, err := s.store.SetNX(ctx, in.Location, in.Value, seconds(in.Ttl))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", in.Value)
+
+	return &eventstore.SetIfNotExistsKVResponse{Version: version}, nil
+}
+
+func (s *kvServer) SetIfExists(ctx context.Context, in *eventstore.SetIfExistsKVRequest) (*eventstore.SetIfExistsKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	version, err := s.store.SetXX(ctx, in.Location, in.Value, seconds(in.Ttl))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, in.Location, "", in.Value)
+
+	return &eventstore.SetIfExistsKVResponse{Version: version}, nil
+}
+
+func (s *kvServer) TTL(ctx context.Context, in *eventstore.TTLKVRequest) (*eventstore.TTLKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.store.TTL(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.TTLKVResponse{Ttl: ttlSeconds(ttl)}, nil
+}
+
+func (s *kvServer) Expire(ctx context.Context, in *eventstore.ExpireKVRequest) (*eventstore.ExpireKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Expire(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ExpireKVResponse{}, nil
+}
+
+func (s *kvServer) Persist(ctx context.Context, in *eventstore.PersistKVRequest) (*eventstore.PersistKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Persist(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.PersistKVResponse{}, nil
+}
+
+func (s *kvServer) MGet(ctx context.Context, in *eventstore.MGetKVRequest) (*eventstore.MGetKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	res := &eventstore.MGetKVResponse{
+		Items: make([]*eventstore.KVItem, 0, len(in.Keys)),
+	}
+	for _, k := range in.Keys {
+		item := &eventstore.KVItem{Key: k}
+
+		v, version, err := s.store.Get(ctx, &eventstore.LocationType{Scope: in.Scope, Key: k})
+		switch {
+		case err == nil:
+			item.Value, item.Version, item.Found = v, version, true
+		case !errors.Is(err, ErrNotFound):
+			return nil, toStatus(err)
+		}
+
+		res.Items = append(res.Items, item)
+	}
+
+	return res, nil
+}
+
+func (s *kvServer) MSet(ctx context.Context, in *eventstore.MSetKVRequest) (*eventstore.MSetKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	res := &eventstore.MSetKVResponse{
+		Versions: make([]uint64, 0, len(in.Entries)),
+	}
+	for _, e := range in.Entries {
+		loc := &eventstore.LocationType{Scope: in.Scope, Key: e.Key}
+
+		version, err := s.store.Set(ctx, loc, e.Value, seconds(e.Ttl))
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, loc, "", e.Value)
+
+		res.Versions = append(res.Versions, version)
+	}
+
+	return res, nil
+}
+
+func (s *kvServer) MDel(ctx context.Context, in *eventstore.MDelKVRequest) (*eventstore.MDelKVResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	var n int32
+	for _, k := range in.Keys {
+		loc := &eventstore.LocationType{Scope: in.Scope, Key: k}
+
+		err := s.store.Del(ctx, loc)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			continue
+		case err != nil:
+			return nil, toStatus(err)
+		}
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, loc, "", nil)
+
+		n++
+	}
+
+	return &eventstore.MDelKVResponse{Keys: n}, nil
+}
+
+func (s *kvServer) Lock(ctx context.Context, in *eventstore.LockRequest) (*eventstore.LockResponse, error) {
+	return lockHandler(ctx, s.store, s.events, in)
+}
+
+func (s *kvServer) Unlock(ctx context.Context, in *eventstore.UnlockRequest) (*eventstore.UnlockResponse, error) {
+	return unlockHandler(ctx, s.store, s.events, in)
+}
+
+type mapServer struct {
+	eventstore.UnimplementedMapServer
+	store  Storage
+	events *hub
+}
+
+func (s *mapServer) New(ctx context.Context, in *eventstore.NewMapRequest) (*eventstore.NewMapResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.HNew(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, in.Location, "", nil)
+
+	return &eventstore.NewMapResponse{}, nil
+}
+
+func (s *mapServer) GetFields(ctx context.Context, in *eventstore.GetAllMapFieldsRequest) (*eventstore.GetAllMapFieldsResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.store.HGetAll(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.GetAllMapFieldsResponse{Values: fields}, nil
+}
+
+func (s *mapServer) Len(ctx context.Context, in *eventstore.LenMapRequest) (*eventstore.LenMapResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	l, err := s.store.HLen(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.LenMapResponse{Len: int32(l)}, nil
+}
+
+func (s *mapServer) Del(ctx context.Context, in *eventstore.DelMapRequest) (*eventstore.DelMapResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Del(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, in.Location, "", nil)
+
+	return &eventstore.DelMapResponse{}, nil
+}
+
+func (s *mapServer) FieldSet(ctx context.Context, in *eventstore.SetMapFieldRequest) (*eventstore.SetMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.HSet(ctx, in.Location, in.Field, in.Value, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, in.Field, in.Value)
+
+	return &eventstore.SetMapFieldResponse{}, nil
+}
+
+func (s *mapServer) FieldSetIfNotExists(ctx context.Context, in *eventstore.SetIfNotExistsMapFieldRequest) (*eventstore.SetIfNotExistsMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.HSetNX(ctx, in.Location, in.Field, in.Value, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, in.Field, in.Value)
+
+	return &eventstore.SetIfNotExistsMapFieldResponse{}, nil
+}
+
+// FieldMSet stores all fields in a single transaction, so that
+// none of them is set when the map does not exist.
+func (s *mapServer) FieldMSet(ctx context.Context, in *eventstore.MSetMapFieldsRequest) (*eventstore.MSetMapFieldsResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ops := make([]Op, 0, len(in.Entries))
+	for _, e := range in.Entries {
+		ops = append(ops, Op{Kind: OpHSet, Location: in.Location, Field: e.Field, Value: e.Value, TTL: seconds(e.Ttl)})
+	}
+
+	if _, err := s.store.Txn(ctx, nil, ops); err != nil {
+		return nil, toStatus(err)
+	}
+	for _, e := range in.Entries {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, e.Field, e.Value)
+	}
+
+	return &eventstore.MSetMapFieldsResponse{}, nil
+}
+
+func (s *mapServer) FieldMGet(ctx context.Context, in *eventstore.MGetMapFieldsRequest) (*eventstore.MGetMapFieldsResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.store.HGetAll(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &eventstore.MGetMapFieldsResponse{
+		Items: make([]*eventstore.MapFieldItem, 0, len(in.Fields)),
+	}
+	for _, f := range in.Fields {
+		v, ok := fields[f]
+		res.Items = append(res.Items, &eventstore.MapFieldItem{Field: f, Value: v, Found: ok})
+	}
+
+	return res, nil
+}
+
+func (s *mapServer) FieldIncr(ctx context.Context, in *eventstore.IncrMapFieldRequest) (*eventstore.IncrMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.HIncrBy(ctx, in.Location, in.Field, in.Incr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, in.Field, integer(n))
+
+	return &eventstore.IncrMapFieldResponse{Value: n}, nil
+}
+
+func (s *mapServer) FieldDecr(ctx context.Context, in *eventstore.DecrMapFieldRequest) (*eventstore.DecrMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.HIncrBy(ctx, in.Location, in.Field, -in.Decr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, in.Field, integer(n))
+
+	return &eventstore.DecrMapFieldResponse{Value: n}, nil
+}
+
+func (s *mapServer) FieldIncrFloat(ctx context.Context, in *eventstore.IncrFloatMapFieldRequest) (*eventstore.IncrFloatMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	f, err := s.store.HIncrByFloat(ctx, in.Location, in.Field, in.Incr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, in.Field, float(f))
+
+	return &eventstore.IncrFloatMapFieldResponse{Value: f}, nil
+}
+
+func (s *mapServer) FieldDel(ctx context.Context, in *eventstore.DelMapFieldRequest) (*eventstore.DelMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.HDel(ctx, in.Location, in.Field); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_DELETE, in.Location, in.Field, nil)
+
+	return &eventstore.DelMapFieldResponse{}, nil
+}
+
+func (s *mapServer) FieldGet(ctx context.Context, in *eventstore.GetMapFieldRequest) (*eventstore.GetMapFieldResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	v, err := s.store.HGet(ctx, in.Location, in.Field)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.GetMapFieldResponse{Value: v}, nil
+}
+
+func (s *mapServer) TTL(ctx context.Context, in *eventstore.TTLMapRequest) (*eventstore.TTLMapResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.store.TTL(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.TTLMapResponse{Ttl: ttlSeconds(ttl)}, nil
+}
+
+func (s *mapServer) Expire(ctx context.Context, in *eventstore.ExpireMapRequest) (*eventstore.ExpireMapResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Expire(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ExpireMapResponse{}, nil
+}
+
+func (s *mapServer) Persist(ctx context.Context, in *eventstore.PersistMapRequest) (*eventstore.PersistMapResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Persist(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.PersistMapResponse{}, nil
+}
+
+func (s *mapServer) Lock(ctx context.Context, in *eventstore.LockRequest) (*eventstore.LockResponse, error) {
+	return lockHandler(ctx, s.store, s.events, in)
+}
+
+func (s *mapServer) Unlock(ctx context.Context, in *eventstore.UnlockRequest) (*eventstore.UnlockResponse, error) {
+	return unlockHandler(ctx, s.store, s.events, in)
+}
+
+type queueServer struct {
+	eventstore.UnimplementedQueueServer
+	store  Storage
+	events *hub
+}
+
+func (s *queueServer) New(ctx context.Context, in *eventstore.NewQueueRequest) (*eventstore.NewQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.LNew(ctx, in.Location, seconds(in.Ttl), queueLimit(in)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, in.Location, "", nil)
+
+	return &eventstore.NewQueueResponse{}, nil
+}
+
+func (s *queueServer) GetAll(ctx context.Context, in *eventstore.GetAllQueuesRequest) (*eventstore.GetAllQueuesResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	items, err := s.store.LRange(ctx, in.Location, 0, -1)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.GetAllQueuesResponse{Values: items}, nil
+}
+
+func (s *queueServer) Len(ctx context.Context, in *eventstore.LenQueueRequest) (*eventstore.LenQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	l, err := s.store.LLen(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.LenQueueResponse{Len: int32(l)}, nil
+}
+
+func (s *queueServer) Del(ctx context.Context, in *eventstore.DelQueueRequest) (*eventstore.DelQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Del(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, in.Location, "", nil)
+
+	return &eventstore.DelQueueResponse{}, nil
+}
+
+func (s *queueServer) Push(ctx context.Context, in *eventstore.PushQueueRequest) (*eventstore.PushQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.RPush(ctx, in.Location, in.Value, pushOptions(in)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH, in.Location, "", in.Value)
+
+	return &eventstore.PushQueueResponse{}, nil
+}
+
+func (s *queueServer) Index(ctx context.Context, in *eventstore.IndexQueueRequest) (*eventstore.IndexQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	v, err := s.store.LIndex(ctx, in.Location, int(in.Index))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.IndexQueueResponse{Value: v}, nil
+}
+
+func (s *queueServer) Pop(ctx context.Context, in *eventstore.PopQueueRequest) (*eventstore.PopQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	v, err := s.store.LPop(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_POP, in.Location, "", v)
+
+	return &eventstore.PopQueueResponse{Value: v}, nil
+}
+
+func (s *queueServer) Peek(ctx context.Context, in *eventstore.PeekQueueRequest) (*eventstore.PeekQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	items, err := s.store.LRange(ctx, in.Location, 0, 0)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if len(items) == 0 {
+		return nil, toStatus(fmt.Errorf("queue %q is empty: %w", in.Location.Key, ErrNotFound))
+	}
+
+	return &eventstore.PeekQueueResponse{Value: items[0]}, nil
+}
+
+func (s *queueServer) PushFront(ctx context.Context, in *eventstore.PushFrontQueueRequest) (*eventstore.PushFrontQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.LPush(ctx, in.Location, in.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH, in.Location, "", in.Value)
+
+	return &eventstore.PushFrontQueueResponse{}, nil
+}
+
+func (s *queueServer) PopBack(ctx context.Context, in *eventstore.PopBackQueueRequest) (*eventstore.PopBackQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	v, err := s.store.RPop(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_POP, in.Location, "", v)
+
+	return &eventstore.PopBackQueueResponse{Value: v}, nil
+}
+
+func (s *queueServer) Range(ctx context.Context, in *eventstore.RangeQueueRequest) (*eventstore.RangeQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	items, err := s.store.LRange(ctx, in.Location, int(in.Start), int(in.Stop))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.RangeQueueResponse{Values: items}, nil
+}
+
+func (s *queueServer) Trim(ctx context.Context, in *eventstore.TrimQueueRequest) (*eventstore.TrimQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.LTrim(ctx, in.Location, int(in.Start), int(in.Stop)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_TRIM, in.Location, fmt.Sprintf("%d:%d", in.Start, in.Stop), nil)
+
+	return &eventstore.TrimQueueResponse{}, nil
+}
+
+func (s *queueServer) Reserve(ctx context.Context, in *eventstore.ReserveQueueRequest) (*eventstore.ReserveQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	opts := ReserveOptions{
+		Visibility:    seconds(in.Visibility),
+		MaxDeliveries: int(in.MaxDeliveries),
+	}
+	if in.DeadLetter != "" {
+		opts.DeadLetter = &eventstore.LocationType{Scope: in.Location.Scope, Key: in.DeadLetter}
+	}
+
+	res, err := s.store.LReserve(ctx, in.Location, opts)
+	if opts.DeadLetter != nil {
+		for _, v := range res.DeadLetters {
+			s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH, opts.DeadLetter, "", v)
+		}
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// Only the first delivery removes the item from the queue.
+	if res.Deliveries == 1 {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_POP, in.Location, "", res.Value)
+	}
+
+	return &eventstore.ReserveQueueResponse{
+		Id:         res.ID,
+		Value:      res.Value,
+		Deliveries: int32(res.Deliveries),
+	}, nil
+}
+
+func (s *queueServer) Ack(ctx context.Context, in *eventstore.AckQueueRequest) (*eventstore.AckQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.LAck(ctx, in.Location, in.Id); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.AckQueueResponse{}, nil
+}
+
+func (s *queueServer) Nack(ctx context.Context, in *eventstore.NackQueueRequest) (*eventstore.NackQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.LNack(ctx, in.Location, in.Id); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.NackQueueResponse{}, nil
+}
+
+func (s *queueServer) TTL(ctx context.Context, in *eventstore.TTLQueueRequest) (*eventstore.TTLQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.store.TTL(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.TTLQueueResponse{Ttl: ttlSeconds(ttl)}, nil
+}
+
+func (s *queueServer) Expire(ctx context.Context, in *eventstore.ExpireQueueRequest) (*eventstore.ExpireQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Expire(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ExpireQueueResponse{}, nil
+}
+
+func (s *queueServer) Persist(ctx context.Context, in *eventstore.PersistQueueRequest) (*eventstore.PersistQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Persist(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.PersistQueueResponse{}, nil
+}
+
+type setServer struct {
+	eventstore.UnimplementedSetServer
+	store  Storage
+	events *hub
+}
+
+func (s *setServer) New(ctx context.Context, in *eventstore.NewSetRequest) (*eventstore.NewSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.SNew(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, in.Location, "", nil)
+
+	return &eventstore.NewSetResponse{}, nil
+}
+
+func (s *setServer) Del(ctx context.Context, in *eventstore.DelSetRequest) (*eventstore.DelSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Del(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, in.Location, "", nil)
+
+	return &eventstore.DelSetResponse{}, nil
+}
+
+func (s *setServer) Add(ctx context.Context, in *eventstore.AddSetRequest) (*eventstore.AddSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.SAdd(ctx, in.Location, in.Members)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, m := range in.Members {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, m, nil)
+	}
+
+	return &eventstore.AddSetResponse{Added: int32(n)}, nil
+}
+
+func (s *setServer) Remove(ctx context.Context, in *eventstore.RemoveSetRequest) (*eventstore.RemoveSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.SRem(ctx, in.Location, in.Members)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, m := range in.Members {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_DELETE, in.Location, m, nil)
+	}
+
+	return &eventstore.RemoveSetResponse{Removed: int32(n)}, nil
+}
+
+func (s *setServer) IsMember(ctx context.Context, in *eventstore.IsMemberSetRequest) (*eventstore.IsMemberSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.store.SIsMember(ctx, in.Location, in.Member)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.IsMemberSetResponse{IsMember: ok}, nil
+}
+
+func (s *setServer) Members(ctx context.Context, in *eventstore.MembersSetRequest) (*eventstore.MembersSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.SMembers(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.MembersSetResponse{Members: members}, nil
+}
+
+func (s *setServer) Card(ctx context.Context, in *eventstore.CardSetRequest) (*eventstore.CardSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.SCard(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.CardSetResponse{Card: int32(n)}, nil
+}
+
+func (s *setServer) Pop(ctx context.Context, in *eventstore.PopSetRequest) (*eventstore.PopSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	m, err := s.store.SPop(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_POP, in.Location, m, nil)
+
+	return &eventstore.PopSetResponse{Member: m}, nil
+}
+
+func (s *setServer) TTL(ctx context.Context, in *eventstore.TTLSetRequest) (*eventstore.TTLSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.store.TTL(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.TTLSetResponse{Ttl: ttlSeconds(ttl)}, nil
+}
+
+func (s *setServer) Expire(ctx context.Context, in *eventstore.ExpireSetRequest) (*eventstore.ExpireSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Expire(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ExpireSetResponse{}, nil
+}
+
+func (s *setServer) Persist(ctx context.Context, in *eventstore.PersistSetRequest) (*eventstore.PersistSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Persist(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.PersistSetResponse{}, nil
+}
+
+type sortedSetServer struct {
+	eventstore.UnimplementedSortedSetServer
+	store  Storage
+	events *hub
+}
+
+func (s *sortedSetServer) New(ctx context.Context, in *eventstore.NewSortedSetRequest) (*eventstore.NewSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.ZNew(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, in.Location, "", nil)
+
+	return &eventstore.NewSortedSetResponse{}, nil
+}
+
+func (s *sortedSetServer) Del(ctx context.Context, in *eventstore.DelSortedSetRequest) (*eventstore.DelSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Del(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, in.Location, "", nil)
+
+	return &eventstore.DelSortedSetResponse{}, nil
+}
+
+func (s *sortedSetServer) Add(ctx context.Context, in *eventstore.AddSortedSetRequest) (*eventstore.AddSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	members := make([]ScoredMember, 0, len(in.Members))
+	for _, m := range in.Members {
+		members = append(members, ScoredMember{Member: m.Member, Score: m.Score})
+	}
+
+	n, err := s.store.ZAdd(ctx, in.Location, members)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, m := range members {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, m.Member, float(m.Score))
+	}
+
+	return &eventstore.AddSortedSetResponse{Added: int32(n)}, nil
+}
+
+func (s *sortedSetServer) Incr(ctx context.Context, in *eventstore.IncrSortedSetRequest) (*eventstore.IncrSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	score, err := s.store.ZIncrBy(ctx, in.Location, in.Member, in.Incr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, in.Location, in.Member, float(score))
+
+	return &eventstore.IncrSortedSetResponse{Score: score}, nil
+}
+
+func (s *sortedSetServer) RangeByScore(ctx context.Context, in *eventstore.RangeByScoreSortedSetRequest) (*eventstore.RangeByScoreSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.ZRangeByScore(ctx, in.Location, in.Min, in.Max, int(in.Limit))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.RangeByScoreSortedSetResponse{Members: scoredMembers(members)}, nil
+}
+
+func (s *sortedSetServer) RangeByRank(ctx context.Context, in *eventstore.RangeByRankSortedSetRequest) (*eventstore.RangeByRankSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.ZRange(ctx, in.Location, int(in.Start), int(in.Stop))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.RangeByRankSortedSetResponse{Members: scoredMembers(members)}, nil
+}
+
+func (s *sortedSetServer) RemoveRangeByScore(ctx context.Context, in *eventstore.RemoveRangeByScoreSortedSetRequest) (*eventstore.RemoveRangeByScoreSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	removed, err := s.store.ZRemRangeByScore(ctx, in.Location, in.Min, in.Max)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, m := range removed {
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_DELETE, in.Location, m, nil)
+	}
+
+	return &eventstore.RemoveRangeByScoreSortedSetResponse{Removed: int32(len(removed))}, nil
+}
+
+func (s *sortedSetServer) Card(ctx context.Context, in *eventstore.CardSortedSetRequest) (*eventstore.CardSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.ZCard(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.CardSortedSetResponse{Card: int32(n)}, nil
+}
+
+func (s *sortedSetServer) TTL(ctx context.Context, in *eventstore.TTLSortedSetRequest) (*eventstore.TTLSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.store.TTL(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.TTLSortedSetResponse{Ttl: ttlSeconds(ttl)}, nil
+}
+
+func (s *sortedSetServer) Expire(ctx context.Context, in *eventstore.ExpireSortedSetRequest) (*eventstore.ExpireSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Expire(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ExpireSortedSetResponse{}, nil
+}
+
+func (s *sortedSetServer) Persist(ctx context.Context, in *eventstore.PersistSortedSetRequest) (*eventstore.PersistSortedSetResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Persist(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.PersistSortedSetResponse{}, nil
+}
+
+// scoredMembers converts sorted set members to their protobuf form.
+func scoredMembers(members []ScoredMember) []*eventstore.ScoredMember {
+	res := make([]*eventstore.ScoredMember, 0, len(members))
+	for _, m := range members {
+		res = append(res, &eventstore.ScoredMember{Member: m.Member, Score: m.Score})
+	}
+	return res
+}
+
+type streamServer struct {
+	eventstore.UnimplementedStreamServer
+	store  Storage
+	events *hub
+}
+
+func (s *streamServer) New(ctx context.Context, in *eventstore.NewStreamRequest) (*eventstore.NewStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.XNew(ctx, in.Location, seconds(in.Ttl), streamRetention(in)); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, in.Location, "", nil)
+
+	return &eventstore.NewStreamResponse{}, nil
+}
+
+func (s *streamServer) Del(ctx context.Context, in *eventstore.DelStreamRequest) (*eventstore.DelStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Del(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, in.Location, "", nil)
+
+	return &eventstore.DelStreamResponse{}, nil
+}
+
+func (s *streamServer) Append(ctx context.Context, in *eventstore.AppendStreamRequest) (*eventstore.AppendStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	offset, err := s.store.XAdd(ctx, in.Location, in.Value)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH, in.Location, strconv.FormatInt(offset, 10), in.Value)
+
+	return &eventstore.AppendStreamResponse{Offset: offset}, nil
+}
+
+func (s *streamServer) Read(ctx context.Context, in *eventstore.ReadStreamRequest) (*eventstore.ReadStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.store.XRange(ctx, in.Location, in.Offset, int(in.Limit))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := make([]*eventstore.StreamEntry, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, &eventstore.StreamEntry{
+			Offset: e.Offset,
+			Value:  e.Value,
+			Time:   e.Time.UnixNano() / int64(time.Millisecond),
+		})
+	}
+
+	return &eventstore.ReadStreamResponse{Entries: res}, nil
+}
+
+func (s *streamServer) Commit(ctx context.Context, in *eventstore.CommitStreamRequest) (*eventstore.CommitStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.XCommit(ctx, in.Location, in.Group, in.Offset); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.CommitStreamResponse{}, nil
+}
+
+func (s *streamServer) Offset(ctx context.Context, in *eventstore.OffsetStreamRequest) (*eventstore.OffsetStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	offset, err := s.store.XOffset(ctx, in.Location, in.Group)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.OffsetStreamResponse{Offset: offset}, nil
+}
+
+func (s *streamServer) TTL(ctx context.Context, in *eventstore.TTLStreamRequest) (*eventstore.TTLStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.store.TTL(ctx, in.Location)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.TTLStreamResponse{Ttl: ttlSeconds(ttl)}, nil
+}
+
+func (s *streamServer) Expire(ctx context.Context, in *eventstore.ExpireStreamRequest) (*eventstore.ExpireStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Expire(ctx, in.Location, seconds(in.Ttl)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ExpireStreamResponse{}, nil
+}
+
+func (s *streamServer) Persist(ctx context.Context, in *eventstore.PersistStreamRequest) (*eventstore.PersistStreamResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Persist(ctx, in.Location); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.PersistStreamResponse{}, nil
+}
+
+type syncServer struct {
+	eventstore.UnimplementedSyncServer
+	store  Storage
+	events *hub
+}
+
+func (s *syncServer) Lock(ctx context.Context, in *eventstore.LockRequest) (*eventstore.LockResponse, error) {
+	return lockHandler(ctx, s.store, s.events, in)
+}
+
+func (s *syncServer) Unlock(ctx context.Context, in *eventstore.UnlockRequest) (*eventstore.UnlockResponse, error) {
+	return unlockHandler(ctx, s.store, s.events, in)
+}
+
+func (s *syncServer) RefreshLock(ctx context.Context, in *eventstore.RefreshLockRequest) (*eventstore.RefreshLockResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.RefreshLock(ctx, in.Location, in.Unlock, seconds(in.Timeout)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.RefreshLockResponse{}, nil
+}
+
+// LockInfo informs unlocked keys as not locked instead of failing.
+func (s *syncServer) LockInfo(ctx context.Context, in *eventstore.LockInfoRequest) (*eventstore.LockInfoResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	lease, err := s.store.LockInfo(ctx, in.Location)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return &eventstore.LockInfoResponse{}, nil
+	case err != nil:
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.LockInfoResponse{
+		Locked:       lease.Token != "",
+		Owner:        lease.Owner,
+		Ttl:          ttlSeconds(lease.TTL),
+		FencingToken: lease.FencingToken,
+		Held:         in.Unlock != "" && in.Unlock == lease.Token,
+		Readers:      int32(lease.Readers),
+	}, nil
+}
+
+func (s *syncServer) RLock(ctx context.Context, in *eventstore.RLockRequest) (*eventstore.RLockResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	token, err := s.store.RLock(ctx, in.Location, seconds(in.Timeout))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.RLockResponse{Unlock: token}, nil
+}
+
+// RUnlock wakes up contenders waiting for the exclusive lock.
+func (s *syncServer) RUnlock(ctx context.Context, in *eventstore.RUnlockRequest) (*eventstore.RUnlockResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.RUnlock(ctx, in.Location, in.Unlock); err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.lockers.notify(locationKey(in.Location))
+
+	return &eventstore.RUnlockResponse{}, nil
+}
+
+func (s *syncServer) Acquire(ctx context.Context, in *eventstore.AcquireRequest) (*eventstore.AcquireResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	token, err := s.store.Acquire(ctx, in.Location, int(in.Permits), int(in.Limit), seconds(in.Timeout))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.AcquireResponse{Release: token}, nil
+}
+
+func (s *syncServer) Release(ctx context.Context, in *eventstore.ReleaseRequest) (*eventstore.ReleaseResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Release(ctx, in.Location, in.Release); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.ReleaseResponse{}, nil
+}
+
+// lockHandler serves lock requests for the KV, Map and Sync services,
+// which share a single lock namespace per scope.
+func lockHandler(ctx context.Context, s Storage, events *hub, in *eventstore.LockRequest) (*eventstore.LockResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	var lease Lease
+	var err error
+	if in.Wait > 0 {
+		lease, err = waitLock(ctx, s, events, in)
+	} else {
+		lease, err = s.Lock(ctx, in.Location, seconds(in.Timeout), in.Owner)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &eventstore.LockResponse{Unlock: lease.Token, FencingToken: lease.FencingToken}, nil
+}
+
+func unlockHandler(ctx context.Context, s Storage, events *hub, in *eventstore.UnlockRequest) (*eventstore.UnlockResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	if err := s.Unlock(ctx, in.Location, in.Unlock); err != nil {
+		return nil, toStatus(err)
+	}
+	events.lockers.notify(locationKey(in.Location))
+
+	return &eventstore.UnlockResponse{}, nil
+}
+
+// toStatus translates storage driver errors into gRPC status errors.
+func toStatus(err error) error {
+	var code codes.Code
+	switch {
+	case errors.Is(err, ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, ErrAlreadyExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, ErrWrongKind), errors.Is(err, ErrNotInteger), errors.Is(err, ErrNotFloat), errors.Is(err, ErrLocked):
+		code = codes.FailedPrecondition
+	case errors.Is(err, ErrOutOfRange):
+		code = codes.OutOfRange
+	case errors.Is(err, ErrUnlockMismatch):
+		code = codes.PermissionDenied
+	case errors.Is(err, ErrConflict):
+		code = codes.Aborted
+	case errors.Is(err, ErrQueueFull), errors.Is(err, ErrNoPermits):
+		code = codes.ResourceExhausted
+	default:
+		return err
+	}
+
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,454 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+	"github.com/triggermesh/eventstore/pkg/server"
+	"github.com/triggermesh/eventstore/pkg/server/memory"
+)
+
+var tValue = []byte("test-value")
+
+func instanceLocation(key string) *eventstore.LocationType {
+	return &eventstore.LocationType{
+		Scope: &eventstore.ScopeType{
+			Type:     eventstore.ScopeChoice_Instance,
+			Bridge:   "test-bridge",
+			Instance: "test-instance",
+		},
+		Key: key,
+	}
+}
+
+func assertCode(t *testing.T, expected codes.Code, err error) {
+	t.Helper()
+	assert.Equal(t, expected, status.Code(err), "unexpected error %v", err)
+}
+
+func newTestConn(t *testing.T) *grpc.ClientConn {
+	s := memory.New()
+	t.Cleanup(func() { _ = s.Close() })
+
+	lis := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer()
+	server.Register(gs, s)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestServer(t *testing.T) {
+	conn := newTestConn(t)
+	ctx := context.Background()
+	loc := instanceLocation("test-key")
+
+	kv := eventstore.NewKVClient(conn)
+	_, err := kv.Set(ctx, &eventstore.SetKVRequest{Location: loc, Value: tValue, Ttl: 60})
+	require.NoError(t, err)
+	res, err := kv.Get(ctx, &eventstore.GetKVRequest{Location: loc})
+	require.NoError(t, err)
+	assert.Equal(t, tValue, res.Value)
+
+	_, err = kv.Set(ctx, &eventstore.SetKVRequest{Location: loc, Ttl: -1})
+	assertCode(t, codes.InvalidArgument, err)
+
+	incr, err := kv.Incr(ctx, &eventstore.IncrKVRequest{Location: instanceLocation("counter"), Incr: 1 << 40})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<40), incr.Value)
+	_, err = kv.IncrFloat(ctx, &eventstore.IncrFloatKVRequest{Location: instanceLocation("counter"), Incr: 0.5})
+	require.NoError(t, err)
+	_, err = kv.Incr(ctx, &eventstore.IncrKVRequest{Location: instanceLocation("counter"), Incr: 1})
+	assertCode(t, codes.FailedPrecondition, err)
+	_, err = kv.Del(ctx, &eventstore.DelKVRequest{Location: instanceLocation("counter")})
+	require.NoError(t, err)
+
+	_, err = kv.SetIfNotExists(ctx, &eventstore.SetIfNotExistsKVRequest{Location: loc, Value: tValue})
+	assertCode(t, codes.Aborted, err)
+	swapped, err := kv.CompareAndSwap(ctx, &eventstore.CompareAndSwapKVRequest{Location: loc, Value: tValue, Ttl: 60, Version: res.Version})
+	require.NoError(t, err)
+	assert.Greater(t, swapped.Version, res.Version)
+
+	q := eventstore.NewQueueClient(conn)
+	qloc := instanceLocation("queue")
+	_, err = q.New(ctx, &eventstore.NewQueueRequest{Location: qloc})
+	require.NoError(t, err)
+	_, err = q.Peek(ctx, &eventstore.PeekQueueRequest{Location: qloc})
+	assertCode(t, codes.NotFound, err)
+	_, err = q.Push(ctx, &eventstore.PushQueueRequest{Location: qloc, Value: tValue})
+	require.NoError(t, err)
+	peek, err := q.Peek(ctx, &eventstore.PeekQueueRequest{Location: qloc})
+	require.NoError(t, err)
+	assert.Equal(t, tValue, peek.Value)
+
+	ttl, err := kv.TTL(ctx, &eventstore.TTLKVRequest{Location: loc})
+	require.NoError(t, err)
+	assert.Equal(t, int32(60), ttl.Ttl)
+	_, err = kv.Expire(ctx, &eventstore.ExpireKVRequest{Location: loc})
+	assertCode(t, codes.InvalidArgument, err)
+	_, err = q.Expire(ctx, &eventstore.ExpireQueueRequest{Location: qloc, Ttl: 30})
+	require.NoError(t, err)
+	_, err = eventstore.NewMapClient(conn).Persist(ctx, &eventstore.PersistMapRequest{Location: instanceLocation("map")})
+	assertCode(t, codes.NotFound, err)
+
+	scope := eventstore.NewScopeClient(conn)
+	scan, err := scope.Scan(ctx, &eventstore.ScanScopeRequest{Scope: loc.Scope})
+	require.NoError(t, err)
+	require.Len(t, scan.Keys, 2)
+	assert.Equal(t, "queue", scan.Keys[0].Key)
+	assert.Equal(t, eventstore.KeyType_TypeQueue, scan.Keys[0].Type)
+	assert.Equal(t, int32(30), scan.Keys[0].Ttl)
+	assert.Equal(t, "test-key", scan.Keys[1].Key)
+	assert.Equal(t, int32(60), scan.Keys[1].Ttl)
+	assert.Empty(t, scan.Cursor)
+	_, err = scope.Scan(ctx, &eventstore.ScanScopeRequest{Scope: loc.Scope, Count: -1})
+	assertCode(t, codes.InvalidArgument, err)
+
+	// KV, Map and Sync services share the lock namespace.
+	sync := eventstore.NewSyncClient(conn)
+	lock, err := sync.Lock(ctx, &eventstore.LockRequest{Location: loc, Timeout: 10, Owner: "worker"})
+	require.NoError(t, err)
+	assert.NotZero(t, lock.FencingToken)
+	_, err = eventstore.NewMapClient(conn).Lock(ctx, &eventstore.LockRequest{Location: loc})
+	assertCode(t, codes.FailedPrecondition, err)
+	_, err = sync.RefreshLock(ctx, &eventstore.RefreshLockRequest{Location: loc, Unlock: "wrong", Timeout: 20})
+	assertCode(t, codes.PermissionDenied, err)
+	_, err = sync.RefreshLock(ctx, &eventstore.RefreshLockRequest{Location: loc, Unlock: lock.Unlock, Timeout: 20})
+	require.NoError(t, err)
+	info, err := sync.LockInfo(ctx, &eventstore.LockInfoRequest{Location: loc, Unlock: lock.Unlock})
+	require.NoError(t, err)
+	assert.True(t, info.Locked && info.Held)
+	assert.Equal(t, "worker", info.Owner)
+	assert.Equal(t, int32(20), info.Ttl)
+	assert.Equal(t, lock.FencingToken, info.FencingToken)
+	_, err = kv.Unlock(ctx, &eventstore.UnlockRequest{Location: loc, Unlock: lock.Unlock})
+	assert.NoError(t, err)
+	info, err = sync.LockInfo(ctx, &eventstore.LockInfoRequest{Location: loc})
+	require.NoError(t, err)
+	assert.False(t, info.Locked)
+
+	rlock, err := sync.RLock(ctx, &eventstore.RLockRequest{Location: loc, Timeout: 10})
+	require.NoError(t, err)
+	_, err = kv.Lock(ctx, &eventstore.LockRequest{Location: loc})
+	assertCode(t, codes.FailedPrecondition, err)
+	info, err = sync.LockInfo(ctx, &eventstore.LockInfoRequest{Location: loc})
+	require.NoError(t, err)
+	assert.False(t, info.Locked)
+	assert.Equal(t, int32(1), info.Readers)
+	_, err = sync.RUnlock(ctx, &eventstore.RUnlockRequest{Location: loc, Unlock: rlock.Unlock})
+	require.NoError(t, err)
+
+	permits, err := sync.Acquire(ctx, &eventstore.AcquireRequest{Location: loc, Permits: 2, Limit: 2, Timeout: 10})
+	require.NoError(t, err)
+	_, err = sync.Acquire(ctx, &eventstore.AcquireRequest{Location: loc, Permits: 1, Limit: 2})
+	assertCode(t, codes.ResourceExhausted, err)
+	_, err = sync.Release(ctx, &eventstore.ReleaseRequest{Location: loc, Release: permits.Release})
+	require.NoError(t, err)
+	_, err = sync.Release(ctx, &eventstore.ReleaseRequest{Location: loc, Release: permits.Release})
+	assertCode(t, codes.NotFound, err)
+
+	dropped, err := scope.Drop(ctx, &eventstore.DropScopeRequest{Scope: loc.Scope})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), dropped.Keys)
+	_, err = kv.Get(ctx, &eventstore.GetKVRequest{Location: loc})
+	assertCode(t, codes.NotFound, err)
+	_, err = scope.Drop(ctx, &eventstore.DropScopeRequest{Scope: &eventstore.ScopeType{Type: eventstore.ScopeChoice_Global}})
+	assertCode(t, codes.InvalidArgument, err)
+}
+
+func TestBatch(t *testing.T) {
+	conn := newTestConn(t)
+	ctx := context.Background()
+	scope := instanceLocation("").Scope
+
+	kv := eventstore.NewKVClient(conn)
+	set, err := kv.MSet(ctx, &eventstore.MSetKVRequest{Scope: scope, Entries: []*eventstore.KVEntry{
+		{Key: "a", Value: []byte("1")},
+		{Key: "b", Value: []byte("2"), Ttl: 60},
+	}})
+	require.NoError(t, err)
+	assert.Len(t, set.Versions, 2)
+
+	get, err := kv.MGet(ctx, &eventstore.MGetKVRequest{Scope: scope, Keys: []string{"b", "missing", "a"}})
+	require.NoError(t, err)
+	require.Len(t, get.Items, 3)
+	assert.Equal(t, []byte("2"), get.Items[0].Value)
+	assert.Equal(t, set.Versions[1], get.Items[0].Version)
+	assert.False(t, get.Items[1].Found)
+	assert.True(t, get.Items[2].Found)
+
+	_, err = kv.MGet(ctx, &eventstore.MGetKVRequest{Scope: scope})
+	assertCode(t, codes.InvalidArgument, err)
+
+	batch := eventstore.NewBatchClient(conn)
+	res, err := batch.Batch(ctx, &eventstore.BatchRequest{Ops: []*eventstore.BatchOperation{
+		{Op: &eventstore.BatchOperation_KvIncr{KvIncr: &eventstore.IncrKVRequest{Location: instanceLocation("a"), Incr: 2}}},
+		{Op: &eventstore.BatchOperation_MapFieldSet{MapFieldSet: &eventstore.SetMapFieldRequest{Location: instanceLocation("map"), Field: "f", Value: tValue}}},
+		{Op: &eventstore.BatchOperation_QueueNew{QueueNew: &eventstore.NewQueueRequest{Location: instanceLocation("queue")}}},
+		{Op: &eventstore.BatchOperation_QueuePush{QueuePush: &eventstore.PushQueueRequest{Location: instanceLocation("queue"), Value: tValue}}},
+		{Op: &eventstore.BatchOperation_QueuePop{QueuePop: &eventstore.PopQueueRequest{Location: instanceLocation("queue")}}},
+		{Op: &eventstore.BatchOperation_KvSet{KvSet: &eventstore.SetKVRequest{Location: instanceLocation("c"), Ttl: -1}}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 6)
+	assert.Equal(t, int64(3), res.Results[0].GetKvIncr().GetValue())
+	assert.Equal(t, int32(codes.NotFound), res.Results[1].GetError().GetCode())
+	assert.NotNil(t, res.Results[2].GetQueueNew())
+	assert.NotNil(t, res.Results[3].GetQueuePush())
+	assert.Equal(t, tValue, res.Results[4].GetQueuePop().GetValue())
+	assert.Equal(t, int32(codes.InvalidArgument), res.Results[5].GetError().GetCode())
+
+	_, err = batch.Batch(ctx, &eventstore.BatchRequest{Ops: []*eventstore.BatchOperation{{}}})
+	assertCode(t, codes.InvalidArgument, err)
+
+	del, err := kv.MDel(ctx, &eventstore.MDelKVRequest{Scope: scope, Keys: []string{"a", "b", "missing"}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), del.Keys)
+}
+
+func TestTxn(t *testing.T) {
+	conn := newTestConn(t)
+	ctx := context.Background()
+	qloc := instanceLocation("queue")
+	mloc := instanceLocation("map")
+
+	q := eventstore.NewQueueClient(conn)
+	_, err := q.New(ctx, &eventstore.NewQueueRequest{Location: qloc})
+	require.NoError(t, err)
+	_, err = q.Push(ctx, &eventstore.PushQueueRequest{Location: qloc, Value: tValue})
+	require.NoError(t, err)
+
+	txn := eventstore.NewTxnClient(conn)
+	req := &eventstore.TxnRequest{
+		Guards: []*eventstore.TxnGuard{
+			{Location: mloc, Condition: &eventstore.TxnGuard_Exists{Exists: false}},
+		},
+		Ops: []*eventstore.TxnOperation{
+			{Op: &eventstore.TxnOperation_QueuePop{QueuePop: &eventstore.PopQueueRequest{Location: qloc}}},
+			{Op: &eventstore.TxnOperation_MapNew{MapNew: &eventstore.NewMapRequest{Location: mloc}}},
+			{Op: &eventstore.TxnOperation_MapFieldIncr{MapFieldIncr: &eventstore.IncrMapFieldRequest{Location: mloc, Field: "count", Incr: 1}}},
+		},
+	}
+	res, err := txn.Txn(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	assert.Equal(t, tValue, res.Results[0].GetQueuePop().GetValue())
+	assert.Equal(t, int64(1), res.Results[2].GetMapFieldIncr().GetValue())
+
+	_, err = txn.Txn(ctx, req)
+	assertCode(t, codes.Aborted, err)
+
+	req.Guards = nil
+	_, err = txn.Txn(ctx, req)
+	assertCode(t, codes.NotFound, err)
+
+	_, err = txn.Txn(ctx, &eventstore.TxnRequest{Ops: []*eventstore.TxnOperation{
+		{Op: &eventstore.TxnOperation_MapFieldSet{MapFieldSet: &eventstore.SetMapFieldRequest{Location: mloc}}},
+	}})
+	assertCode(t, codes.InvalidArgument, err)
+}
+
+func TestBlockingPop(t *testing.T) {
+	conn := newTestConn(t)
+	ctx := context.Background()
+	loc := instanceLocation("queue")
+	q := eventstore.NewQueueClient(conn)
+
+	_, err := q.BlockingPop(ctx, &eventstore.BlockingPopQueueRequest{Location: loc, Timeout: 1})
+	assertCode(t, codes.NotFound, err)
+
+	_, err = q.New(ctx, &eventstore.NewQueueRequest{Location: loc})
+	require.NoError(t, err)
+
+	_, err = q.BlockingPop(ctx, &eventstore.BlockingPopQueueRequest{Location: loc, Timeout: 1})
+	assertCode(t, codes.NotFound, err)
+
+	// Consumers are served in the order they started waiting.
+	results := make([]chan []byte, 2)
+	for i := range results {
+		results[i] = make(chan []byte, 1)
+		go func(ch chan []byte) {
+			res, err := q.BlockingPop(ctx, &eventstore.BlockingPopQueueRequest{Location: loc, Timeout: 5})
+			assert.NoError(t, err)
+			ch <- res.GetValue()
+		}(results[i])
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	for _, v := range []string{"first", "second"} {
+		_, err = q.Push(ctx, &eventstore.PushQueueRequest{Location: loc, Value: []byte(v)})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []byte("first"), <-results[0])
+	assert.Equal(t, []byte("second"), <-results[1])
+
+	_, err = q.BlockingPop(ctx, &eventstore.BlockingPopQueueRequest{Location: loc, Timeout: -1})
+	assertCode(t, codes.InvalidArgument, err)
+}
+
+func TestLockWait(t *testing.T) {
+	conn := newTestConn(t)
+	ctx := context.Background()
+	loc := instanceLocation("lock")
+	sync := eventstore.NewSyncClient(conn)
+
+	held, err := sync.Lock(ctx, &eventstore.LockRequest{Location: loc, Timeout: 10})
+	require.NoError(t, err)
+
+	_, err = sync.Lock(ctx, &eventstore.LockRequest{Location: loc, Wait: 1})
+	assertCode(t, codes.FailedPrecondition, err)
+
+	// A contender that gives up leaves the line.
+	cctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	_, err = sync.Lock(cctx, &eventstore.LockRequest{Location: loc, Wait: 5})
+	assertCode(t, codes.DeadlineExceeded, err)
+
+	// Contenders are granted the lock in the order they started waiting.
+	results := make([]chan *eventstore.LockResponse, 2)
+	for i := range results {
+		results[i] = make(chan *eventstore.LockResponse, 1)
+		go func(ch chan *eventstore.LockResponse) {
+			res, err := sync.Lock(ctx, &eventstore.LockRequest{Location: loc, Timeout: 10, Wait: 5})
+			assert.NoError(t, err)
+			ch <- res
+		}(results[i])
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	_, err = sync.Unlock(ctx, &eventstore.UnlockRequest{Location: loc, Unlock: held.Unlock})
+	require.NoError(t, err)
+
+	first := <-results[0]
+	select {
+	case <-results[1]:
+		t.Fatal("lock granted to two contenders")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	_, err = sync.Unlock(ctx, &eventstore.UnlockRequest{Location: loc, Unlock: first.GetUnlock()})
+	require.NoError(t, err)
+	second := <-results[1]
+	assert.Greater(t, second.GetFencingToken(), first.GetFencingToken())
+
+	_, err = sync.Lock(ctx, &eventstore.LockRequest{Location: loc, Wait: -1})
+	assertCode(t, codes.InvalidArgument, err)
+}
+
+func TestWatch(t *testing.T) {
+	conn := newTestConn(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := eventstore.NewWatchClient(conn)
+	kv := eventstore.NewKVClient(conn)
+	m := eventstore.NewMapClient(conn)
+
+	stream, err := w.Watch(ctx, &eventstore.WatchRequest{Location: instanceLocation("test-"), Prefix: true})
+	require.NoError(t, err)
+	md, err := stream.Header()
+	require.NoError(t, err)
+	require.Len(t, md.Get("eventstore-revision"), 1)
+	revision, err := strconv.ParseUint(md.Get("eventstore-revision")[0], 10, 64)
+	require.NoError(t, err)
+
+	_, err = kv.Set(ctx, &eventstore.SetKVRequest{Location: instanceLocation("test-key"), Value: tValue})
+	require.NoError(t, err)
+	_, err = kv.Set(ctx, &eventstore.SetKVRequest{Location: instanceLocation("other-key"), Value: tValue})
+	require.NoError(t, err)
+	_, err = kv.Incr(ctx, &eventstore.IncrKVRequest{Location: instanceLocation("test-counter"), Incr: 3})
+	require.NoError(t, err)
+	_, err = m.New(ctx, &eventstore.NewMapRequest{Location: instanceLocation("test-map")})
+	require.NoError(t, err)
+	_, err = m.FieldSet(ctx, &eventstore.SetMapFieldRequest{Location: instanceLocation("test-map"), Field: "f", Value: tValue})
+	require.NoError(t, err)
+	_, err = kv.Del(ctx, &eventstore.DelKVRequest{Location: instanceLocation("test-key")})
+	require.NoError(t, err)
+
+	expected := []struct {
+		typ   eventstore.WatchEventType
+		key   string
+		field string
+		value []byte
+	}{
+		{eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, "test-key", "", tValue},
+		{eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, "test-counter", "", []byte("3")},
+		{eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, "test-map", "", nil},
+		{eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, "test-map", "f", tValue},
+		{eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, "test-key", "", nil},
+	}
+
+	var revisions []uint64
+	for _, e := range expected {
+		ev, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, e.typ, ev.Type)
+		assert.Equal(t, e.key, ev.Location.Key)
+		assert.Equal(t, e.field, ev.Field)
+		assert.Equal(t, e.value, ev.Value)
+		revisions = append(revisions, ev.Revision)
+	}
+
+	assert.Equal(t, revision+1, revisions[0], "headers should inform the revision when watching")
+
+	// Resuming replays the events after the informed revision.
+	resumed, err := w.Watch(ctx, &eventstore.WatchRequest{Location: instanceLocation("test-key"), Revision: revisions[0]})
+	require.NoError(t, err)
+	ev, err := resumed.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, ev.Type)
+	assert.Equal(t, revisions[4], ev.Revision)
+
+	// Resuming fails once the events after the revision are
+	// evicted from the latest 1024 ones kept by the server.
+	for i := 0; i < 1024; i++ {
+		_, err = kv.Set(ctx, &eventstore.SetKVRequest{Location: instanceLocation("other-key"), Value: tValue})
+		require.NoError(t, err)
+	}
+	evicted, err := w.Watch(ctx, &eventstore.WatchRequest{Location: instanceLocation("test-key"), Revision: revisions[0]})
+	require.NoError(t, err)
+	_, err = evicted.Recv()
+	assertCode(t, codes.OutOfRange, err)
+
+	invalid, err := w.Watch(ctx, &eventstore.WatchRequest{Location: instanceLocation("")})
+	require.NoError(t, err)
+	_, err = invalid.Recv()
+	assertCode(t, codes.InvalidArgument, err)
+}
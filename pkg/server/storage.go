@@ -0,0 +1,520 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package server exposes EventStore storage drivers
+// through the EventStore gRPC services.
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+// Errors returned by storage drivers. Drivers might wrap
+// them to add context, the gRPC adapter translates them into
+// the matching status code. Any other error is returned to
+// clients as is, which lets drivers return gRPC status errors.
+var (
+	// ErrNotFound is returned when the key, field, item or lock
+	// for the operation does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when creating a key that exists.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrWrongKind is returned when operating on a key that
+	// holds a different kind of value.
+	ErrWrongKind = errors.New("operation against a key holding the wrong kind of value")
+	// ErrNotInteger is returned when incrementing a value that
+	// is not an integer.
+	ErrNotInteger = errors.New("value is not an integer")
+	// ErrNotFloat is returned when incrementing a value that
+	// is not a floating point number.
+	ErrNotFloat = errors.New("value is not a valid float")
+	// ErrOutOfRange is returned for out of bounds indexes and
+	// increments that would overflow or produce NaN or infinity.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrLocked is returned when locking a key that is locked.
+	ErrLocked = errors.New("locked")
+	// ErrUnlockMismatch is returned when unlocking a key with
+	// a token that does not match the one returned by Lock.
+	ErrUnlockMismatch = errors.New("unlock token does not match")
+	// ErrConflict is returned when the condition for a
+	// conditional write does not hold.
+	ErrConflict = errors.New("condition does not hold")
+	// ErrQueueFull is returned when pushing to a queue that
+	// reached its maximum length and rejects new items.
+	ErrQueueFull = errors.New("queue is full")
+	// ErrNoPermits is returned when acquiring more permits than
+	// a semaphore has left.
+	ErrNoPermits = errors.New("not enough permits left")
+)
+
+// Storage is implemented by EventStore storage drivers.
+//
+// Every key is addressed by a location whose scope has already been
+// validated. Drivers must keep keys at different scopes isolated, and
+// each key holds a single kind of value: KV, map, queue, set, sorted
+// set or stream. A zero TTL means that the key never expires.
+//
+// KV entries have a version that changes with every write. Versions
+// are never zero and must not be reused for a location, not even
+// after the key is deleted.
+type Storage interface {
+	KV
+	Hash
+	List
+	Set
+	SortedSet
+	Stream
+	Locker
+	Semaphore
+
+	// Del removes the key at the location regardless of
+	// the kind of value it holds.
+	Del(ctx context.Context, loc *eventstore.LocationType) error
+
+	// TTL returns the remaining time to live of the key at the
+	// location regardless of its kind, zero if it never expires.
+	TTL(ctx context.Context, loc *eventstore.LocationType) (time.Duration, error)
+	// Expire sets a new non zero TTL for the key at the location.
+	Expire(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error
+	// Persist removes the TTL of the key at the location.
+	Persist(ctx context.Context, loc *eventstore.LocationType) error
+
+	// Scan returns up to count keys at the scope that start with the
+	// prefix, resuming after the cursor returned by a previous call.
+	// The returned cursor is empty once all keys have been returned,
+	// drivers might return fewer keys than requested before that.
+	Scan(ctx context.Context, scope *eventstore.ScopeType, prefix, cursor string, count int) ([]KeyInfo, string, error)
+
+	// DropScope removes all keys and locks at the bridge or instance
+	// scope, dropping a bridge also drops its instances. It returns
+	// the locations of the removed keys.
+	DropScope(ctx context.Context, scope *eventstore.ScopeType) ([]*eventstore.LocationType, error)
+
+	// Txn checks the guards and applies the operations in order as a
+	// single atomic change, returning their results. It fails with
+	// ErrConflict when any guard does not hold, and when any operation
+	// fails none of them is applied.
+	Txn(ctx context.Context, guards []Guard, ops []Op) ([]OpResult, error)
+}
+
+// Kind of value held by a key.
+type Kind int
+
+// Kinds of values.
+const (
+	KindKV Kind = iota
+	KindMap
+	KindQueue
+	KindSet
+	KindSortedSet
+	KindStream
+)
+
+// KeyInfo describes a key returned by Scan.
+type KeyInfo struct {
+	Key  string
+	Kind Kind
+	// TTL is the remaining time to live, zero
+	// meaning that the key never expires.
+	TTL time.Duration
+}
+
+// GuardKind is the condition checked by a transaction guard.
+type GuardKind int
+
+// Transaction guard conditions.
+const (
+	// GuardExists holds when the key exists.
+	GuardExists GuardKind = iota
+	// GuardNotExists holds when the key does not exist.
+	GuardNotExists
+	// GuardVersion holds when the KV entry has the version.
+	GuardVersion
+	// GuardField holds when the map field holds the value.
+	GuardField
+)
+
+// Guard is a condition that must hold for a transaction
+// to be applied. Only the fields that apply to its kind
+// are informed.
+type Guard struct {
+	Kind     GuardKind
+	Location *eventstore.LocationType
+	Version  uint64
+	Field    string
+	Value    []byte
+}
+
+// OpKind is the mutation applied by a transaction operation.
+type OpKind int
+
+// Transaction operations. Each of them works like the storage
+// method of the same name.
+const (
+	OpSet OpKind = iota
+	OpIncrBy
+	OpDel
+	OpHNew
+	OpHSet
+	OpHDel
+	OpHIncrBy
+	OpLNew
+	OpRPush
+	OpLPop
+)
+
+// Overflow is the policy applied when pushing to a full queue.
+type Overflow int
+
+// Queue overflow policies.
+const (
+	// OverflowReject fails the push with ErrQueueFull.
+	OverflowReject Overflow = iota
+	// OverflowDropOldest removes the item at the opposite
+	// end of the queue to make room for the pushed one.
+	OverflowDropOldest
+)
+
+// QueueLimit bounds the length of a queue.
+type QueueLimit struct {
+	// MaxLen is the maximum number of items,
+	// zero meaning that the queue is unbounded.
+	MaxLen   int
+	Overflow Overflow
+}
+
+// PushOptions customize how items are pushed to queues.
+type PushOptions struct {
+	// Priority of the item, items with higher priorities are
+	// popped first. Items are pushed with priority zero by default.
+	Priority int
+	// Delay is how long the item is hidden after being pushed.
+	Delay time.Duration
+}
+
+// Op is a transaction operation. Only the fields that apply
+// to its kind are informed.
+type Op struct {
+	Kind     OpKind
+	Location *eventstore.LocationType
+	Field    string
+	Value    []byte
+	Delta    int64
+	TTL      time.Duration
+	Limit    QueueLimit
+	Push     PushOptions
+}
+
+// OpResult is the outcome of a transaction operation.
+type OpResult struct {
+	// Version assigned by OpSet and OpIncrBy.
+	Version uint64
+	// Number resulting from OpIncrBy and OpHIncrBy.
+	Number int64
+	// Value removed by OpLPop.
+	Value []byte
+}
+
+// ReserveOptions customize how queue items are reserved.
+type ReserveOptions struct {
+	// Visibility is how long the item is hidden from other
+	// consumers until it is delivered again.
+	Visibility time.Duration
+	// MaxDeliveries is the number of times an item is delivered
+	// before being dead-lettered, zero meaning unlimited.
+	MaxDeliveries int
+	// DeadLetter is the queue where dead-lettered items are
+	// pushed honoring its limit, nil meaning that they are dropped.
+	DeadLetter *eventstore.LocationType
+}
+
+// Reservation is a queue item delivered to a consumer
+// that needs to acknowledge it.
+type Reservation struct {
+	// ID identifies the delivery when acknowledging the item.
+	ID    string
+	Value []byte
+	// Deliveries is the number of times the item has been
+	// delivered, including this one.
+	Deliveries int
+	// DeadLetters are the items dead-lettered while looking for
+	// an item to deliver, also informed when the queue is empty.
+	DeadLetters [][]byte
+}
+
+// KV primitives.
+type KV interface {
+	// Set stores the value at the location, replacing any
+	// existing key, and returns the new version.
+	Set(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error)
+	// Get returns the value stored at the location and its version.
+	Get(ctx context.Context, loc *eventstore.LocationType) ([]byte, uint64, error)
+	// IncrBy adds delta to the integer stored at the location and
+	// returns the result. Missing keys are created with a zero value.
+	IncrBy(ctx context.Context, loc *eventstore.LocationType, delta int64) (int64, error)
+	// IncrByFloat works like IncrBy for floating point numbers.
+	IncrByFloat(ctx context.Context, loc *eventstore.LocationType, delta float64) (float64, error)
+
+	// SetNX works like Set when the key does not exist, and fails
+	// with ErrConflict otherwise.
+	SetNX(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error)
+	// SetXX works like Set when the key exists, and fails with
+	// ErrConflict otherwise.
+	SetXX(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration) (uint64, error)
+	// CompareAndSwap works like Set when the KV entry at the location
+	// has the informed version, and fails with ErrConflict otherwise.
+	CompareAndSwap(ctx context.Context, loc *eventstore.LocationType, value []byte, ttl time.Duration, version uint64) (uint64, error)
+}
+
+// Hash primitives, backing the Map service.
+type Hash interface {
+	// HNew creates an empty map at the location.
+	HNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error
+	// HSet stores the value at a field of an existing map. The field
+	// expires after the TTL when it is positive, and lives as long as
+	// the map otherwise.
+	HSet(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration) error
+	// HSetNX works like HSet when the field does not exist, and
+	// fails with ErrConflict otherwise.
+	HSetNX(ctx context.Context, loc *eventstore.LocationType, field string, value []byte, ttl time.Duration) error
+	// HGet returns the value stored at a map field.
+	HGet(ctx context.Context, loc *eventstore.LocationType, field string) ([]byte, error)
+	// HDel removes a map field.
+	HDel(ctx context.Context, loc *eventstore.LocationType, field string) error
+	// HIncrBy adds delta to the integer stored at a map field and
+	// returns the result. Missing fields are created with a zero value.
+	HIncrBy(ctx context.Context, loc *eventstore.LocationType, field string, delta int64) (int64, error)
+	// HIncrByFloat works like HIncrBy for floating point numbers.
+	HIncrByFloat(ctx context.Context, loc *eventstore.LocationType, field string, delta float64) (float64, error)
+	// HGetAll returns all map fields.
+	HGetAll(ctx context.Context, loc *eventstore.LocationType) (map[string][]byte, error)
+	// HLen returns the number of map fields.
+	HLen(ctx context.Context, loc *eventstore.LocationType) (int, error)
+}
+
+// List primitives, backing the Queue service.
+//
+// Queue items are sorted by priority, and items with the same priority
+// are kept in the order they become visible. Items pushed at the head
+// are placed before the rest of the items with priority zero. Hidden
+// items are not counted or returned by the List primitives, but count
+// towards the limit of the queue.
+type List interface {
+	// LNew creates an empty queue at the location, whose
+	// length is enforced by pushes when limited.
+	LNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration, limit QueueLimit) error
+	// RPush appends the value at the tail of an existing queue,
+	// behind the rest of the items with the same priority.
+	RPush(ctx context.Context, loc *eventstore.LocationType, value []byte, opts PushOptions) error
+	// LPush inserts the value at the head of an existing queue.
+	LPush(ctx context.Context, loc *eventstore.LocationType, value []byte) error
+	// LIndex returns the item at the index, counting from the head.
+	LIndex(ctx context.Context, loc *eventstore.LocationType, index int) ([]byte, error)
+	// LPop removes and returns the item at the head of the queue.
+	LPop(ctx context.Context, loc *eventstore.LocationType) ([]byte, error)
+	// RPop removes and returns the item at the tail of the queue.
+	RPop(ctx context.Context, loc *eventstore.LocationType) ([]byte, error)
+	// LRange returns the items between the start and stop indexes,
+	// both inclusive. Negative indexes count from the tail.
+	LRange(ctx context.Context, loc *eventstore.LocationType, start, stop int) ([][]byte, error)
+	// LTrim removes the items out of the start and stop indexes,
+	// both inclusive. Negative indexes count from the tail.
+	LTrim(ctx context.Context, loc *eventstore.LocationType, start, stop int) error
+	// LLen returns the number of items in the queue.
+	LLen(ctx context.Context, loc *eventstore.LocationType) (int, error)
+
+	// LReserve hides an item from other consumers until it is
+	// acknowledged or the visibility timeout elapses. Reserved items
+	// that are released, or whose visibility timeout elapsed, are
+	// delivered again before the item at the head of the queue.
+	// Reserved items are not counted or returned by the rest of the
+	// List primitives, and are removed along with the queue.
+	LReserve(ctx context.Context, loc *eventstore.LocationType, opts ReserveOptions) (Reservation, error)
+	// LAck removes a reserved item.
+	LAck(ctx context.Context, loc *eventstore.LocationType, id string) error
+	// LNack releases a reserved item so that it is delivered again.
+	LNack(ctx context.Context, loc *eventstore.LocationType, id string) error
+}
+
+// Set primitives, backing the Set service. Members are
+// never empty, and existing sets might have no members.
+type Set interface {
+	// SNew creates an empty set at the location.
+	SNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error
+	// SAdd adds the members to an existing set and returns
+	// the number of them that were not in the set.
+	SAdd(ctx context.Context, loc *eventstore.LocationType, members []string) (int, error)
+	// SRem removes the members from the set and returns
+	// the number of them that were in the set.
+	SRem(ctx context.Context, loc *eventstore.LocationType, members []string) (int, error)
+	// SIsMember reports whether the member is in the set.
+	SIsMember(ctx context.Context, loc *eventstore.LocationType, member string) (bool, error)
+	// SMembers returns all members of the set, in no particular order.
+	SMembers(ctx context.Context, loc *eventstore.LocationType) ([]string, error)
+	// SCard returns the number of members of the set.
+	SCard(ctx context.Context, loc *eventstore.LocationType) (int, error)
+	// SPop removes and returns a random member of the set,
+	// failing with ErrNotFound when the set is empty.
+	SPop(ctx context.Context, loc *eventstore.LocationType) (string, error)
+}
+
+// ScoredMember is a member of a sorted set.
+type ScoredMember struct {
+	Member string
+	Score  float64
+}
+
+// SortedSet primitives, backing the SortedSet service.
+//
+// Members are never empty and are sorted by score, members with the
+// same score being sorted lexicographically. Score ranges include
+// both ends and might be infinite.
+type SortedSet interface {
+	// ZNew creates an empty sorted set at the location.
+	ZNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration) error
+	// ZAdd adds the members to an existing sorted set, updating the
+	// score of existing ones, and returns the number of members that
+	// were not in the set.
+	ZAdd(ctx context.Context, loc *eventstore.LocationType, members []ScoredMember) (int, error)
+	// ZIncrBy adds delta to the score of the member and returns the
+	// result. Missing members are added with a zero score.
+	ZIncrBy(ctx context.Context, loc *eventstore.LocationType, member string, delta float64) (float64, error)
+	// ZRangeByScore returns up to limit members whose score is
+	// between min and max, zero limit meaning no limit.
+	ZRangeByScore(ctx context.Context, loc *eventstore.LocationType, min, max float64, limit int) ([]ScoredMember, error)
+	// ZRange returns the members between the start and stop ranks,
+	// both inclusive. Negative ranks count from the highest score.
+	ZRange(ctx context.Context, loc *eventstore.LocationType, start, stop int) ([]ScoredMember, error)
+	// ZRemRangeByScore removes the members whose score is between
+	// min and max, and returns them.
+	ZRemRangeByScore(ctx context.Context, loc *eventstore.LocationType, min, max float64) ([]string, error)
+	// ZCard returns the number of members of the sorted set.
+	ZCard(ctx context.Context, loc *eventstore.LocationType) (int, error)
+}
+
+// StreamEntry is an entry appended to a stream.
+type StreamEntry struct {
+	Offset int64
+	Value  []byte
+	// Time when the entry was appended.
+	Time time.Time
+}
+
+// StreamRetention bounds the entries retained by a stream.
+type StreamRetention struct {
+	// MaxLen is the maximum number of entries,
+	// zero meaning that the stream is unbounded.
+	MaxLen int
+	// MaxAge is how long entries are retained,
+	// zero meaning that they are retained forever.
+	MaxAge time.Duration
+}
+
+// Stream primitives, backing the Stream service.
+//
+// Entries are assigned offsets that start at one and increase with
+// every append, which are never reused, not even after the entries
+// are removed by the retention of the stream. Consumer groups commit
+// the offset of the next entry they will read, and are removed along
+// with the stream.
+type Stream interface {
+	// XNew creates an empty stream at the location.
+	XNew(ctx context.Context, loc *eventstore.LocationType, ttl time.Duration, retention StreamRetention) error
+	// XAdd appends the value to an existing stream, removing the
+	// entries out of its retention, and returns its offset.
+	XAdd(ctx context.Context, loc *eventstore.LocationType, value []byte) (int64, error)
+	// XRange returns up to limit entries starting at the offset,
+	// zero limit meaning no limit. Entries older than the maximum
+	// age of the stream are not returned.
+	XRange(ctx context.Context, loc *eventstore.LocationType, offset int64, limit int) ([]StreamEntry, error)
+	// XCommit stores the offset committed by the consumer group,
+	// failing with ErrOutOfRange for offsets after the one that
+	// will be assigned to the next appended entry.
+	XCommit(ctx context.Context, loc *eventstore.LocationType, group string, offset int64) error
+	// XOffset returns the offset committed by the consumer
+	// group, zero if it has not committed any.
+	XOffset(ctx context.Context, loc *eventstore.LocationType, group string) (int64, error)
+}
+
+// Lease describes a held lock.
+type Lease struct {
+	// Token needed to refresh and unlock the lock.
+	Token string
+	// FencingToken is greater than the fencing token of any lock
+	// acquired before, which allows downstream systems to reject
+	// writes from holders whose lock timed out.
+	FencingToken uint64
+	// Owner optionally identifies the holder.
+	Owner string
+	// TTL is the remaining time until the lock is released,
+	// zero meaning that it is held until unlocked.
+	TTL time.Duration
+	// Readers is the number of shared holders, the other fields
+	// being empty when the lock is not held exclusively.
+	Readers int
+}
+
+// Locker primitives. Locks are advisory and live in a namespace of
+// their own, shared by the KV, Map and Sync services.
+type Locker interface {
+	// Lock acquires exclusive access to the location and returns
+	// the lease, whose token is needed to unlock it. A non zero
+	// timeout releases the lock automatically once elapsed.
+	Lock(ctx context.Context, loc *eventstore.LocationType, timeout time.Duration, owner string) (Lease, error)
+	// Unlock releases the lock when the token matches.
+	Unlock(ctx context.Context, loc *eventstore.LocationType, token string) error
+	// RefreshLock replaces the timeout of the lock when the token
+	// matches, zero meaning that it is held until unlocked.
+	RefreshLock(ctx context.Context, loc *eventstore.LocationType, token string, timeout time.Duration) error
+	// LockInfo returns the lease of the lock at the location,
+	// failing with ErrNotFound when it is not locked.
+	LockInfo(ctx context.Context, loc *eventstore.LocationType) (Lease, error)
+
+	// RLock acquires shared access to the location, which is only
+	// granted while it is not locked exclusively, and returns the token
+	// needed to unlock it. Exclusive locks are not granted while there
+	// are shared holders. A non zero timeout releases the shared lock
+	// automatically once elapsed.
+	RLock(ctx context.Context, loc *eventstore.LocationType, timeout time.Duration) (string, error)
+	// RUnlock releases the shared lock held using the token.
+	RUnlock(ctx context.Context, loc *eventstore.LocationType, token string) error
+}
+
+// Semaphore primitives. Semaphores live in a namespace of their own,
+// and are created when permits are first acquired and removed once
+// all permits are released.
+type Semaphore interface {
+	// Acquire takes permits from the semaphore at the location, failing
+	// with ErrNoPermits if more than limit permits would be held, and
+	// returns the token needed to release them. A non zero timeout
+	// releases the permits automatically once elapsed.
+	Acquire(ctx context.Context, loc *eventstore.LocationType, permits, limit int, timeout time.Duration) (string, error)
+	// Release returns the permits taken using the token.
+	Release(ctx context.Context, loc *eventstore.LocationType, token string) error
+}
+
+// ExpiryNotifier is optionally implemented by storage drivers that
+// report keys removed because their TTL elapsed, so that expirations
+// can be streamed to watchers.
+type ExpiryNotifier interface {
+	// NotifyExpired registers the function to be called with the
+	// location of every expired key. The function does not block.
+	NotifyExpired(f func(loc *eventstore.LocationType))
+}
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+type txnServer struct {
+	eventstore.UnimplementedTxnServer
+	store  Storage
+	events *hub
+}
+
+func (s *txnServer) Txn(ctx context.Context, in *eventstore.TxnRequest) (*eventstore.TxnResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	guards := make([]Guard, 0, len(in.Guards))
+	for _, g := range in.Guards {
+		guards = append(guards, txnGuard(g))
+	}
+
+	ops := make([]Op, 0, len(in.Ops))
+	for _, op := range in.Ops {
+		ops = append(ops, txnOp(op))
+	}
+
+	res, err := s.store.Txn(ctx, guards, ops)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &eventstore.TxnResponse{
+		Results: make([]*eventstore.TxnResult, 0, len(res)),
+	}
+	for i, r := range res {
+		s.publish(ops[i], r)
+		out.Results = append(out.Results, txnResult(in.Ops[i], r))
+	}
+
+	return out, nil
+}
+
+// publish notifies watchers of the change applied by the operation.
+func (s *txnServer) publish(op Op, r OpResult) {
+	switch op.Kind {
+	case OpSet:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, op.Location, "", op.Value)
+	case OpIncrBy:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_SET, op.Location, "", integer(r.Number))
+	case OpDel:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_DELETE, op.Location, "", nil)
+	case OpHNew, OpLNew:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_NEW, op.Location, "", nil)
+	case OpHSet:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, op.Location, op.Field, op.Value)
+	case OpHDel:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_DELETE, op.Location, op.Field, nil)
+	case OpHIncrBy:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_FIELD_SET, op.Location, op.Field, integer(r.Number))
+	case OpRPush:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH, op.Location, "", op.Value)
+	case OpLPop:
+		s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_POP, op.Location, "", r.Value)
+	}
+}
+
+func txnGuard(g *eventstore.TxnGuard) Guard {
+	switch c := g.Condition.(type) {
+	case *eventstore.TxnGuard_Version:
+		return Guard{Kind: GuardVersion, Location: g.Location, Version: c.Version}
+	case *eventstore.TxnGuard_Field:
+		return Guard{Kind: GuardField, Location: g.Location, Field: c.Field.Field, Value: c.Field.Value}
+	case *eventstore.TxnGuard_Exists:
+		if !c.Exists {
+			return Guard{Kind: GuardNotExists, Location: g.Location}
+		}
+	}
+	return Guard{Kind: GuardExists, Location: g.Location}
+}
+
+// txnOp returns the storage operation for a validated request.
+func txnOp(op *eventstore.TxnOperation) Op {
+	switch o := op.Op.(type) {
+	case *eventstore.TxnOperation_KvSet:
+		return Op{Kind: OpSet, Location: o.KvSet.Location, Value: o.KvSet.Value, TTL: seconds(o.KvSet.Ttl)}
+	case *eventstore.TxnOperation_KvDel:
+		return Op{Kind: OpDel, Location: o.KvDel.Location}
+	case *eventstore.TxnOperation_KvIncr:
+		return Op{Kind: OpIncrBy, Location: o.KvIncr.Location, Delta: o.KvIncr.Incr}
+	case *eventstore.TxnOperation_KvDecr:
+		return Op{Kind: OpIncrBy, Location: o.KvDecr.Location, Delta: -o.KvDecr.Decr}
+
+	case *eventstore.TxnOperation_MapNew:
+		return Op{Kind: OpHNew, Location: o.MapNew.Location, TTL: seconds(o.MapNew.Ttl)}
+	case *eventstore.TxnOperation_MapDel:
+		return Op{Kind: OpDel, Location: o.MapDel.Location}
+	case *eventstore.TxnOperation_MapFieldSet:
+		return Op{Kind: OpHSet, Location: o.MapFieldSet.Location, Field: o.MapFieldSet.Field, Value: o.MapFieldSet.Value, TTL: seconds(o.MapFieldSet.Ttl)}
+	case *eventstore.TxnOperation_MapFieldDel:
+		return Op{Kind: OpHDel, Location: o.MapFieldDel.Location, Field: o.MapFieldDel.Field}
+	case *eventstore.TxnOperation_MapFieldIncr:
+		return Op{Kind: OpHIncrBy, Location: o.MapFieldIncr.Location, Field: o.MapFieldIncr.Field, Delta: o.MapFieldIncr.Incr}
+	case *eventstore.TxnOperation_MapFieldDecr:
+		return Op{Kind: OpHIncrBy, Location: o.MapFieldDecr.Location, Field: o.MapFieldDecr.Field, Delta: -o.MapFieldDecr.Decr}
+
+	case *eventstore.TxnOperation_QueueNew:
+		return Op{Kind: OpLNew, Location: o.QueueNew.Location, TTL: seconds(o.QueueNew.Ttl), Limit: queueLimit(o.QueueNew)}
+	case *eventstore.TxnOperation_QueueDel:
+		return Op{Kind: OpDel, Location: o.QueueDel.Location}
+	case *eventstore.TxnOperation_QueuePush:
+		return Op{Kind: OpRPush, Location: o.QueuePush.Location, Value: o.QueuePush.Value, Push: pushOptions(o.QueuePush)}
+	case *eventstore.TxnOperation_QueuePop:
+		return Op{Kind: OpLPop, Location: o.QueuePop.Location}
+	}
+
+	// unreachable for validated requests
+	return Op{Kind: -1}
+}
+
+// txnResult returns the response for the requested operation.
+func txnResult(op *eventstore.TxnOperation, r OpResult) *eventstore.TxnResult {
+	res := &eventstore.TxnResult{}
+
+	switch op.Op.(type) {
+	case *eventstore.TxnOperation_KvSet:
+		res.Result = &eventstore.TxnResult_KvSet{KvSet: &eventstore.SetKVResponse{Version: r.Version}}
+	case *eventstore.TxnOperation_KvDel:
+		res.Result = &eventstore.TxnResult_KvDel{KvDel: &eventstore.DelKVResponse{}}
+	case *eventstore.TxnOperation_KvIncr:
+		res.Result = &eventstore.TxnResult_KvIncr{KvIncr: &eventstore.IncrKVResponse{Value: r.Number}}
+	case *eventstore.TxnOperation_KvDecr:
+		res.Result = &eventstore.TxnResult_KvDecr{KvDecr: &eventstore.DecrKVResponse{Value: r.Number}}
+
+	case *eventstore.TxnOperation_MapNew:
+		res.Result = &eventstore.TxnResult_MapNew{MapNew: &eventstore.NewMapResponse{}}
+	case *eventstore.TxnOperation_MapDel:
+		res.Result = &eventstore.TxnResult_MapDel{MapDel: &eventstore.DelMapResponse{}}
+	case *eventstore.TxnOperation_MapFieldSet:
+		res.Result = &eventstore.TxnResult_MapFieldSet{MapFieldSet: &eventstore.SetMapFieldResponse{}}
+	case *eventstore.TxnOperation_MapFieldDel:
+		res.Result = &eventstore.TxnResult_MapFieldDel{MapFieldDel: &eventstore.DelMapFieldResponse{}}
+	case *eventstore.TxnOperation_MapFieldIncr:
+		res.Result = &eventstore.TxnResult_MapFieldIncr{MapFieldIncr: &eventstore.IncrMapFieldResponse{Value: r.Number}}
+	case *eventstore.TxnOperation_MapFieldDecr:
+		res.Result = &eventstore.TxnResult_MapFieldDecr{MapFieldDecr: &eventstore.DecrMapFieldResponse{Value: r.Number}}
+
+	case *eventstore.TxnOperation_QueueNew:
+		res.Result = &eventstore.TxnResult_QueueNew{QueueNew: &eventstore.NewQueueResponse{}}
+	case *eventstore.TxnOperation_QueueDel:
+		res.Result = &eventstore.TxnResult_QueueDel{QueueDel: &eventstore.DelQueueResponse{}}
+	case *eventstore.TxnOperation_QueuePush:
+		res.Result = &eventstore.TxnResult_QueuePush{QueuePush: &eventstore.PushQueueResponse{}}
+	case *eventstore.TxnOperation_QueuePop:
+		res.Result = &eventstore.TxnResult_QueuePop{QueuePop: &eventstore.PopQueueResponse{Value: r.Value}}
+	}
+
+	return res
+}
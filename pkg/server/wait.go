@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+const (
+	// popPollInterval is how often the consumer at the head of a
+	// blocked queue checks the storage, which catches items pushed
+	// by other servers sharing it.
+	popPollInterval = time.Second
+	// lockPollInterval is how often the contender at the head of a
+	// lock line checks the storage, which catches locks that expired
+	// or were released through other servers sharing it.
+	lockPollInterval = 100 * time.Millisecond
+)
+
+// waiters keeps requests blocked on a location in arrival order,
+// so that queue items and locks are handed to them first come,
+// first served.
+type waiters struct {
+	mu      sync.Mutex
+	waiting map[string][]*waiter
+}
+
+type waiter struct {
+	// ready is signaled when an item or the lock might be available.
+	ready chan struct{}
+}
+
+func newWaiters() *waiters {
+	return &waiters{
+		waiting: make(map[string][]*waiter),
+	}
+}
+
+// add queues a waiter for the location and returns whether
+// there are no other waiters before it.
+func (ws *waiters) add(key string) (*waiter, bool) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	w := &waiter{ready: make(chan struct{}, 1)}
+	ws.waiting[key] = append(ws.waiting[key], w)
+
+	return w, len(ws.waiting[key]) == 1
+}
+
+// first returns whether the waiter is at the head of the line.
+func (ws *waiters) first(key string, w *waiter) bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	l := ws.waiting[key]
+	return len(l) != 0 && l[0] == w
+}
+
+// remove takes the waiter out of the line. A signal it did not
+// consume is handed to the next waiter so that wake ups are not lost,
+// and the head of the line is handed to the next waiter, which
+// checks whether the location became available meanwhile.
+func (ws *waiters) remove(key string, w *waiter) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	l := ws.waiting[key]
+	head := len(l) != 0 && l[0] == w
+	for i := range l {
+		if l[i] == w {
+			l = append(l[:i], l[i+1:]...)
+			break
+		}
+	}
+
+	if len(l) == 0 {
+		delete(ws.waiting, key)
+		return
+	}
+	ws.waiting[key] = l
+
+	select {
+	case <-w.ready:
+		head = true
+	default:
+	}
+
+	if head {
+		ws.signal(key)
+	}
+}
+
+// notify wakes up the oldest waiter at the location
+// that has not been signaled yet.
+func (ws *waiters) notify(key string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.signal(key)
+}
+
+// signal must be called with the lock held.
+func (ws *waiters) signal(key string) {
+	for _, w := range ws.waiting[key] {
+		select {
+		case w.ready <- struct{}{}:
+			return
+		default:
+		}
+	}
+}
+
+// locationKey identifies a key across scopes.
+func locationKey(loc *eventstore.LocationType) string {
+	s := loc.GetScope()
+	return strings.Join([]string{s.GetType().String(), s.GetBridge(), s.GetInstance(), loc.GetKey()}, "\x00")
+}
+
+func (s *queueServer) BlockingPop(ctx context.Context, in *eventstore.BlockingPopQueueRequest) (*eventstore.BlockingPopQueueResponse, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	key := locationKey(in.Location)
+	w, first := s.events.poppers.add(key)
+	defer s.events.poppers.remove(key, w)
+
+	// Consumers that are not first in line wait for their turn,
+	// unless the queue does not exist.
+	v, ok, err := s.tryPop(ctx, in.Location, first)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	var timeout <-chan time.Time
+	if in.Timeout > 0 {
+		t := time.NewTimer(seconds(in.Timeout))
+		defer t.Stop()
+		timeout = t.C
+	}
+
+	poll := time.NewTicker(popPollInterval)
+	defer poll.Stop()
+
+	for !ok {
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+
+		case <-timeout:
+			return nil, toStatus(fmt.Errorf("queue %q is empty: %w", in.Location.Key, ErrNotFound))
+
+		case <-w.ready:
+			v, ok, err = s.tryPop(ctx, in.Location, true)
+
+		case <-poll.C:
+			v, ok, err = s.tryPop(ctx, in.Location, s.events.poppers.first(key, w))
+		}
+
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	s.events.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_POP, in.Location, "", v)
+
+	return &eventstore.BlockingPopQueueResponse{Value: v}, nil
+}
+
+// tryPop pops an item from the queue when pop is true, returning
+// whether there was one. Errors are only returned when the queue
+// cannot be read, not when it is empty.
+func (s *queueServer) tryPop(ctx context.Context, loc *eventstore.LocationType, pop bool) ([]byte, bool, error) {
+	n, err := s.store.LLen(ctx, loc)
+	if err != nil || n == 0 || !pop {
+		return nil, false, err
+	}
+
+	v, err := s.store.LPop(ctx, loc)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		// Taken by a non blocking consumer.
+		return nil, false, nil
+	case err != nil:
+		return nil, false, err
+	}
+
+	return v, true, nil
+}
+
+// waitLock acquires the lock at the location, waiting in line for up
+// to the requested wait when it is held. Contenders are granted the lock
+// in arrival order, and leave the line when their context is done.
+func waitLock(ctx context.Context, s Storage, events *hub, in *eventstore.LockRequest) (Lease, error) {
+	key := locationKey(in.Location)
+	w, first := events.lockers.add(key)
+	defer events.lockers.remove(key, w)
+
+	// Contenders that are not first in line wait for their turn.
+	lease, ok, err := tryLock(ctx, s, in, first)
+	if err != nil {
+		return Lease{}, err
+	}
+
+	t := time.NewTimer(seconds(in.Wait))
+	defer t.Stop()
+
+	poll := time.NewTicker(lockPollInterval)
+	defer poll.Stop()
+
+	for !ok {
+		select {
+		case <-ctx.Done():
+			return Lease{}, status.FromContextError(ctx.Err()).Err()
+
+		case <-t.C:
+			return Lease{}, fmt.Errorf("key %q: %w", in.Location.Key, ErrLocked)
+
+		case <-w.ready:
+			lease, ok, err = tryLock(ctx, s, in, true)
+
+		case <-poll.C:
+			lease, ok, err = tryLock(ctx, s, in, events.lockers.first(key, w))
+		}
+
+		if err != nil {
+			return Lease{}, err
+		}
+	}
+
+	// The lock would be left behind until it expires when the
+	// contender gives up while it is being granted.
+	if err := ctx.Err(); err != nil {
+		_ = s.Unlock(context.Background(), in.Location, lease.Token)
+		return Lease{}, status.FromContextError(err).Err()
+	}
+
+	return lease, nil
+}
+
+// tryLock acquires the lock when try is true, returning whether it
+// was granted. Errors are only returned when the lock cannot be
+// requested, not when it is held.
+func tryLock(ctx context.Context, s Storage, in *eventstore.LockRequest, try bool) (Lease, bool, error) {
+	if !try {
+		return Lease{}, false, nil
+	}
+
+	lease, err := s.Lock(ctx, in.Location, seconds(in.Timeout), in.Owner)
+	switch {
+	case errors.Is(err, ErrLocked):
+		return Lease{}, false, nil
+	case err != nil:
+		return Lease{}, false, err
+	}
+
+	return lease, true, nil
+}
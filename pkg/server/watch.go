@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2021 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	eventstore "github.com/triggermesh/eventstore/pkg/protob"
+)
+
+const (
+	// historySize is the number of past events kept for
+	// watchers that resume after a disconnection.
+	historySize = 1024
+	// watcherBuffer is the number of events that can be pending
+	// delivery to a watcher before it is disconnected.
+	watcherBuffer = 256
+	// revisionHeader informs watchers of the revision at the time
+	// they subscribed, which they can resume from when no events
+	// are received before a disconnection.
+	revisionHeader = "eventstore-revision"
+)
+
+// hub broadcasts changes to watchers.
+type hub struct {
+	mu       sync.Mutex
+	revision uint64
+	history  []*eventstore.WatchEvent
+	// evicted is the revision of the latest event
+	// that no longer fits the history.
+	evicted  uint64
+	watchers map[*watcher]struct{}
+	// poppers are woken up when items are pushed to queues.
+	poppers *waiters
+	// lockers are woken up when locks are released.
+	lockers *waiters
+}
+
+type watcher struct {
+	req    *eventstore.WatchRequest
+	events chan *eventstore.WatchEvent
+	// dropped is closed when the watcher falls behind.
+	dropped chan struct{}
+}
+
+// newHub creates a hub whose revisions start at the current time,
+// so that they keep growing across server restarts. Watchers resuming
+// after a restart receive every event issued by the new server.
+func newHub() *hub {
+	return &hub{
+		revision: uint64(time.Now().UnixNano()),
+		watchers: make(map[*watcher]struct{}),
+		poppers:  newWaiters(),
+		lockers:  newWaiters(),
+	}
+}
+
+// publish assigns a revision to the event and sends it to matching
+// watchers. Watchers that are not keeping up are dropped, they can
+// resume from the history when reconnecting.
+func (h *hub) publish(typ eventstore.WatchEventType, loc *eventstore.LocationType, field string, value []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.revision++
+	ev := &eventstore.WatchEvent{
+		Type:     typ,
+		Location: proto.Clone(loc).(*eventstore.LocationType),
+		Field:    field,
+		Value:    value,
+		Revision: h.revision,
+	}
+
+	if len(h.history) == historySize {
+		h.evicted = h.history[0].Revision
+		copy(h.history, h.history[1:])
+		h.history = h.history[:historySize-1]
+	}
+	h.history = append(h.history, ev)
+
+	if typ == eventstore.WatchEventType_WATCH_EVENT_TYPE_PUSH {
+		h.poppers.notify(locationKey(loc))
+	}
+
+	for w := range h.watchers {
+		if !matches(w.req, ev) {
+			continue
+		}
+
+		select {
+		case w.events <- ev:
+		default:
+			close(w.dropped)
+			delete(h.watchers, w)
+		}
+	}
+}
+
+// expired publishes expiration events for storage drivers
+// implementing ExpiryNotifier.
+func (h *hub) expired(loc *eventstore.LocationType) {
+	h.publish(eventstore.WatchEventType_WATCH_EVENT_TYPE_EXPIRE, loc, "", nil)
+}
+
+// subscribe registers a watcher and returns the past events after
+// the requested revision that it should receive first, along with the
+// current revision. Fails with OutOfRange when events after the
+// requested revision are no longer in the history.
+func (h *hub) subscribe(req *eventstore.WatchRequest) (*watcher, []*eventstore.WatchEvent, uint64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if req.Revision != 0 && req.Revision < h.evicted {
+		return nil, nil, 0, status.Errorf(codes.OutOfRange,
+			"events after revision %d are no longer available", req.Revision)
+	}
+
+	w := &watcher{
+		req:     req,
+		events:  make(chan *eventstore.WatchEvent, watcherBuffer),
+		dropped: make(chan struct{}),
+	}
+	h.watchers[w] = struct{}{}
+
+	if req.Revision == 0 {
+		return w, nil, h.revision, nil
+	}
+
+	var past []*eventstore.WatchEvent
+	for _, ev := range h.history {
+		if ev.Revision > req.Revision && matches(req, ev) {
+			past = append(past, ev)
+		}
+	}
+
+	return w, past, h.revision, nil
+}
+
+func (h *hub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.watchers, w)
+}
+
+// matches returns whether the event is at the scope and
+// key, or key prefix, requested by the watcher.
+func matches(req *eventstore.WatchRequest, ev *eventstore.WatchEvent) bool {
+	rs, es := req.Location.Scope, ev.Location.Scope
+	if rs.Type != es.Type || rs.Bridge != es.Bridge || rs.Instance != es.Instance {
+		return false
+	}
+
+	if req.Prefix {
+		return strings.HasPrefix(ev.Location.Key, req.Location.GetKey())
+	}
+	return ev.Location.Key == req.Location.Key
+}
+
+type watchServer struct {
+	eventstore.UnimplementedWatchServer
+	events *hub
+}
+
+func (s *watchServer) Watch(in *eventstore.WatchRequest, stream eventstore.Watch_WatchServer) error {
+	if err := validate(in); err != nil {
+		return err
+	}
+
+	w, past, revision, err := s.events.subscribe(in)
+	if err != nil {
+		return err
+	}
+	defer s.events.unsubscribe(w)
+
+	// Headers let clients know that the watch is in place.
+	md := metadata.Pairs(revisionHeader, strconv.FormatUint(revision, 10))
+	if err := stream.SendHeader(md); err != nil {
+		return err
+	}
+
+	for _, ev := range past {
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-w.dropped:
+			return status.Error(codes.ResourceExhausted, "watcher is not keeping up with changes")
+
+		case ev := <-w.events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}